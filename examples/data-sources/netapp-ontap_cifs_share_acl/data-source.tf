@@ -0,0 +1,7 @@
+data "netapp-ontap_cifs_share_acl" "cifs_share_acl" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  share_name = "share1"
+  user_or_group = "Everyone"
+}
@@ -0,0 +1,9 @@
+data "netapp-ontap_cifs_share_acls" "cifs_share_acls" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  share_name = "share1"
+  filter = {
+    permission = "full_control"
+  }
+}
@@ -0,0 +1,7 @@
+data "netapp-ontap_name_services_name_mapping" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  direction = "win_unix"
+  index = 1
+}
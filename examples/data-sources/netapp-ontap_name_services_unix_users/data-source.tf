@@ -0,0 +1,7 @@
+data "netapp-ontap_name_services_unix_users" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  filter = {
+    svm_name = "svm1"
+  }
+}
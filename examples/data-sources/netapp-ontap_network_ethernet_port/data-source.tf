@@ -0,0 +1,6 @@
+data "netapp-ontap_network_ethernet_port" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  node = "ontap_cluster_1-01"
+  name = "e0c"
+}
@@ -0,0 +1,28 @@
+data "netapp-ontap_network_ethernet_ports" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  filter = {
+    node = "ontap_cluster_1-01"
+    type = "physical"
+  }
+}
+
+resource "netapp-ontap_network_ip_interface" "example" {
+  cx_profile_name = "cluster4"
+  name = "data_lif"
+  svm_name = "svm0"
+  ip = {
+    address = "10.10.10.10"
+    netmask = 24
+  }
+  location = {
+    home_node = "ontap_cluster_1-01"
+    home_port = "e0d"
+  }
+  lifecycle {
+    precondition {
+      condition = anytrue([for port in data.netapp-ontap_network_ethernet_ports.example.ethernet_ports : port.name == "e0d" && port.state == "up" && port.speed >= 10000])
+      error_message = "e0d must be cabled and up at 10Gbps or more"
+    }
+  }
+}
@@ -0,0 +1,8 @@
+data "netapp-ontap_nvme_namespaces" "nvme_namespaces" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  filter = {
+    svm_name = "svm1"
+    volume_name = "nvme_vol"
+  }
+}
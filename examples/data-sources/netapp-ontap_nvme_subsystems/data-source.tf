@@ -0,0 +1,8 @@
+data "netapp-ontap_nvme_subsystems" "nvme_subsystems" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  filter = {
+    svm_name = "svm1"
+    os_type = "linux"
+  }
+}
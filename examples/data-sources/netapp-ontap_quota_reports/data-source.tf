@@ -0,0 +1,9 @@
+data "netapp-ontap_quota_reports" "storage_quota_reports" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster2"
+  filter = {
+    type = "tree"
+    svm_name = "carchi-test"
+    volume_name = "lunTest"
+  }
+}
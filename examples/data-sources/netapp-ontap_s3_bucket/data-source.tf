@@ -0,0 +1,6 @@
+data "netapp-ontap_s3_bucket" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  name = "bucket1"
+}
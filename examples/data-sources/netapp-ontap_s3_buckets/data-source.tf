@@ -0,0 +1,8 @@
+data "netapp-ontap_s3_buckets" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  filter = {
+    svm_name = "svm1"
+    type = "s3"
+  }
+}
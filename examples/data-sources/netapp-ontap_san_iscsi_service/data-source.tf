@@ -0,0 +1,5 @@
+data "netapp-ontap_san_iscsi_service" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+}
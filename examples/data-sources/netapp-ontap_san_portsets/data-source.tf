@@ -0,0 +1,8 @@
+data "netapp-ontap_san_portsets" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  filter = {
+    svm_name = "svm1"
+    protocol = "iscsi"
+  }
+}
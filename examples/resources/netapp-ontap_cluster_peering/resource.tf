@@ -0,0 +1,56 @@
+resource "netapp-ontap_cluster_peering" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  peer_cx_profile_name = "cluster3"
+  intercluster_interfaces = [
+    {
+      name = "ic_1"
+      home_node = "ontap_cluster_1-01"
+      home_port = "e0c"
+      address = "10.10.10.21"
+      netmask = 24
+    },
+    {
+      name = "ic_2"
+      home_node = "ontap_cluster_1-02"
+      home_port = "e0c"
+      address = "10.10.10.22"
+      netmask = 24
+    },
+  ]
+  peer_intercluster_interfaces = [
+    {
+      name = "ic_1"
+      home_node = "ontap_cluster_2-01"
+      home_port = "e0c"
+      address = "10.10.20.21"
+      netmask = 24
+    },
+  ]
+  peer_applications = ["snapmirror"]
+}
+
+resource "netapp-ontap_cluster_peering" "subnets" {
+  cx_profile_name = "cluster4"
+  peer_cx_profile_name = "cluster3"
+  # an intercluster interface is created on each node that has none, with an address from the subnet
+  intercluster_subnet = "ic_subnet"
+  peer_intercluster_subnet = "ic_subnet"
+}
+
+resource "netapp-ontap_svm_peer" "example" {
+  cx_profile_name = "cluster4"
+  svm = {
+    name = "svm1"
+  }
+  peer = {
+    svm = {
+      name = "svm2"
+    }
+    cluster = {
+      name = netapp-ontap_cluster_peering.example.name
+    }
+    peer_cx_profile_name = "cluster3"
+  }
+  applications = ["snapmirror"]
+}
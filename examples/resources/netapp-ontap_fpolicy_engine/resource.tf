@@ -0,0 +1,11 @@
+resource "netapp-ontap_fpolicy_engine" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  name = "engine1"
+  primary_servers = ["10.10.10.10"]
+  secondary_servers = ["10.10.10.11"]
+  port = 9000
+  type = "asynchronous"
+  ssl_option = "no_auth"
+}
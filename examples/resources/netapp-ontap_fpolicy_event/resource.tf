@@ -0,0 +1,9 @@
+resource "netapp-ontap_fpolicy_event" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  name = "event1"
+  protocol = "cifs"
+  file_operations = ["create", "write", "rename"]
+  filters = ["first_write", "close_with_modification"]
+}
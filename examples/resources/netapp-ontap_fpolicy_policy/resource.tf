@@ -0,0 +1,13 @@
+resource "netapp-ontap_fpolicy_policy" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  name = "policy1"
+  engine_name = netapp-ontap_fpolicy_engine.example.name
+  events = [netapp-ontap_fpolicy_event.example.name]
+  mandatory = false
+  scope = {
+    include_volumes = ["vol1", "vol2"]
+    exclude_extension = ["tmp"]
+  }
+}
@@ -0,0 +1,8 @@
+resource "netapp-ontap_fpolicy_policy_status" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  policy_name = netapp-ontap_fpolicy_policy.example.name
+  enabled = true
+  priority = 1
+}
@@ -9,3 +9,15 @@ resource "netapp-ontap_lun" "storage_lun" {
   size = 1048576
 
 }
+
+resource "netapp-ontap_lun" "storage_lun_clone" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  logical_unit = "ACC-clone-lun"
+  svm_name = "carchi-test"
+  volume_name = "lunTest"
+  clone = {
+    source_name = "/vol/lunTest/ACC-import-lun"
+    snapshot_name = "snap1"
+  }
+}
@@ -6,3 +6,18 @@ resource "netapp-ontap_dns" "dns" {
   dns_domains = ["foo.bar.com", "boo.bar.com"]
   skip_config_validation = true
 }
+
+resource "netapp-ontap_dns" "dns_ddns" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm5"
+  name_servers = ["1.1.1.1", "2.2.2.2"]
+  dns_domains = ["foo.bar.com"]
+  dynamic_dns = {
+    enabled = true
+    time_to_live = "PT1H"
+  }
+  nsswitch = {
+    hosts = ["files", "dns"]
+  }
+}
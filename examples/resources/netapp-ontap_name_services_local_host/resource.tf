@@ -0,0 +1,8 @@
+resource "netapp-ontap_name_services_local_host" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm5"
+  address = "10.10.10.10"
+  hostname = "host1.example.com"
+  aliases = ["host1"]
+}
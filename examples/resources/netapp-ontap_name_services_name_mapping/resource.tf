@@ -0,0 +1,21 @@
+resource "netapp-ontap_name_services_name_mapping" "windows_users" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  direction = "win_unix"
+  index = 1
+  pattern = "DOMAIN\\\\(.+)"
+  replacement = "\\1"
+}
+
+resource "netapp-ontap_name_services_name_mapping" "guest" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  direction = "win_unix"
+  index = 2
+  pattern = "DOMAIN\\\\guest"
+  replacement = "nobody"
+  client_match = "10.0.0.0/8"
+  depends_on = [netapp-ontap_name_services_name_mapping.windows_users]
+}
@@ -0,0 +1,8 @@
+resource "netapp-ontap_name_services_unix_group" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  name = "group1"
+  group_id = 1001
+  users = ["user1", "user2"]
+}
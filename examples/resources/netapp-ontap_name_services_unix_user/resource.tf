@@ -0,0 +1,9 @@
+resource "netapp-ontap_name_services_unix_user" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  name = "user1"
+  user_id = 1001
+  primary_gid = 1001
+  full_name = "User One"
+}
@@ -0,0 +1,11 @@
+resource "netapp-ontap_network_broadcast_domain" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  name = "tenant1_bd"
+  ipspace = netapp-ontap_network_ipspace.example.name
+  mtu = 9000
+  ports = [
+    "ontap_cluster_1-01:e0e",
+    "ontap_cluster_1-02:e0e",
+  ]
+}
@@ -0,0 +1,24 @@
+resource "netapp-ontap_network_ethernet_port" "lag" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  node = "ontap_cluster_1-01"
+  type = "lag"
+  lag = {
+    mode = "multimode_lacp"
+    distribution_policy = "port"
+    member_ports = ["e0c", "e0d"]
+  }
+  broadcast_domain = "tenant1_bd"
+  ipspace = "tenant1"
+}
+
+resource "netapp-ontap_network_ethernet_port" "vlan" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  node = "ontap_cluster_1-01"
+  type = "vlan"
+  vlan = {
+    tag = 100
+    base_port = netapp-ontap_network_ethernet_port.lag.name
+  }
+}
@@ -0,0 +1,43 @@
+resource "netapp-ontap_network_ip_interface" "bgp_lif" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  name = "bgp_lif"
+  svm_name = "ontap_cluster_1"
+  ip = {
+    address = "10.10.10.10"
+    netmask = 24
+  }
+  location = {
+    home_port = "e0d"
+    home_node = "ontap_cluster_1-01"
+  }
+  service_policy = "default-route-announce"
+}
+
+resource "netapp-ontap_network_ip_bgp_peer_group" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  name = "bgp_group"
+  ipspace = "Default"
+  local_interface = netapp-ontap_network_ip_interface.bgp_lif.name
+  peer = {
+    address = "10.10.10.1"
+    asn = 65501
+  }
+}
+
+resource "netapp-ontap_network_ip_interface" "vip_lif" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  name = "vip_lif"
+  svm_name = "svm0"
+  vip = true
+  ip = {
+    address = "10.20.20.10"
+    netmask = 32
+  }
+  location = {
+    home_node = "ontap_cluster_1-01"
+  }
+  depends_on = [netapp-ontap_network_ip_bgp_peer_group.example]
+}
@@ -10,5 +10,8 @@ resource "netapp-ontap_network_ip_interface" "ip_interface" {
   location = {
     home_port = "e0c"
     home_node = "ontap_cluster_1-01"
+    failover = "home_node_only"
+    auto_revert = true
   }
+  service_policy = "default-data-files"
 }
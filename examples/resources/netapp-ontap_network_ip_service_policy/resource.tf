@@ -0,0 +1,23 @@
+resource "netapp-ontap_network_ip_service_policy" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  name = "nfs-only"
+  svm_name = "svm0"
+  services = ["data_core", "data_nfs"]
+}
+
+resource "netapp-ontap_network_ip_interface" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  name = "nfs_lif"
+  svm_name = "svm0"
+  service_policy = netapp-ontap_network_ip_service_policy.example.name
+  ip = {
+    address = "10.10.10.10"
+    netmask = 20
+  }
+  location = {
+    home_port = "e0d"
+    home_node = "ontap_cluster_1-01"
+  }
+}
@@ -0,0 +1,28 @@
+resource "netapp-ontap_network_ip_subnet" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  name = "data_subnet"
+  ipspace = "Default"
+  broadcast_domain = "Default"
+  address = "10.10.10.0"
+  netmask = 24
+  gateway = "10.10.10.1"
+  ip_ranges = [
+    {
+      start = "10.10.10.20"
+      end = "10.10.10.29"
+    },
+  ]
+}
+
+resource "netapp-ontap_network_ip_interface" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  name = "data_lif"
+  svm_name = "svm0"
+  subnet = netapp-ontap_network_ip_subnet.example.name
+  location = {
+    home_port = "e0d"
+    home_node = "ontap_cluster_1-01"
+  }
+}
@@ -0,0 +1,5 @@
+resource "netapp-ontap_network_ipspace" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  name = "tenant1"
+}
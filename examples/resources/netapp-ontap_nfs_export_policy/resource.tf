@@ -3,3 +3,21 @@ resource "netapp-ontap_nfs_export_policy" "example" {
   svm_name = "carchi-test"
   name = "exportpolicytest"
 }
+
+resource "netapp-ontap_nfs_export_policy" "with_rules" {
+  cx_profile_name = "cluster4"
+  svm_name = "carchi-test"
+  name = "exportpolicyrules"
+  rules = [
+    {
+      clients_match = ["10.0.0.0/24"]
+      ro_rule = ["sys"]
+      rw_rule = ["sys"]
+    },
+    {
+      clients_match = ["0.0.0.0/0"]
+      ro_rule = ["any"]
+      rw_rule = ["none"]
+    },
+  ]
+}
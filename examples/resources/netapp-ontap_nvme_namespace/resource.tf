@@ -0,0 +1,9 @@
+resource "netapp-ontap_nvme_namespace" "nvme_namespace" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  name = "/vol/nvme_vol/namespace1"
+  os_type = "linux"
+  size = 10737418240
+  comment = "database namespace"
+}
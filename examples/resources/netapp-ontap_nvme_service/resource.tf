@@ -0,0 +1,6 @@
+resource "netapp-ontap_nvme_service" "nvme_service" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  enabled = true
+}
@@ -0,0 +1,12 @@
+resource "netapp-ontap_nvme_subsystem" "nvme_subsystem" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  name = "subsystem1"
+  os_type = "linux"
+  comment = "database hosts"
+  hosts = [
+    "nqn.2014-08.org.nvmexpress:uuid:00000000-0000-0000-0000-000000000001",
+    "nqn.2014-08.org.nvmexpress:uuid:00000000-0000-0000-0000-000000000002",
+  ]
+}
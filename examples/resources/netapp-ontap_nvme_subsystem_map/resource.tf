@@ -0,0 +1,7 @@
+resource "netapp-ontap_nvme_subsystem_map" "nvme_subsystem_map" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  subsystem_name = "subsystem1"
+  namespace_name = "/vol/nvme_vol/namespace1"
+}
@@ -0,0 +1 @@
+../../provider/variables.tf
\ No newline at end of file
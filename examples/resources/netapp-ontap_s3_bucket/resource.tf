@@ -0,0 +1,26 @@
+resource "netapp-ontap_s3_bucket" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  name = "bucket1"
+  size = 107374182400
+  versioning_state = "enabled"
+  policy_statements = [
+    {
+      sid = "readers"
+      effect = "allow"
+      actions = ["GetObject", "ListBucket"]
+      principals = ["readers"]
+      resources = ["bucket1", "bucket1/*"]
+    },
+  ]
+}
+
+resource "netapp-ontap_s3_bucket" "nas" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  name = "nasbucket1"
+  type = "nas"
+  nas_path = "/vol1"
+}
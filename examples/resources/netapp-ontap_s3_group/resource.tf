@@ -0,0 +1,8 @@
+resource "netapp-ontap_s3_group" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  name = "readers"
+  users = [netapp-ontap_s3_user.example.name]
+  policies = ["ReadOnlyAccess"]
+}
@@ -0,0 +1,11 @@
+resource "netapp-ontap_s3_service" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  name = "s3.example.com"
+  comment = "object store"
+  is_http_enabled = false
+  is_https_enabled = true
+  secure_port = 443
+  certificate_name = "svm1_ca"
+}
@@ -0,0 +1,8 @@
+resource "netapp-ontap_s3_user" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  name = "user1"
+  comment = "application user"
+  key_time_to_live = "P90D"
+}
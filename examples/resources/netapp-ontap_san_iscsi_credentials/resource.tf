@@ -0,0 +1,11 @@
+resource "netapp-ontap_san_iscsi_credentials" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  initiator = "iqn.1995-08.com.example:host1"
+  authentication_type = "chap"
+  inbound_user = "chapuser"
+  inbound_password = "chappassword123"
+  outbound_user = "targetuser"
+  outbound_password = "targetpassword123"
+}
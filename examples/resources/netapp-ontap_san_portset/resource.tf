@@ -0,0 +1,8 @@
+resource "netapp-ontap_san_portset" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  name = "portset1"
+  protocol = "iscsi"
+  interfaces = ["lif1", "lif2"]
+}
@@ -18,4 +18,9 @@ resource "netapp-ontap_quota_rule" "storage_quota_rule" {
     hard_limit = 100
     soft_limit = 80
     }
+  space = {
+    hard_limit = 1073741824
+    soft_limit = 858993459
+    }
+  quota_state = "on"
 }
@@ -0,0 +1,18 @@
+resource "netapp-ontap_svm_audit" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  log_path = "/audit_log"
+  format = "evtx"
+  rotation_size = 104857600
+  retention_count = 10
+  rotation_schedule = {
+    hours = [0, 12]
+    minutes = [0]
+  }
+  events = {
+    file_operations = true
+    cifs_logon_logoff = true
+    audit_policy_change = true
+  }
+}
@@ -0,0 +1,14 @@
+resource "netapp-ontap_volume_snapshot_restore" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "carchi-test"
+  volume_name = "carchi_test_root"
+  snapshot_name = "snaptest"
+}
+
+resource "netapp-ontap_volume_snapshot_restore" "file_example" {
+  cx_profile_name = "cluster4"
+  svm_name = "carchi-test"
+  volume_name = "carchi_test_root"
+  snapshot_name = "snaptest"
+  file_path = "/dir1/file1"
+}
@@ -0,0 +1,7 @@
+resource "netapp-ontap_vscan" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  enabled = true
+  depends_on = [netapp-ontap_vscan_scanner_pool.example, netapp-ontap_vscan_on_access_policy.example]
+}
@@ -0,0 +1,14 @@
+resource "netapp-ontap_vscan_on_access_policy" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  name = "policy1"
+  enabled = true
+  mandatory = true
+  scope = {
+    max_file_size = 2147483648
+    include_extensions = ["exe", "dll"]
+    exclude_paths = ["/tmp"]
+    scan_readonly_volumes = true
+  }
+}
@@ -0,0 +1,14 @@
+resource "netapp-ontap_vscan_on_demand_policy" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  name = "task1"
+  log_path = "/vol0/report_dir"
+  scan_paths = ["/vol1/", "/vol2/cifs/"]
+  schedule_name = "weekly"
+  scope = {
+    max_file_size = 10737418240
+    include_extensions = ["vmdk", "mp*"]
+    scan_without_extension = true
+  }
+}
@@ -0,0 +1,9 @@
+resource "netapp-ontap_vscan_scanner_pool" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "svm1"
+  name = "pool1"
+  servers = ["10.10.10.10", "10.10.10.11"]
+  privileged_users = ["cifs\\u1"]
+  role = "primary"
+}
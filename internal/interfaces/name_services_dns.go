@@ -12,10 +12,27 @@ import (
 
 // NameServicesDNSGetDataModelONTAP describes the GET record data model using go types for mapping.
 type NameServicesDNSGetDataModelONTAP struct {
-	Domains              []string          `mapstructure:"domains"`
-	Servers              []string          `mapstructure:"servers"`
-	SVM                  SvmDataModelONTAP `mapstructure:"svm"`
-	SkipConfigValidation bool              `mapstructure:"skip_config_validation"`
+	Domains              []string                `mapstructure:"domains"`
+	Servers              []string                `mapstructure:"servers"`
+	SVM                  SvmDataModelONTAP       `mapstructure:"svm"`
+	SkipConfigValidation bool                    `mapstructure:"skip_config_validation"`
+	DynamicDNS           *NameServicesDynamicDNS `mapstructure:"dynamic_dns,omitempty"`
+}
+
+// NameServicesDynamicDNS describes the DNS dynamic update settings.
+type NameServicesDynamicDNS struct {
+	Enabled    bool   `mapstructure:"enabled"`
+	UseSecure  bool   `mapstructure:"use_secure"`
+	Fqdn       string `mapstructure:"fqdn,omitempty"`
+	TimeToLive string `mapstructure:"time_to_live,omitempty"`
+}
+
+// NameServicesDNSUpdateBodyDataModelONTAP describes the PATCH body data model using go types for mapping.
+type NameServicesDNSUpdateBodyDataModelONTAP struct {
+	Domains              *[]string               `mapstructure:"domains,omitempty"`
+	Servers              *[]string               `mapstructure:"servers,omitempty"`
+	SkipConfigValidation *bool                   `mapstructure:"skip_config_validation,omitempty"`
+	DynamicDNS           *NameServicesDynamicDNS `mapstructure:"dynamic_dns,omitempty"`
 }
 
 // NameServicesDNSDataSourceFilterModel describes filter model.
@@ -25,12 +42,16 @@ type NameServicesDNSDataSourceFilterModel struct {
 	Servers string `tfsdk:"servers"`
 }
 
-// GetNameServicesDNS to get name_services_dns info
-func GetNameServicesDNS(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmName string) (*NameServicesDNSGetDataModelONTAP, error) {
+// GetNameServicesDNS to get name_services_dns info, dynamic_dns is read when version is 9.9 or later
+func GetNameServicesDNS(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmName string, version *versionModelONTAP) (*NameServicesDNSGetDataModelONTAP, error) {
 	api := "name-services/dns"
 	query := r.NewQuery()
 	query.Add("svm.name", svmName)
-	query.Fields([]string{"domains", "servers"})
+	fields := []string{"domains", "servers"}
+	if version != nil && version.Generation == 9 && version.Major > 8 {
+		fields = append(fields, "dynamic_dns")
+	}
+	query.Fields(fields)
 	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
 	if err == nil && response == nil {
 		err = fmt.Errorf("no response for GET %s", api)
@@ -110,6 +131,21 @@ func CreateNameServicesDNS(errorHandler *utils.ErrorHandler, r restclient.RestCl
 	return &dataONTAP, nil
 }
 
+// UpdateNameServicesDNS updates the DNS configuration of a svm
+func UpdateNameServicesDNS(errorHandler *utils.ErrorHandler, r restclient.RestClient, data NameServicesDNSUpdateBodyDataModelONTAP, svmUUID string) error {
+	api := "name-services/dns/" + svmUUID
+	var body map[string]interface{}
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return errorHandler.MakeAndReportError("error encoding DNS body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, data))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("%s body is : %#v", api, body))
+	statusCode, _, err := r.CallUpdateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating DNS", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
 // DeleteNameServicesDNS deletes a DNS
 func DeleteNameServicesDNS(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) error {
 	statusCode, _, err := r.CallDeleteMethod("name-services/dns/"+uuid, nil, nil)
@@ -78,7 +78,7 @@ func TestGetNameServicesDNS(t *testing.T) {
 			if err != nil {
 				panic(err)
 			}
-			got, err := GetNameServicesDNS(errorHandler, *r, "svmname")
+			got, err := GetNameServicesDNS(errorHandler, *r, "svmname", nil)
 			if err != nil {
 				fmt.Printf("err: %s\n", err)
 			}
@@ -274,3 +274,45 @@ func TestDeleteNameServicesDNS(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateNameServicesDNS(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_update": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "name-services/dns/id", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_update_error": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "name-services/dns/id", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	servers := []string{"10.193.0.250"}
+	body := NameServicesDNSUpdateBodyDataModelONTAP{
+		Servers:    &servers,
+		DynamicDNS: &NameServicesDynamicDNS{Enabled: true, UseSecure: false, Fqdn: "svmname.sales.bar.com", TimeToLive: "PT1H"},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_update", responses: responses["test_update"], wantErr: false},
+		{name: "test_update_error", responses: responses["test_update_error"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = UpdateNameServicesDNS(errorHandler, *r, body, "id")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateNameServicesDNS() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
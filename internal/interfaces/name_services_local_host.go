@@ -0,0 +1,95 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// NameServicesLocalHostGetDataModelONTAP describes the GET record data model using go types for mapping.
+type NameServicesLocalHostGetDataModelONTAP struct {
+	Address  string            `mapstructure:"address"`
+	Hostname string            `mapstructure:"hostname"`
+	Aliases  []string          `mapstructure:"aliases,omitempty"`
+	Owner    SvmDataModelONTAP `mapstructure:"owner"`
+}
+
+// NameServicesLocalHostResourceBodyDataModelONTAP describes the POST body data model using go types for mapping.
+type NameServicesLocalHostResourceBodyDataModelONTAP struct {
+	Address  string   `mapstructure:"address"`
+	Hostname string   `mapstructure:"hostname"`
+	Aliases  []string `mapstructure:"aliases,omitempty"`
+	Owner    svm      `mapstructure:"owner"`
+}
+
+// NameServicesLocalHostUpdateBodyDataModelONTAP describes the PATCH body data model using go types for mapping.
+type NameServicesLocalHostUpdateBodyDataModelONTAP struct {
+	Hostname *string   `mapstructure:"hostname,omitempty"`
+	Aliases  *[]string `mapstructure:"aliases,omitempty"`
+}
+
+// GetNameServicesLocalHost to get name_services_local_host info
+func GetNameServicesLocalHost(errorHandler *utils.ErrorHandler, r restclient.RestClient, address string, svmName string) (*NameServicesLocalHostGetDataModelONTAP, error) {
+	api := "name-services/local-hosts"
+	query := r.NewQuery()
+	query.Set("address", address)
+	query.Set("owner.name", svmName)
+	query.Fields([]string{"address", "hostname", "aliases", "owner.name", "owner.uuid"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading name_services_local_host info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP NameServicesLocalHostGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read name_services_local_host: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// CreateNameServicesLocalHost to create name_services_local_host
+func CreateNameServicesLocalHost(errorHandler *utils.ErrorHandler, r restclient.RestClient, body NameServicesLocalHostResourceBodyDataModelONTAP) error {
+	api := "name-services/local-hosts"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding name_services_local_host body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error creating name_services_local_host", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Create name_services_local_host %s", body.Address))
+	return nil
+}
+
+// UpdateNameServicesLocalHost to update name_services_local_host
+func UpdateNameServicesLocalHost(errorHandler *utils.ErrorHandler, r restclient.RestClient, data NameServicesLocalHostUpdateBodyDataModelONTAP, svmUUID string, address string) error {
+	api := "name-services/local-hosts/" + svmUUID + "/" + address
+	var body map[string]interface{}
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return errorHandler.MakeAndReportError("error encoding name_services_local_host body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, data))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating name_services_local_host", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteNameServicesLocalHost to delete name_services_local_host
+func DeleteNameServicesLocalHost(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, address string) error {
+	api := "name-services/local-hosts/" + svmUUID + "/" + address
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting name_services_local_host", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var basicLocalHostRecord = NameServicesLocalHostGetDataModelONTAP{
+	Address:  "10.10.10.10",
+	Hostname: "host1.example.com",
+	Aliases:  []string{"host1"},
+	Owner:    SvmDataModelONTAP{Name: "svm1", UUID: "5678"},
+}
+
+func TestGetNameServicesLocalHost(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+
+	var recordInterface map[string]any
+	err := mapstructure.Decode(basicLocalHostRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var badRecordInterface map[string]any
+	err = mapstructure.Decode(struct{ Aliases string }{"host1"}, &badRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	decodeError := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records": {
+			{ExpectedMethod: "GET", ExpectedURL: "name-services/local-hosts", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record": {
+			{ExpectedMethod: "GET", ExpectedURL: "name-services/local-hosts", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "name-services/local-hosts", StatusCode: 200, Response: decodeError, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *NameServicesLocalHostGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records", responses: responses["test_no_records"], want: nil, wantErr: false},
+		{name: "test_one_record", responses: responses["test_one_record"], want: &basicLocalHostRecord, wantErr: false},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetNameServicesLocalHost(errorHandler, *r, "10.10.10.10", "svm1")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetNameServicesLocalHost() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetNameServicesLocalHost() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateNameServicesLocalHost(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_update": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "name-services/local-hosts/5678/10.10.10.10", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_update_error": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "name-services/local-hosts/5678/10.10.10.10", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	hostname := "host2.example.com"
+	aliases := []string{}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_update", responses: responses["test_update"], wantErr: false},
+		{name: "test_update_error", responses: responses["test_update_error"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			body := NameServicesLocalHostUpdateBodyDataModelONTAP{Hostname: &hostname, Aliases: &aliases}
+			err = UpdateNameServicesLocalHost(errorHandler, *r, body, "5678", "10.10.10.10")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateNameServicesLocalHost() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
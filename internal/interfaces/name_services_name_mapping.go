@@ -0,0 +1,157 @@
+package interfaces
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// NameServicesNameMappingGetDataModelONTAP describes the GET record data model using go types for mapping.
+type NameServicesNameMappingGetDataModelONTAP struct {
+	SVM         SvmDataModelONTAP `mapstructure:"svm"`
+	Direction   string            `mapstructure:"direction"`
+	Index       int64             `mapstructure:"index"`
+	Pattern     string            `mapstructure:"pattern"`
+	Replacement string            `mapstructure:"replacement"`
+	ClientMatch string            `mapstructure:"client_match,omitempty"`
+}
+
+// NameServicesNameMappingResourceBodyDataModelONTAP describes the POST body data model using go types for mapping.
+type NameServicesNameMappingResourceBodyDataModelONTAP struct {
+	SVM         svm    `mapstructure:"svm"`
+	Direction   string `mapstructure:"direction"`
+	Index       int64  `mapstructure:"index"`
+	Pattern     string `mapstructure:"pattern"`
+	Replacement string `mapstructure:"replacement"`
+	ClientMatch string `mapstructure:"client_match,omitempty"`
+}
+
+// NameServicesNameMappingUpdateBodyDataModelONTAP describes the PATCH body data model using go types for mapping.
+type NameServicesNameMappingUpdateBodyDataModelONTAP struct {
+	Pattern     string `mapstructure:"pattern,omitempty"`
+	Replacement string `mapstructure:"replacement,omitempty"`
+	ClientMatch string `mapstructure:"client_match"`
+}
+
+// NameServicesNameMappingDataSourceFilterModel describes the data source data model for queries.
+type NameServicesNameMappingDataSourceFilterModel struct {
+	SVMName   string `mapstructure:"svm.name"`
+	Direction string `mapstructure:"direction"`
+	Pattern   string `mapstructure:"pattern"`
+}
+
+var nameServicesNameMappingFields = []string{"svm.name", "svm.uuid", "direction", "index", "pattern", "replacement", "client_match"}
+
+// GetNameServicesNameMapping to get name_services_name_mapping info by index
+func GetNameServicesNameMapping(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmName string, direction string, index int64) (*NameServicesNameMappingGetDataModelONTAP, error) {
+	api := "name-services/name-mappings"
+	query := r.NewQuery()
+	query.Set("svm.name", svmName)
+	query.Set("direction", direction)
+	query.Set("index", strconv.FormatInt(index, 10))
+	query.Fields(nameServicesNameMappingFields)
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading name_services_name_mapping info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP NameServicesNameMappingGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read name_services_name_mapping: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// GetNameServicesNameMappings to get name_services_name_mapping info for all resources matching a filter
+func GetNameServicesNameMappings(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *NameServicesNameMappingDataSourceFilterModel) ([]NameServicesNameMappingGetDataModelONTAP, error) {
+	api := "name-services/name-mappings"
+	query := r.NewQuery()
+	query.Fields(nameServicesNameMappingFields)
+	if filter != nil {
+		var filterMap map[string]interface{}
+		if err := mapstructure.Decode(filter, &filterMap); err != nil {
+			return nil, errorHandler.MakeAndReportError("error encoding name_services_name_mappings filter info", fmt.Sprintf("error on filter %#v: %s", filter, err))
+		}
+		query.SetValues(filterMap)
+	}
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading name_services_name_mappings info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []NameServicesNameMappingGetDataModelONTAP
+	for _, info := range response {
+		var record NameServicesNameMappingGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read name_services_name_mappings data source: %#v", dataONTAP))
+	return dataONTAP, nil
+}
+
+// CreateNameServicesNameMapping to create name_services_name_mapping, ONTAP shifts the mappings at or after the index down by one
+func CreateNameServicesNameMapping(errorHandler *utils.ErrorHandler, r restclient.RestClient, body NameServicesNameMappingResourceBodyDataModelONTAP) error {
+	api := "name-services/name-mappings"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding name_services_name_mapping body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error creating name_services_name_mapping", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Create name_services_name_mapping %s %d", body.Direction, body.Index))
+	return nil
+}
+
+// UpdateNameServicesNameMapping to update name_services_name_mapping
+func UpdateNameServicesNameMapping(errorHandler *utils.ErrorHandler, r restclient.RestClient, data NameServicesNameMappingUpdateBodyDataModelONTAP, svmUUID string, direction string, index int64) error {
+	api := fmt.Sprintf("name-services/name-mappings/%s/%s/%d", svmUUID, direction, index)
+	var body map[string]interface{}
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return errorHandler.MakeAndReportError("error encoding name_services_name_mapping body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, data))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating name_services_name_mapping", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// UpdateNameServicesNameMappingIndex moves a name mapping to a new index, ONTAP shifts the mappings in between
+func UpdateNameServicesNameMappingIndex(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, direction string, index int64, newIndex int64) error {
+	api := fmt.Sprintf("name-services/name-mappings/%s/%s/%d", svmUUID, direction, index)
+	query := r.NewQuery()
+	query.Add("new_index", strconv.FormatInt(newIndex, 10))
+	statusCode, _, err := r.CallUpdateMethod(api, query, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error moving name_services_name_mapping", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Moved name mapping %d to index %d", index, newIndex))
+	return nil
+}
+
+// DeleteNameServicesNameMapping to delete name_services_name_mapping, ONTAP shifts the mappings after the index up by one
+func DeleteNameServicesNameMapping(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, direction string, index int64) error {
+	api := fmt.Sprintf("name-services/name-mappings/%s/%s/%d", svmUUID, direction, index)
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting name_services_name_mapping", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var basicNameMappingRecord = NameServicesNameMappingGetDataModelONTAP{
+	SVM:         SvmDataModelONTAP{Name: "svm1", UUID: "5678"},
+	Direction:   "win_unix",
+	Index:       1,
+	Pattern:     "DOMAIN\\\\(.+)",
+	Replacement: "\\1",
+}
+
+func TestGetNameServicesNameMapping(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+
+	var recordInterface map[string]any
+	err := mapstructure.Decode(basicNameMappingRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var badRecordInterface map[string]any
+	err = mapstructure.Decode(struct{ Index string }{"first"}, &badRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	twoRecords := restclient.RestResponse{NumRecords: 2, Records: []map[string]any{recordInterface, recordInterface}}
+	decodeError := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records": {
+			{ExpectedMethod: "GET", ExpectedURL: "name-services/name-mappings", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record": {
+			{ExpectedMethod: "GET", ExpectedURL: "name-services/name-mappings", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_two_records_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "name-services/name-mappings", StatusCode: 200, Response: twoRecords, Err: genericError},
+		},
+		"test_get_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "name-services/name-mappings", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "name-services/name-mappings", StatusCode: 200, Response: decodeError, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *NameServicesNameMappingGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records", responses: responses["test_no_records"], want: nil, wantErr: false},
+		{name: "test_one_record", responses: responses["test_one_record"], want: &basicNameMappingRecord, wantErr: false},
+		{name: "test_two_records_error", responses: responses["test_two_records_error"], want: nil, wantErr: true},
+		{name: "test_get_error", responses: responses["test_get_error"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetNameServicesNameMapping(errorHandler, *r, "svm1", "win_unix", 1)
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetNameServicesNameMapping() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetNameServicesNameMapping() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateNameServicesNameMappingIndex(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_move": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "name-services/name-mappings/5678/win_unix/3", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_move_error": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "name-services/name-mappings/5678/win_unix/3", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_move", responses: responses["test_move"], wantErr: false},
+		{name: "test_move_error", responses: responses["test_move_error"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = UpdateNameServicesNameMappingIndex(errorHandler, *r, "5678", "win_unix", 3, 1)
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateNameServicesNameMappingIndex() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCreateUpdateDeleteNameServicesNameMapping(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	responses := map[string][]restclient.MockResponse{
+		"test_create": {
+			{ExpectedMethod: "POST", ExpectedURL: "name-services/name-mappings", StatusCode: 201, Response: noRecords, Err: nil},
+		},
+		"test_create_error": {
+			{ExpectedMethod: "POST", ExpectedURL: "name-services/name-mappings", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_update": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "name-services/name-mappings/5678/win_unix/1", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_update_error": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "name-services/name-mappings/5678/win_unix/1", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_delete": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "name-services/name-mappings/5678/win_unix/1", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_delete_not_found": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "name-services/name-mappings/5678/win_unix/1", StatusCode: 404, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		action    string
+		wantErr   bool
+	}{
+		{name: "test_create", responses: responses["test_create"], action: "create", wantErr: false},
+		{name: "test_create_error", responses: responses["test_create_error"], action: "create", wantErr: true},
+		{name: "test_update", responses: responses["test_update"], action: "update", wantErr: false},
+		{name: "test_update_error", responses: responses["test_update_error"], action: "update", wantErr: true},
+		{name: "test_delete", responses: responses["test_delete"], action: "delete", wantErr: false},
+		{name: "test_delete_not_found", responses: responses["test_delete_not_found"], action: "delete", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			switch tt.action {
+			case "create":
+				body := NameServicesNameMappingResourceBodyDataModelONTAP{SVM: svm{Name: "svm1"}, Direction: "win_unix", Index: 1, Pattern: "DOMAIN\\\\(.+)", Replacement: "\\1"}
+				err = CreateNameServicesNameMapping(errorHandler, *r, body)
+			case "update":
+				err = UpdateNameServicesNameMapping(errorHandler, *r, NameServicesNameMappingUpdateBodyDataModelONTAP{Replacement: "\\2"}, "5678", "win_unix", 1)
+			case "delete":
+				err = DeleteNameServicesNameMapping(errorHandler, *r, "5678", "win_unix", 1)
+			}
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("%s error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package interfaces
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// NameServicesUnixGroupGetDataModelONTAP describes the GET record data model using go types for mapping.
+type NameServicesUnixGroupGetDataModelONTAP struct {
+	Name  string                        `mapstructure:"name"`
+	SVM   SvmDataModelONTAP             `mapstructure:"svm"`
+	ID    int64                         `mapstructure:"id"`
+	Users []NameServicesUnixGroupMember `mapstructure:"users,omitempty"`
+}
+
+// NameServicesUnixGroupMember describes a UNIX user member of a UNIX group.
+type NameServicesUnixGroupMember struct {
+	Name string `mapstructure:"name"`
+}
+
+// NameServicesUnixGroupResourceBodyDataModelONTAP describes the POST body data model using go types for mapping.
+type NameServicesUnixGroupResourceBodyDataModelONTAP struct {
+	Name string `mapstructure:"name"`
+	SVM  svm    `mapstructure:"svm"`
+	ID   int64  `mapstructure:"id"`
+}
+
+// NameServicesUnixGroupUpdateBodyDataModelONTAP describes the PATCH body data model using go types for mapping.
+type NameServicesUnixGroupUpdateBodyDataModelONTAP struct {
+	ID int64 `mapstructure:"id"`
+}
+
+// NameServicesUnixGroupDataSourceFilterModel describes the data source data model for queries.
+type NameServicesUnixGroupDataSourceFilterModel struct {
+	Name    string `mapstructure:"name"`
+	SVMName string `mapstructure:"svm.name"`
+}
+
+var nameServicesUnixGroupFields = []string{"name", "svm.name", "svm.uuid", "id", "users.name"}
+
+// GetNameServicesUnixGroupByName to get name_services_unix_group info
+func GetNameServicesUnixGroupByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string, svmName string) (*NameServicesUnixGroupGetDataModelONTAP, error) {
+	api := "name-services/unix-groups"
+	query := r.NewQuery()
+	query.Set("name", name)
+	query.Set("svm.name", svmName)
+	query.Fields(nameServicesUnixGroupFields)
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading name_services_unix_group info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP NameServicesUnixGroupGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read name_services_unix_group: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// GetNameServicesUnixGroups to get name_services_unix_group info for all resources matching a filter
+func GetNameServicesUnixGroups(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *NameServicesUnixGroupDataSourceFilterModel) ([]NameServicesUnixGroupGetDataModelONTAP, error) {
+	api := "name-services/unix-groups"
+	query := r.NewQuery()
+	query.Fields(nameServicesUnixGroupFields)
+	if filter != nil {
+		var filterMap map[string]interface{}
+		if err := mapstructure.Decode(filter, &filterMap); err != nil {
+			return nil, errorHandler.MakeAndReportError("error encoding name_services_unix_groups filter info", fmt.Sprintf("error on filter %#v: %s", filter, err))
+		}
+		query.SetValues(filterMap)
+	}
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading name_services_unix_groups info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []NameServicesUnixGroupGetDataModelONTAP
+	for _, info := range response {
+		var record NameServicesUnixGroupGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read name_services_unix_groups data source: %#v", dataONTAP))
+	return dataONTAP, nil
+}
+
+// CreateNameServicesUnixGroup to create name_services_unix_group
+func CreateNameServicesUnixGroup(errorHandler *utils.ErrorHandler, r restclient.RestClient, body NameServicesUnixGroupResourceBodyDataModelONTAP) error {
+	api := "name-services/unix-groups"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding name_services_unix_group body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error creating name_services_unix_group", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Create name_services_unix_group %s", body.Name))
+	return nil
+}
+
+// UpdateNameServicesUnixGroup to update name_services_unix_group
+func UpdateNameServicesUnixGroup(errorHandler *utils.ErrorHandler, r restclient.RestClient, data NameServicesUnixGroupUpdateBodyDataModelONTAP, svmUUID string, name string) error {
+	api := "name-services/unix-groups/" + svmUUID + "/" + url.PathEscape(name)
+	var body map[string]interface{}
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return errorHandler.MakeAndReportError("error encoding name_services_unix_group body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, data))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating name_services_unix_group", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteNameServicesUnixGroup to delete name_services_unix_group
+func DeleteNameServicesUnixGroup(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, name string) error {
+	api := "name-services/unix-groups/" + svmUUID + "/" + url.PathEscape(name)
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting name_services_unix_group", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// AddNameServicesUnixGroupUser to add a UNIX user to the UNIX group
+func AddNameServicesUnixGroupUser(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, groupName string, userName string) error {
+	api := "name-services/unix-groups/" + svmUUID + "/" + url.PathEscape(groupName) + "/users"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(NameServicesUnixGroupMember{Name: userName}, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding name_services_unix_group user body", fmt.Sprintf("error on encoding %s body: %s, user: %s", api, err, userName))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error adding name_services_unix_group user", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// RemoveNameServicesUnixGroupUser to remove a UNIX user from the UNIX group
+func RemoveNameServicesUnixGroupUser(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, groupName string, userName string) error {
+	api := "name-services/unix-groups/" + svmUUID + "/" + url.PathEscape(groupName) + "/users/" + url.PathEscape(userName)
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error removing name_services_unix_group user", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var basicUnixGroupRecord = NameServicesUnixGroupGetDataModelONTAP{
+	Name:  "group1",
+	SVM:   SvmDataModelONTAP{Name: "svm1", UUID: "5678"},
+	ID:    1001,
+	Users: []NameServicesUnixGroupMember{{Name: "user1"}, {Name: "user2"}},
+}
+
+func TestGetNameServicesUnixGroupByName(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+
+	var recordInterface map[string]any
+	err := mapstructure.Decode(basicUnixGroupRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var badRecordInterface map[string]any
+	err = mapstructure.Decode(struct{ Users string }{"user1"}, &badRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	decodeError := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records": {
+			{ExpectedMethod: "GET", ExpectedURL: "name-services/unix-groups", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record": {
+			{ExpectedMethod: "GET", ExpectedURL: "name-services/unix-groups", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_get_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "name-services/unix-groups", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "name-services/unix-groups", StatusCode: 200, Response: decodeError, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *NameServicesUnixGroupGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records", responses: responses["test_no_records"], want: nil, wantErr: false},
+		{name: "test_one_record", responses: responses["test_one_record"], want: &basicUnixGroupRecord, wantErr: false},
+		{name: "test_get_error", responses: responses["test_get_error"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetNameServicesUnixGroupByName(errorHandler, *r, "group1", "svm1")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetNameServicesUnixGroupByName() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetNameServicesUnixGroupByName() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNameServicesUnixGroupMembership(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_add_remove": {
+			{ExpectedMethod: "POST", ExpectedURL: "name-services/unix-groups/5678/group1/users", StatusCode: 201, Response: noRecords, Err: nil},
+			{ExpectedMethod: "DELETE", ExpectedURL: "name-services/unix-groups/5678/group1/users/user1", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_add_error": {
+			{ExpectedMethod: "POST", ExpectedURL: "name-services/unix-groups/5678/group1/users", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_add_remove", responses: responses["test_add_remove"], wantErr: false},
+		{name: "test_add_error", responses: responses["test_add_error"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = AddNameServicesUnixGroupUser(errorHandler, *r, "5678", "group1", "user1")
+			if err == nil {
+				err = RemoveNameServicesUnixGroupUser(errorHandler, *r, "5678", "group1", "user1")
+			}
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NameServicesUnixGroupMembership() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCreateUpdateDeleteNameServicesUnixGroup(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	responses := map[string][]restclient.MockResponse{
+		"test_create": {
+			{ExpectedMethod: "POST", ExpectedURL: "name-services/unix-groups", StatusCode: 201, Response: noRecords, Err: nil},
+		},
+		"test_create_error": {
+			{ExpectedMethod: "POST", ExpectedURL: "name-services/unix-groups", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_update": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "name-services/unix-groups/5678/group1", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_update_error": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "name-services/unix-groups/5678/group1", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_delete": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "name-services/unix-groups/5678/group1", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_delete_not_found": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "name-services/unix-groups/5678/group1", StatusCode: 404, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		action    string
+		wantErr   bool
+	}{
+		{name: "test_create", responses: responses["test_create"], action: "create", wantErr: false},
+		{name: "test_create_error", responses: responses["test_create_error"], action: "create", wantErr: true},
+		{name: "test_update", responses: responses["test_update"], action: "update", wantErr: false},
+		{name: "test_update_error", responses: responses["test_update_error"], action: "update", wantErr: true},
+		{name: "test_delete", responses: responses["test_delete"], action: "delete", wantErr: false},
+		{name: "test_delete_not_found", responses: responses["test_delete_not_found"], action: "delete", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			switch tt.action {
+			case "create":
+				err = CreateNameServicesUnixGroup(errorHandler, *r, NameServicesUnixGroupResourceBodyDataModelONTAP{Name: "group1", SVM: svm{Name: "svm1"}, ID: 1001})
+			case "update":
+				err = UpdateNameServicesUnixGroup(errorHandler, *r, NameServicesUnixGroupUpdateBodyDataModelONTAP{ID: 1002}, "5678", "group1")
+			case "delete":
+				err = DeleteNameServicesUnixGroup(errorHandler, *r, "5678", "group1")
+			}
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("%s error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package interfaces
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// NameServicesUnixUserGetDataModelONTAP describes the GET record data model using go types for mapping.
+type NameServicesUnixUserGetDataModelONTAP struct {
+	Name       string            `mapstructure:"name"`
+	SVM        SvmDataModelONTAP `mapstructure:"svm"`
+	ID         int64             `mapstructure:"id"`
+	PrimaryGID int64             `mapstructure:"primary_gid"`
+	FullName   string            `mapstructure:"full_name,omitempty"`
+}
+
+// NameServicesUnixUserResourceBodyDataModelONTAP describes the POST body data model using go types for mapping.
+type NameServicesUnixUserResourceBodyDataModelONTAP struct {
+	Name       string `mapstructure:"name"`
+	SVM        svm    `mapstructure:"svm"`
+	ID         int64  `mapstructure:"id"`
+	PrimaryGID int64  `mapstructure:"primary_gid"`
+	FullName   string `mapstructure:"full_name,omitempty"`
+}
+
+// NameServicesUnixUserUpdateBodyDataModelONTAP describes the PATCH body data model using go types for mapping.
+type NameServicesUnixUserUpdateBodyDataModelONTAP struct {
+	ID         *int64  `mapstructure:"id,omitempty"`
+	PrimaryGID *int64  `mapstructure:"primary_gid,omitempty"`
+	FullName   *string `mapstructure:"full_name,omitempty"`
+}
+
+// NameServicesUnixUserDataSourceFilterModel describes the data source data model for queries.
+type NameServicesUnixUserDataSourceFilterModel struct {
+	Name    string `mapstructure:"name"`
+	SVMName string `mapstructure:"svm.name"`
+}
+
+var nameServicesUnixUserFields = []string{"name", "svm.name", "svm.uuid", "id", "primary_gid", "full_name"}
+
+// GetNameServicesUnixUserByName to get name_services_unix_user info
+func GetNameServicesUnixUserByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string, svmName string) (*NameServicesUnixUserGetDataModelONTAP, error) {
+	api := "name-services/unix-users"
+	query := r.NewQuery()
+	query.Set("name", name)
+	query.Set("svm.name", svmName)
+	query.Fields(nameServicesUnixUserFields)
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading name_services_unix_user info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP NameServicesUnixUserGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read name_services_unix_user: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// GetNameServicesUnixUsers to get name_services_unix_user info for all resources matching a filter
+func GetNameServicesUnixUsers(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *NameServicesUnixUserDataSourceFilterModel) ([]NameServicesUnixUserGetDataModelONTAP, error) {
+	api := "name-services/unix-users"
+	query := r.NewQuery()
+	query.Fields(nameServicesUnixUserFields)
+	if filter != nil {
+		var filterMap map[string]interface{}
+		if err := mapstructure.Decode(filter, &filterMap); err != nil {
+			return nil, errorHandler.MakeAndReportError("error encoding name_services_unix_users filter info", fmt.Sprintf("error on filter %#v: %s", filter, err))
+		}
+		query.SetValues(filterMap)
+	}
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading name_services_unix_users info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []NameServicesUnixUserGetDataModelONTAP
+	for _, info := range response {
+		var record NameServicesUnixUserGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read name_services_unix_users data source: %#v", dataONTAP))
+	return dataONTAP, nil
+}
+
+// CreateNameServicesUnixUser to create name_services_unix_user
+func CreateNameServicesUnixUser(errorHandler *utils.ErrorHandler, r restclient.RestClient, body NameServicesUnixUserResourceBodyDataModelONTAP) error {
+	api := "name-services/unix-users"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding name_services_unix_user body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error creating name_services_unix_user", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Create name_services_unix_user %s", body.Name))
+	return nil
+}
+
+// UpdateNameServicesUnixUser to update name_services_unix_user
+func UpdateNameServicesUnixUser(errorHandler *utils.ErrorHandler, r restclient.RestClient, data NameServicesUnixUserUpdateBodyDataModelONTAP, svmUUID string, name string) error {
+	api := "name-services/unix-users/" + svmUUID + "/" + url.PathEscape(name)
+	var body map[string]interface{}
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return errorHandler.MakeAndReportError("error encoding name_services_unix_user body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, data))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating name_services_unix_user", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteNameServicesUnixUser to delete name_services_unix_user
+func DeleteNameServicesUnixUser(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, name string) error {
+	api := "name-services/unix-users/" + svmUUID + "/" + url.PathEscape(name)
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting name_services_unix_user", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
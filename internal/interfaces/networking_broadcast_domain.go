@@ -0,0 +1,145 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// NetworkBroadcastDomainGetDataModelONTAP describes the GET record data model using go types for mapping.
+type NetworkBroadcastDomainGetDataModelONTAP struct {
+	Name    string                          `mapstructure:"name"`
+	UUID    string                          `mapstructure:"uuid"`
+	IPspace NetworkIPspaceGetDataModelONTAP `mapstructure:"ipspace"`
+	MTU     int64                           `mapstructure:"mtu"`
+	Ports   []NetworkPortReference          `mapstructure:"ports"`
+}
+
+// NetworkPortReference describes a port as referenced from broadcast domains and link aggregation groups.
+type NetworkPortReference struct {
+	Name string        `mapstructure:"name"`
+	UUID string        `mapstructure:"uuid,omitempty"`
+	Node NameDataModel `mapstructure:"node"`
+}
+
+// NetworkBroadcastDomainResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+type NetworkBroadcastDomainResourceBodyDataModelONTAP struct {
+	Name    string                                    `mapstructure:"name,omitempty"`
+	IPspace *NetworkIPspaceResourceBodyDataModelONTAP `mapstructure:"ipspace,omitempty"`
+	MTU     int64                                     `mapstructure:"mtu,omitempty"`
+}
+
+// NetworkBroadcastDomainDataSourceFilterModel describes the data source data model for queries.
+type NetworkBroadcastDomainDataSourceFilterModel struct {
+	Name        string `mapstructure:"name"`
+	IPspaceName string `mapstructure:"ipspace.name"`
+}
+
+// GetNetworkBroadcastDomainByName to get a broadcast domain by name and ipspace
+func GetNetworkBroadcastDomainByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string, ipspaceName string) (*NetworkBroadcastDomainGetDataModelONTAP, error) {
+	api := "network/ethernet/broadcast-domains"
+	query := r.NewQuery()
+	query.Set("name", name)
+	query.Set("ipspace.name", ipspaceName)
+	query.Fields([]string{"name", "uuid", "ipspace.name", "ipspace.uuid", "mtu", "ports"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading network_broadcast_domain info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP NetworkBroadcastDomainGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read network_broadcast_domain: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// GetNetworkBroadcastDomains to get the broadcast domains matching a filter
+func GetNetworkBroadcastDomains(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *NetworkBroadcastDomainDataSourceFilterModel) ([]NetworkBroadcastDomainGetDataModelONTAP, error) {
+	api := "network/ethernet/broadcast-domains"
+	query := r.NewQuery()
+	query.Fields([]string{"name", "uuid", "ipspace.name", "ipspace.uuid", "mtu", "ports"})
+	if filter != nil {
+		var filterMap map[string]interface{}
+		if err := mapstructure.Decode(filter, &filterMap); err != nil {
+			return nil, errorHandler.MakeAndReportError("error encoding network_broadcast_domains filter info", fmt.Sprintf("error on filter %#v: %s", filter, err))
+		}
+		query.SetValues(filterMap)
+	}
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading network_broadcast_domains info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []NetworkBroadcastDomainGetDataModelONTAP
+	for _, info := range response {
+		var record NetworkBroadcastDomainGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read network_broadcast_domains: %#v", dataONTAP))
+	return dataONTAP, nil
+}
+
+// CreateNetworkBroadcastDomain to create a broadcast domain
+func CreateNetworkBroadcastDomain(errorHandler *utils.ErrorHandler, r restclient.RestClient, body NetworkBroadcastDomainResourceBodyDataModelONTAP) (*NetworkBroadcastDomainGetDataModelONTAP, error) {
+	api := "network/ethernet/broadcast-domains"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return nil, errorHandler.MakeAndReportError("error encoding network_broadcast_domain body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	query := r.NewQuery()
+	query.Add("return_records", "true")
+	statusCode, response, err := r.CallCreateMethod(api, query, bodyMap)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error creating network_broadcast_domain", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if len(response.Records) == 0 {
+		return nil, errorHandler.MakeAndReportError("error creating network_broadcast_domain", fmt.Sprintf("no record returned on POST %s, statusCode %d", api, statusCode))
+	}
+
+	var dataONTAP NetworkBroadcastDomainGetDataModelONTAP
+	if err := mapstructure.Decode(response.Records[0], &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError("error decoding network_broadcast_domain info", fmt.Sprintf("error on decode %s info: %s, statusCode %d, response %#v", api, err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Create network_broadcast_domain: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// UpdateNetworkBroadcastDomain to rename a broadcast domain or change its MTU
+func UpdateNetworkBroadcastDomain(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string, body NetworkBroadcastDomainResourceBodyDataModelONTAP) error {
+	api := "network/ethernet/broadcast-domains/" + uuid
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding network_broadcast_domain body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating network_broadcast_domain", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteNetworkBroadcastDomain to delete a broadcast domain
+func DeleteNetworkBroadcastDomain(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) error {
+	api := "network/ethernet/broadcast-domains/" + uuid
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting network_broadcast_domain", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
@@ -0,0 +1,264 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var basicBroadcastDomainRecord = NetworkBroadcastDomainGetDataModelONTAP{
+	Name:    "bd1",
+	UUID:    "1234",
+	IPspace: NetworkIPspaceGetDataModelONTAP{Name: "ipspace1", UUID: "5678"},
+	MTU:     1500,
+}
+
+func TestGetNetworkBroadcastDomains(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+
+	// ports are returned as a list of nested records by ONTAP
+	recordInterface := map[string]any{
+		"name":    "bd1",
+		"uuid":    "1234",
+		"ipspace": map[string]any{"name": "ipspace1", "uuid": "5678"},
+		"mtu":     9000,
+		"ports": []any{
+			map[string]any{"name": "e0c", "uuid": "p1", "node": map[string]any{"name": "node1"}},
+			map[string]any{"name": "e0d", "uuid": "p2", "node": map[string]any{"name": "node2"}},
+		},
+	}
+	record := NetworkBroadcastDomainGetDataModelONTAP{
+		Name:    "bd1",
+		UUID:    "1234",
+		IPspace: NetworkIPspaceGetDataModelONTAP{Name: "ipspace1", UUID: "5678"},
+		MTU:     9000,
+		Ports: []NetworkPortReference{
+			{Name: "e0c", UUID: "p1", Node: NameDataModel{Name: "node1"}},
+			{Name: "e0d", UUID: "p2", Node: NameDataModel{Name: "node2"}},
+		},
+	}
+	genericError := errors.New("generic error for UT")
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	badRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{{"mtu": "big"}}}
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records": {
+			{ExpectedMethod: "GET", ExpectedURL: "network/ethernet/broadcast-domains", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record": {
+			{ExpectedMethod: "GET", ExpectedURL: "network/ethernet/broadcast-domains", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "network/ethernet/broadcast-domains", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "network/ethernet/broadcast-domains", StatusCode: 200, Response: badRecord, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      []NetworkBroadcastDomainGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records", responses: responses["test_no_records"], want: nil, wantErr: false},
+		{name: "test_one_record", responses: responses["test_one_record"], want: []NetworkBroadcastDomainGetDataModelONTAP{record}, wantErr: false},
+		{name: "test_error", responses: responses["test_error"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetNetworkBroadcastDomains(errorHandler, *r, &NetworkBroadcastDomainDataSourceFilterModel{IPspaceName: "ipspace1"})
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetNetworkBroadcastDomains() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetNetworkBroadcastDomains() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetNetworkBroadcastDomainByName(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+
+	var recordInterface map[string]any
+	err := mapstructure.Decode(basicBroadcastDomainRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	badRecordInterface := map[string]any{"mtu": "big"}
+	genericError := errors.New("generic error for UT")
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	twoRecords := restclient.RestResponse{NumRecords: 2, Records: []map[string]any{recordInterface, recordInterface}}
+	decodeError := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records": {
+			{ExpectedMethod: "GET", ExpectedURL: "network/ethernet/broadcast-domains", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record": {
+			{ExpectedMethod: "GET", ExpectedURL: "network/ethernet/broadcast-domains", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_two_records_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "network/ethernet/broadcast-domains", StatusCode: 200, Response: twoRecords, Err: nil},
+		},
+		"test_get_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "network/ethernet/broadcast-domains", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "network/ethernet/broadcast-domains", StatusCode: 200, Response: decodeError, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *NetworkBroadcastDomainGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records", responses: responses["test_no_records"], want: nil, wantErr: false},
+		{name: "test_one_record", responses: responses["test_one_record"], want: &basicBroadcastDomainRecord, wantErr: false},
+		{name: "test_two_records_error", responses: responses["test_two_records_error"], want: nil, wantErr: true},
+		{name: "test_get_error", responses: responses["test_get_error"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetNetworkBroadcastDomainByName(errorHandler, *r, "bd1", "ipspace1")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetNetworkBroadcastDomainByName() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetNetworkBroadcastDomainByName() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateNetworkBroadcastDomain(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+
+	var recordInterface map[string]any
+	err := mapstructure.Decode(basicBroadcastDomainRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	genericError := errors.New("generic error for UT")
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	responses := map[string][]restclient.MockResponse{
+		"test_create": {
+			{ExpectedMethod: "POST", ExpectedURL: "network/ethernet/broadcast-domains", StatusCode: 201, Response: oneRecord, Err: nil},
+		},
+		"test_no_records": {
+			{ExpectedMethod: "POST", ExpectedURL: "network/ethernet/broadcast-domains", StatusCode: 201, Response: noRecords, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "POST", ExpectedURL: "network/ethernet/broadcast-domains", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *NetworkBroadcastDomainGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_create", responses: responses["test_create"], want: &basicBroadcastDomainRecord, wantErr: false},
+		{name: "test_no_records", responses: responses["test_no_records"], want: nil, wantErr: true},
+		{name: "test_error", responses: responses["test_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			body := NetworkBroadcastDomainResourceBodyDataModelONTAP{Name: "bd1", IPspace: &NetworkIPspaceResourceBodyDataModelONTAP{Name: "ipspace1"}, MTU: 1500}
+			got, err := CreateNetworkBroadcastDomain(errorHandler, *r, body)
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CreateNetworkBroadcastDomain() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CreateNetworkBroadcastDomain() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateDeleteNetworkBroadcastDomain(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	responses := map[string][]restclient.MockResponse{
+		"test_update": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "network/ethernet/broadcast-domains/1234", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_update_error": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "network/ethernet/broadcast-domains/1234", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_delete": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "network/ethernet/broadcast-domains/1234", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_delete_not_found": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "network/ethernet/broadcast-domains/1234", StatusCode: 404, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		action    string
+		wantErr   bool
+	}{
+		{name: "test_update", responses: responses["test_update"], action: "update", wantErr: false},
+		{name: "test_update_error", responses: responses["test_update_error"], action: "update", wantErr: true},
+		{name: "test_delete", responses: responses["test_delete"], action: "delete", wantErr: false},
+		{name: "test_delete_not_found", responses: responses["test_delete_not_found"], action: "delete", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			switch tt.action {
+			case "update":
+				err = UpdateNetworkBroadcastDomain(errorHandler, *r, "1234", NetworkBroadcastDomainResourceBodyDataModelONTAP{MTU: 9000})
+			case "delete":
+				err = DeleteNetworkBroadcastDomain(errorHandler, *r, "1234")
+			}
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("%s error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			}
+		})
+	}
+}
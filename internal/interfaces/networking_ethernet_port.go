@@ -0,0 +1,213 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// NetworkEthernetPortGetDataModelONTAP describes the GET record data model using go types for mapping.
+type NetworkEthernetPortGetDataModelONTAP struct {
+	Name            string                             `mapstructure:"name"`
+	UUID            string                             `mapstructure:"uuid"`
+	Type            string                             `mapstructure:"type"`
+	Node            NameDataModel                      `mapstructure:"node"`
+	BroadcastDomain NetworkEthernetPortBroadcastDomain `mapstructure:"broadcast_domain"`
+	Enabled         bool                               `mapstructure:"enabled"`
+	MTU             int64                              `mapstructure:"mtu"`
+	Speed           int64                              `mapstructure:"speed"`
+	State           string                             `mapstructure:"state"`
+	Vlan            NetworkEthernetPortVlan            `mapstructure:"vlan"`
+	Lag             NetworkEthernetPortLag             `mapstructure:"lag"`
+}
+
+// NetworkEthernetPortBroadcastDomain describes the broadcast domain of a port.
+type NetworkEthernetPortBroadcastDomain struct {
+	Name    string                                   `mapstructure:"name"`
+	IPspace NetworkIPspaceResourceBodyDataModelONTAP `mapstructure:"ipspace"`
+}
+
+// NetworkEthernetPortVlan describes the VLAN tag and base port of a vlan port.
+type NetworkEthernetPortVlan struct {
+	Tag      int64                `mapstructure:"tag"`
+	BasePort NetworkPortReference `mapstructure:"base_port"`
+}
+
+// NetworkEthernetPortLag describes the link aggregation group of a lag port.
+type NetworkEthernetPortLag struct {
+	Mode               string                 `mapstructure:"mode"`
+	DistributionPolicy string                 `mapstructure:"distribution_policy"`
+	MemberPorts        []NetworkPortReference `mapstructure:"member_ports"`
+	ActivePorts        []NetworkPortReference `mapstructure:"active_ports"`
+}
+
+// NetworkEthernetPortResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+type NetworkEthernetPortResourceBodyDataModelONTAP struct {
+	Type            string                              `mapstructure:"type,omitempty"`
+	Node            map[string]string                   `mapstructure:"node,omitempty"`
+	Enabled         *bool                               `mapstructure:"enabled,omitempty"`
+	BroadcastDomain *NetworkEthernetPortBroadcastDomain `mapstructure:"broadcast_domain,omitempty"`
+	Vlan            *NetworkEthernetPortVlanBody        `mapstructure:"vlan,omitempty"`
+	Lag             *NetworkEthernetPortLagBody         `mapstructure:"lag,omitempty"`
+}
+
+// NetworkEthernetPortVlanBody describes the vlan body, only used on create.
+type NetworkEthernetPortVlanBody struct {
+	Tag      int64                    `mapstructure:"tag"`
+	BasePort NetworkPortReferenceBody `mapstructure:"base_port"`
+}
+
+// NetworkEthernetPortLagBody describes the lag body, mode and distribution policy are only used on create.
+type NetworkEthernetPortLagBody struct {
+	Mode               string                     `mapstructure:"mode,omitempty"`
+	DistributionPolicy string                     `mapstructure:"distribution_policy,omitempty"`
+	MemberPorts        []NetworkPortReferenceBody `mapstructure:"member_ports"`
+}
+
+// NetworkPortReferenceBody describes a port reference in a body.
+type NetworkPortReferenceBody struct {
+	Name string            `mapstructure:"name"`
+	Node map[string]string `mapstructure:"node"`
+}
+
+// NetworkEthernetPortDataSourceFilterModel describes the data source data model for queries.
+type NetworkEthernetPortDataSourceFilterModel struct {
+	Name                string `mapstructure:"name"`
+	NodeName            string `mapstructure:"node.name"`
+	Type                string `mapstructure:"type"`
+	BroadcastDomainName string `mapstructure:"broadcast_domain.name"`
+}
+
+var networkEthernetPortFields = []string{"name", "uuid", "type", "node.name", "broadcast_domain.name", "broadcast_domain.ipspace.name",
+	"enabled", "mtu", "speed", "state", "vlan", "lag"}
+
+// GetNetworkEthernetPortByName to get a port by node and name
+func GetNetworkEthernetPortByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, nodeName string, name string) (*NetworkEthernetPortGetDataModelONTAP, error) {
+	api := "network/ethernet/ports"
+	query := r.NewQuery()
+	query.Set("node.name", nodeName)
+	query.Set("name", name)
+	query.Fields(networkEthernetPortFields)
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading network_ethernet_port info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP NetworkEthernetPortGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read network_ethernet_port: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// GetNetworkEthernetPort to get a port by UUID
+func GetNetworkEthernetPort(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) (*NetworkEthernetPortGetDataModelONTAP, error) {
+	api := "network/ethernet/ports/" + uuid
+	query := r.NewQuery()
+	query.Fields(networkEthernetPortFields)
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading network_ethernet_port info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP NetworkEthernetPortGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read network_ethernet_port: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// GetNetworkEthernetPorts to get the ports matching a filter
+func GetNetworkEthernetPorts(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *NetworkEthernetPortDataSourceFilterModel) ([]NetworkEthernetPortGetDataModelONTAP, error) {
+	api := "network/ethernet/ports"
+	query := r.NewQuery()
+	query.Fields(networkEthernetPortFields)
+	if filter != nil {
+		var filterMap map[string]interface{}
+		if err := mapstructure.Decode(filter, &filterMap); err != nil {
+			return nil, errorHandler.MakeAndReportError("error encoding network_ethernet_ports filter info", fmt.Sprintf("error on filter %#v: %s", filter, err))
+		}
+		query.SetValues(filterMap)
+	}
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading network_ethernet_ports info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []NetworkEthernetPortGetDataModelONTAP
+	for _, info := range response {
+		var record NetworkEthernetPortGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read network_ethernet_ports: %#v", dataONTAP))
+	return dataONTAP, nil
+}
+
+// CreateNetworkEthernetPort to create a vlan or lag port
+func CreateNetworkEthernetPort(errorHandler *utils.ErrorHandler, r restclient.RestClient, body NetworkEthernetPortResourceBodyDataModelONTAP) (*NetworkEthernetPortGetDataModelONTAP, error) {
+	api := "network/ethernet/ports"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return nil, errorHandler.MakeAndReportError("error encoding network_ethernet_port body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	query := r.NewQuery()
+	query.Add("return_records", "true")
+	statusCode, response, err := r.CallCreateMethod(api, query, bodyMap)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error creating network_ethernet_port", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if len(response.Records) == 0 {
+		return nil, errorHandler.MakeAndReportError("error creating network_ethernet_port", fmt.Sprintf("no record returned on POST %s, statusCode %d", api, statusCode))
+	}
+
+	var dataONTAP NetworkEthernetPortGetDataModelONTAP
+	if err := mapstructure.Decode(response.Records[0], &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError("error decoding network_ethernet_port info", fmt.Sprintf("error on decode %s info: %s, statusCode %d, response %#v", api, err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Create network_ethernet_port: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// UpdateNetworkEthernetPort to update a port, this is also how a port joins a broadcast domain
+func UpdateNetworkEthernetPort(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string, body NetworkEthernetPortResourceBodyDataModelONTAP) error {
+	api := "network/ethernet/ports/" + uuid
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding network_ethernet_port body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating network_ethernet_port", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteNetworkEthernetPort to delete a vlan or lag port
+func DeleteNetworkEthernetPort(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) error {
+	api := "network/ethernet/ports/" + uuid
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting network_ethernet_port", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
@@ -0,0 +1,234 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var basicEthernetPortRecord = NetworkEthernetPortGetDataModelONTAP{
+	Name:    "e0c-100",
+	UUID:    "5678",
+	Type:    "vlan",
+	Node:    NameDataModel{Name: "node1"},
+	Enabled: true,
+	Vlan:    NetworkEthernetPortVlan{Tag: 100, BasePort: NetworkPortReference{Name: "e0c", Node: NameDataModel{Name: "node1"}}},
+}
+
+func TestGetNetworkEthernetPortByName(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+
+	lagInterface := map[string]any{
+		"name":             "a0a",
+		"uuid":             "1234",
+		"type":             "lag",
+		"node":             map[string]any{"name": "node1"},
+		"broadcast_domain": map[string]any{"name": "bd1", "ipspace": map[string]any{"name": "ipspace1"}},
+		"enabled":          true,
+		"mtu":              9000,
+		"lag": map[string]any{
+			"mode":                "multimode_lacp",
+			"distribution_policy": "port",
+			"member_ports": []any{
+				map[string]any{"name": "e0c", "node": map[string]any{"name": "node1"}},
+				map[string]any{"name": "e0d", "node": map[string]any{"name": "node1"}},
+			},
+		},
+	}
+	lag := NetworkEthernetPortGetDataModelONTAP{
+		Name:            "a0a",
+		UUID:            "1234",
+		Type:            "lag",
+		Node:            NameDataModel{Name: "node1"},
+		BroadcastDomain: NetworkEthernetPortBroadcastDomain{Name: "bd1", IPspace: NetworkIPspaceResourceBodyDataModelONTAP{Name: "ipspace1"}},
+		Enabled:         true,
+		MTU:             9000,
+		Lag: NetworkEthernetPortLag{
+			Mode:               "multimode_lacp",
+			DistributionPolicy: "port",
+			MemberPorts: []NetworkPortReference{
+				{Name: "e0c", Node: NameDataModel{Name: "node1"}},
+				{Name: "e0d", Node: NameDataModel{Name: "node1"}},
+			},
+		},
+	}
+	vlanInterface := map[string]any{
+		"name": "e0c-100",
+		"uuid": "5678",
+		"type": "vlan",
+		"node": map[string]any{"name": "node1"},
+		"vlan": map[string]any{"tag": 100, "base_port": map[string]any{"name": "e0c", "node": map[string]any{"name": "node1"}}},
+	}
+	vlan := NetworkEthernetPortGetDataModelONTAP{
+		Name: "e0c-100",
+		UUID: "5678",
+		Type: "vlan",
+		Node: NameDataModel{Name: "node1"},
+		Vlan: NetworkEthernetPortVlan{Tag: 100, BasePort: NetworkPortReference{Name: "e0c", Node: NameDataModel{Name: "node1"}}},
+	}
+	genericError := errors.New("generic error for UT")
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	lagRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{lagInterface}}
+	vlanRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{vlanInterface}}
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records": {
+			{ExpectedMethod: "GET", ExpectedURL: "network/ethernet/ports", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_lag": {
+			{ExpectedMethod: "GET", ExpectedURL: "network/ethernet/ports", StatusCode: 200, Response: lagRecord, Err: nil},
+		},
+		"test_vlan": {
+			{ExpectedMethod: "GET", ExpectedURL: "network/ethernet/ports", StatusCode: 200, Response: vlanRecord, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "network/ethernet/ports", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *NetworkEthernetPortGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records", responses: responses["test_no_records"], want: nil, wantErr: false},
+		{name: "test_lag", responses: responses["test_lag"], want: &lag, wantErr: false},
+		{name: "test_vlan", responses: responses["test_vlan"], want: &vlan, wantErr: false},
+		{name: "test_error", responses: responses["test_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetNetworkEthernetPortByName(errorHandler, *r, "node1", "port")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetNetworkEthernetPortByName() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetNetworkEthernetPortByName() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateNetworkEthernetPort(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+
+	var recordInterface map[string]any
+	err := mapstructure.Decode(basicEthernetPortRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	genericError := errors.New("generic error for UT")
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	responses := map[string][]restclient.MockResponse{
+		"test_create": {
+			{ExpectedMethod: "POST", ExpectedURL: "network/ethernet/ports", StatusCode: 201, Response: oneRecord, Err: nil},
+		},
+		"test_no_records": {
+			{ExpectedMethod: "POST", ExpectedURL: "network/ethernet/ports", StatusCode: 201, Response: noRecords, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "POST", ExpectedURL: "network/ethernet/ports", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *NetworkEthernetPortGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_create", responses: responses["test_create"], want: &basicEthernetPortRecord, wantErr: false},
+		{name: "test_no_records", responses: responses["test_no_records"], want: nil, wantErr: true},
+		{name: "test_error", responses: responses["test_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			body := NetworkEthernetPortResourceBodyDataModelONTAP{
+				Type: "vlan",
+				Node: map[string]string{"name": "node1"},
+				Vlan: &NetworkEthernetPortVlanBody{Tag: 100, BasePort: NetworkPortReferenceBody{Name: "e0c", Node: map[string]string{"name": "node1"}}},
+			}
+			got, err := CreateNetworkEthernetPort(errorHandler, *r, body)
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CreateNetworkEthernetPort() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CreateNetworkEthernetPort() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateDeleteNetworkEthernetPort(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	responses := map[string][]restclient.MockResponse{
+		"test_update": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "network/ethernet/ports/5678", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_update_error": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "network/ethernet/ports/5678", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_delete": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "network/ethernet/ports/5678", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_delete_not_found": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "network/ethernet/ports/5678", StatusCode: 404, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		action    string
+		wantErr   bool
+	}{
+		{name: "test_update", responses: responses["test_update"], action: "update", wantErr: false},
+		{name: "test_update_error", responses: responses["test_update_error"], action: "update", wantErr: true},
+		{name: "test_delete", responses: responses["test_delete"], action: "delete", wantErr: false},
+		{name: "test_delete_not_found", responses: responses["test_delete_not_found"], action: "delete", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			switch tt.action {
+			case "update":
+				enabled := false
+				err = UpdateNetworkEthernetPort(errorHandler, *r, "5678", NetworkEthernetPortResourceBodyDataModelONTAP{Enabled: &enabled})
+			case "delete":
+				err = DeleteNetworkEthernetPort(errorHandler, *r, "5678")
+			}
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("%s error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// NetworkIPBgpPeerGroupGetDataModelONTAP describes the GET record data model using go types for mapping.
+type NetworkIPBgpPeerGroupGetDataModelONTAP struct {
+	Name    string                          `mapstructure:"name"`
+	UUID    string                          `mapstructure:"uuid"`
+	IPspace NetworkIPspaceGetDataModelONTAP `mapstructure:"ipspace"`
+	Local   NetworkIPBgpPeerGroupLocal      `mapstructure:"local"`
+	Peer    NetworkIPBgpPeerGroupPeer       `mapstructure:"peer"`
+	State   string                          `mapstructure:"state"`
+}
+
+// NetworkIPBgpPeerGroupLocal describes the local interface the BGP session is established from.
+type NetworkIPBgpPeerGroupLocal struct {
+	Interface BgpPeerGroupInterface `mapstructure:"interface"`
+}
+
+// BgpPeerGroupInterface describes the local interface by name.
+type BgpPeerGroupInterface struct {
+	Name string `mapstructure:"name"`
+}
+
+// NetworkIPBgpPeerGroupPeer describes the BGP router of the peer group.
+type NetworkIPBgpPeerGroupPeer struct {
+	Address   string `mapstructure:"address,omitempty"`
+	Asn       int64  `mapstructure:"asn,omitempty"`
+	IsNextHop *bool  `mapstructure:"is_next_hop,omitempty"`
+}
+
+// NetworkIPBgpPeerGroupResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+type NetworkIPBgpPeerGroupResourceBodyDataModelONTAP struct {
+	Name    string                                    `mapstructure:"name,omitempty"`
+	IPspace *NetworkIPspaceResourceBodyDataModelONTAP `mapstructure:"ipspace,omitempty"`
+	Local   *NetworkIPBgpPeerGroupLocal               `mapstructure:"local,omitempty"`
+	Peer    *NetworkIPBgpPeerGroupPeer                `mapstructure:"peer,omitempty"`
+}
+
+// GetNetworkIPBgpPeerGroupByName to get a BGP peer group by name and ipspace
+func GetNetworkIPBgpPeerGroupByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string, ipspaceName string) (*NetworkIPBgpPeerGroupGetDataModelONTAP, error) {
+	api := "network/ip/bgp/peer-groups"
+	query := r.NewQuery()
+	query.Set("name", name)
+	query.Set("ipspace.name", ipspaceName)
+	query.Fields([]string{"name", "uuid", "ipspace.name", "ipspace.uuid", "local.interface.name", "peer", "state"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading network_ip_bgp_peer_group info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP NetworkIPBgpPeerGroupGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read network_ip_bgp_peer_group: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// CreateNetworkIPBgpPeerGroup to create a BGP peer group
+func CreateNetworkIPBgpPeerGroup(errorHandler *utils.ErrorHandler, r restclient.RestClient, body NetworkIPBgpPeerGroupResourceBodyDataModelONTAP) (*NetworkIPBgpPeerGroupGetDataModelONTAP, error) {
+	api := "network/ip/bgp/peer-groups"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return nil, errorHandler.MakeAndReportError("error encoding network_ip_bgp_peer_group body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	query := r.NewQuery()
+	query.Add("return_records", "true")
+	statusCode, response, err := r.CallCreateMethod(api, query, bodyMap)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error creating network_ip_bgp_peer_group", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if len(response.Records) == 0 {
+		return nil, errorHandler.MakeAndReportError("error creating network_ip_bgp_peer_group", fmt.Sprintf("no record returned on POST %s, statusCode %d", api, statusCode))
+	}
+
+	var dataONTAP NetworkIPBgpPeerGroupGetDataModelONTAP
+	if err := mapstructure.Decode(response.Records[0], &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError("error decoding network_ip_bgp_peer_group info", fmt.Sprintf("error on decode %s info: %s, statusCode %d, response %#v", api, err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Create network_ip_bgp_peer_group: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// UpdateNetworkIPBgpPeerGroup to rename a BGP peer group or change its peer address
+func UpdateNetworkIPBgpPeerGroup(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string, body NetworkIPBgpPeerGroupResourceBodyDataModelONTAP) error {
+	api := "network/ip/bgp/peer-groups/" + uuid
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding network_ip_bgp_peer_group body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating network_ip_bgp_peer_group", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteNetworkIPBgpPeerGroup to delete a BGP peer group
+func DeleteNetworkIPBgpPeerGroup(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) error {
+	api := "network/ip/bgp/peer-groups/" + uuid
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting network_ip_bgp_peer_group", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
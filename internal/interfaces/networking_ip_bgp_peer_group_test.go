@@ -0,0 +1,140 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var ipBgpPeerGroupRecordInterface = map[string]any{
+	"name":    "bgp_group",
+	"uuid":    "1234",
+	"ipspace": map[string]any{"name": "Default", "uuid": "5678"},
+	"local":   map[string]any{"interface": map[string]any{"name": "bgp_lif", "uuid": "9012"}},
+	"peer":    map[string]any{"address": "10.10.10.1", "asn": 65501, "is_next_hop": false},
+	"state":   "up",
+}
+
+var ipBgpPeerGroupIsNextHop = false
+
+var ipBgpPeerGroupRecord = NetworkIPBgpPeerGroupGetDataModelONTAP{
+	Name:    "bgp_group",
+	UUID:    "1234",
+	IPspace: NetworkIPspaceGetDataModelONTAP{Name: "Default", UUID: "5678"},
+	Local:   NetworkIPBgpPeerGroupLocal{Interface: BgpPeerGroupInterface{Name: "bgp_lif"}},
+	Peer:    NetworkIPBgpPeerGroupPeer{Address: "10.10.10.1", Asn: 65501, IsNextHop: &ipBgpPeerGroupIsNextHop},
+	State:   "up",
+}
+
+func TestGetNetworkIPBgpPeerGroupByName(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+
+	genericError := errors.New("generic error for UT")
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{ipBgpPeerGroupRecordInterface}}
+	badRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{{"peer": "10.10.10.1"}}}
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records": {
+			{ExpectedMethod: "GET", ExpectedURL: "network/ip/bgp/peer-groups", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record": {
+			{ExpectedMethod: "GET", ExpectedURL: "network/ip/bgp/peer-groups", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "network/ip/bgp/peer-groups", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "network/ip/bgp/peer-groups", StatusCode: 200, Response: badRecord, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *NetworkIPBgpPeerGroupGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records", responses: responses["test_no_records"], want: nil, wantErr: false},
+		{name: "test_one_record", responses: responses["test_one_record"], want: &ipBgpPeerGroupRecord, wantErr: false},
+		{name: "test_error", responses: responses["test_error"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetNetworkIPBgpPeerGroupByName(errorHandler, *r, "bgp_group", "Default")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetNetworkIPBgpPeerGroupByName() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetNetworkIPBgpPeerGroupByName() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateNetworkIPBgpPeerGroup(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+
+	genericError := errors.New("generic error for UT")
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{ipBgpPeerGroupRecordInterface}}
+	responses := map[string][]restclient.MockResponse{
+		"test_create": {
+			{ExpectedMethod: "POST", ExpectedURL: "network/ip/bgp/peer-groups", StatusCode: 201, Response: oneRecord, Err: nil},
+		},
+		"test_no_records": {
+			{ExpectedMethod: "POST", ExpectedURL: "network/ip/bgp/peer-groups", StatusCode: 201, Response: noRecords, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "POST", ExpectedURL: "network/ip/bgp/peer-groups", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	body := NetworkIPBgpPeerGroupResourceBodyDataModelONTAP{
+		Name:    "bgp_group",
+		IPspace: &NetworkIPspaceResourceBodyDataModelONTAP{Name: "Default"},
+		Local:   &NetworkIPBgpPeerGroupLocal{Interface: BgpPeerGroupInterface{Name: "bgp_lif"}},
+		Peer:    &NetworkIPBgpPeerGroupPeer{Address: "10.10.10.1", Asn: 65501},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *NetworkIPBgpPeerGroupGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_create", responses: responses["test_create"], want: &ipBgpPeerGroupRecord, wantErr: false},
+		{name: "test_no_records", responses: responses["test_no_records"], want: nil, wantErr: true},
+		{name: "test_error", responses: responses["test_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := CreateNetworkIPBgpPeerGroup(errorHandler, *r, body)
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CreateNetworkIPBgpPeerGroup() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CreateNetworkIPBgpPeerGroup() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
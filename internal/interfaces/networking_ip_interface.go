@@ -11,26 +11,52 @@ import (
 
 // IPInterfaceGetDataModelONTAP describes the GET record data model using go types for mapping.
 type IPInterfaceGetDataModelONTAP struct {
-	Name     string                      `mapstructure:"name"`
-	Scope    string                      `mapstructure:"scope"`
-	SVM      IPInterfaceSvmName          `mapstructure:"svm"`
-	UUID     string                      `mapstructure:"uuid"`
-	IP       IPInterfaceGetIP            `mapstructure:"ip"`
-	Location IPInterfaceResourceLocation `mapstructure:"location"`
+	Name          string                      `mapstructure:"name"`
+	Scope         string                      `mapstructure:"scope"`
+	SVM           IPInterfaceSvmName          `mapstructure:"svm"`
+	UUID          string                      `mapstructure:"uuid"`
+	Enabled       bool                        `mapstructure:"enabled"`
+	State         string                      `mapstructure:"state"`
+	Vip           bool                        `mapstructure:"vip"`
+	ServicePolicy IPInterfaceServicePolicy    `mapstructure:"service_policy"`
+	IP            IPInterfaceGetIP            `mapstructure:"ip"`
+	Location      IPInterfaceResourceLocation `mapstructure:"location"`
+	Statistics    IPInterfaceStatistics       `mapstructure:"statistics"`
+}
+
+// IPInterfaceStatistics describes the GET record data for the throughput counters, available in 9.8 and later.
+type IPInterfaceStatistics struct {
+	Status        string                `mapstructure:"status"`
+	Timestamp     string                `mapstructure:"timestamp"`
+	ThroughputRaw IPInterfaceThroughput `mapstructure:"throughput_raw"`
+}
+
+// IPInterfaceThroughput describes the read, write and total bytes counters.
+type IPInterfaceThroughput struct {
+	Read  int64 `mapstructure:"read"`
+	Write int64 `mapstructure:"write"`
+	Total int64 `mapstructure:"total"`
 }
 
 // IPInterfaceGetIP describes the GET record data for IP.
 type IPInterfaceGetIP struct {
 	Address string `mapstructure:"address"`
 	Netmask string `mapstructure:"netmask"`
+	Family  string `mapstructure:"family,omitempty"`
 }
 
 // IPInterfaceResourceBodyDataModelONTAP describes the body data model using go types for mapping.
 type IPInterfaceResourceBodyDataModelONTAP struct {
-	Name     string                      `mapstructure:"name"`
-	SVM      IPInterfaceSvmName          `mapstructure:"svm,omitempty"` // API errors if body contains svm name when updating. can not use universal 'svm struct'
-	IP       IPInterfaceResourceIP       `mapstructure:"ip"`
-	Location IPInterfaceResourceLocation `mapstructure:"location"`
+	Name          string                           `mapstructure:"name"`
+	SVM           *IPInterfaceSvmName              `mapstructure:"svm,omitempty"` // API errors if body contains svm name when updating. can not use universal 'svm struct'
+	Scope         string                           `mapstructure:"scope,omitempty"`
+	IPspace       *IPInterfaceIPspace              `mapstructure:"ipspace,omitempty"` // only for cluster scoped interfaces, such as intercluster interfaces
+	IP            *IPInterfaceResourceIP           `mapstructure:"ip,omitempty"`
+	Subnet        *IPInterfaceSubnet               `mapstructure:"subnet,omitempty"` // the address is allocated from the subnet when ip is not set
+	Location      *IPInterfaceResourceBodyLocation `mapstructure:"location,omitempty"`
+	ServicePolicy *IPInterfaceServicePolicy        `mapstructure:"service_policy,omitempty"`
+	Enabled       *bool                            `mapstructure:"enabled,omitempty"`
+	Vip           *bool                            `mapstructure:"vip,omitempty"`
 }
 
 // IPInterfaceSvmName describes the svm name specifcally for network ip interface.
@@ -38,16 +64,55 @@ type IPInterfaceSvmName struct {
 	Name string `mapstructure:"name,omitempty"`
 }
 
+// IPInterfaceIPspace describes the ipspace of a cluster scoped interface.
+type IPInterfaceIPspace struct {
+	Name string `mapstructure:"name,omitempty"`
+}
+
 // IPInterfaceResourceIP is the body data model for IP field
 type IPInterfaceResourceIP struct {
 	Address string `mapstructure:"address"`
 	Netmask int64  `mapstructure:"netmask"`
 }
 
-// IPInterfaceResourceLocation is the body data model for location field
+// IPInterfaceResourceLocation is the GET record data model for location field, node and port are the current location
 type IPInterfaceResourceLocation struct {
-	HomeNode IPInterfaceResourceHomeNode `mapstructure:"home_node,omitempty"`
-	HomePort IPInterfaceResourceHomePort `mapstructure:"home_port,omitempty"`
+	HomeNode        IPInterfaceResourceHomeNode `mapstructure:"home_node,omitempty"`
+	HomePort        IPInterfaceResourceHomePort `mapstructure:"home_port,omitempty"`
+	BroadcastDomain IPInterfaceBroadcastDomain  `mapstructure:"broadcast_domain,omitempty"`
+	Failover        string                      `mapstructure:"failover,omitempty"`
+	AutoRevert      bool                        `mapstructure:"auto_revert"`
+	Node            IPInterfaceResourceHomeNode `mapstructure:"node,omitempty"`
+	Port            IPInterfaceResourceHomePort `mapstructure:"port,omitempty"`
+	IsHome          bool                        `mapstructure:"is_home"`
+}
+
+// IPInterfaceResourceBodyLocation is the body data model for location field, only the fields that are set are sent.
+// node and port migrate the interface, is_home reverts it to its home port
+type IPInterfaceResourceBodyLocation struct {
+	HomeNode        *IPInterfaceResourceHomeNode `mapstructure:"home_node,omitempty"`
+	HomePort        *IPInterfaceResourceHomePort `mapstructure:"home_port,omitempty"`
+	BroadcastDomain *IPInterfaceBroadcastDomain  `mapstructure:"broadcast_domain,omitempty"`
+	Failover        string                       `mapstructure:"failover,omitempty"`
+	AutoRevert      *bool                        `mapstructure:"auto_revert,omitempty"`
+	Node            *IPInterfaceResourceHomeNode `mapstructure:"node,omitempty"`
+	Port            *IPInterfaceResourceHomePort `mapstructure:"port,omitempty"`
+	IsHome          *bool                        `mapstructure:"is_home,omitempty"`
+}
+
+// IPInterfaceBroadcastDomain is the data model for broadcast_domain field
+type IPInterfaceBroadcastDomain struct {
+	Name string `mapstructure:"name,omitempty"`
+}
+
+// IPInterfaceSubnet is the body data model for subnet field
+type IPInterfaceSubnet struct {
+	Name string `mapstructure:"name"`
+}
+
+// IPInterfaceServicePolicy is the data model for service_policy field
+type IPInterfaceServicePolicy struct {
+	Name string `mapstructure:"name,omitempty"`
 }
 
 // IPInterfaceResourceHomeNode is the body data model for home_node field
@@ -66,6 +131,7 @@ type IPInterfaceDataSourceFilterModel struct {
 	Name    string `tfsdk:"name"`
 	SVMName string `tfsdk:"svm_name"`
 	Scope   string `tfsdk:"scope"`
+	IPspace string `tfsdk:"ipspace"`
 }
 
 // GetIPInterface to get ip_interface info
@@ -78,7 +144,7 @@ func GetIPInterface(errorHandler *utils.ErrorHandler, r restclient.RestClient, i
 	// 	query.Set("svm.name", svmName)
 	// 	query.Set("scope", "svm")
 	// }
-	query.Fields([]string{"name", "svm.name", "ip", "scope", "location"})
+	query.Fields(ipInterfaceFields(nil))
 	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
 	if err == nil && response == nil {
 		err = fmt.Errorf("no response for GET %s", api)
@@ -96,8 +162,8 @@ func GetIPInterface(errorHandler *utils.ErrorHandler, r restclient.RestClient, i
 	return &dataONTAP, nil
 }
 
-// GetIPInterfaceByName to get ip_interface info
-func GetIPInterfaceByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string, svmName string) (*IPInterfaceGetDataModelONTAP, error) {
+// GetIPInterfaceByName to get ip_interface info, statistics are only read when version is set
+func GetIPInterfaceByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string, svmName string, version *versionModelONTAP) (*IPInterfaceGetDataModelONTAP, error) {
 	api := "network/ip/interfaces"
 	query := r.NewQuery()
 	query.Set("name", name)
@@ -107,7 +173,7 @@ func GetIPInterfaceByName(errorHandler *utils.ErrorHandler, r restclient.RestCli
 		query.Set("svm.name", svmName)
 		query.Set("scope", "svm")
 	}
-	query.Fields([]string{"name", "svm.name", "ip", "scope", "location"})
+	query.Fields(ipInterfaceFields(version))
 	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
 	if err == nil && response == nil {
 		err = fmt.Errorf("no response for GET %s", api)
@@ -125,11 +191,11 @@ func GetIPInterfaceByName(errorHandler *utils.ErrorHandler, r restclient.RestCli
 	return &dataONTAP, nil
 }
 
-// GetListIPInterfaces to get ip_interface info for all resources matching a filter
-func GetListIPInterfaces(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *IPInterfaceDataSourceFilterModel) ([]IPInterfaceGetDataModelONTAP, error) {
+// GetListIPInterfaces to get ip_interface info for all resources matching a filter, statistics are only read when version is set
+func GetListIPInterfaces(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *IPInterfaceDataSourceFilterModel, version *versionModelONTAP) ([]IPInterfaceGetDataModelONTAP, error) {
 	api := "network/ip/interfaces"
 	query := r.NewQuery()
-	query.Fields([]string{"name", "svm.name", "ip", "scope", "location"})
+	query.Fields(ipInterfaceFields(version))
 
 	if filter != nil {
 		if filter.Name != "" {
@@ -141,6 +207,9 @@ func GetListIPInterfaces(errorHandler *utils.ErrorHandler, r restclient.RestClie
 		if filter.Scope != "" {
 			query.Set("scope", filter.Scope)
 		}
+		if filter.IPspace != "" {
+			query.Set("ipspace.name", filter.IPspace)
+		}
 	}
 
 	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
@@ -164,6 +233,15 @@ func GetListIPInterfaces(errorHandler *utils.ErrorHandler, r restclient.RestClie
 	return dataONTAP, nil
 }
 
+// ipInterfaceFields returns the fields to read, statistics are not available before 9.8
+func ipInterfaceFields(version *versionModelONTAP) []string {
+	fields := []string{"name", "svm.name", "ip", "scope", "location", "enabled", "state", "vip", "service_policy.name"}
+	if version != nil && version.Generation == 9 && version.Major > 7 {
+		fields = append(fields, "statistics")
+	}
+	return fields
+}
+
 // CreateIPInterface to create ip_interface
 func CreateIPInterface(errorHandler *utils.ErrorHandler, r restclient.RestClient, body IPInterfaceResourceBodyDataModelONTAP) (*IPInterfaceGetDataModelONTAP, error) {
 	api := "network/ip/interfaces"
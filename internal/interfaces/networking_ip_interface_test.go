@@ -18,8 +18,14 @@ var ipInterfaceRecord = IPInterfaceGetDataModelONTAP{
 	SVM: IPInterfaceSvmName{
 		Name: "string",
 	},
-	Scope: "string",
-	UUID:  "string",
+	Scope:   "string",
+	UUID:    "string",
+	Enabled: true,
+	State:   "up",
+	Vip:     false,
+	ServicePolicy: IPInterfaceServicePolicy{
+		Name: "default-data-files",
+	},
 	IP: IPInterfaceGetIP{
 		Address: "string",
 		Netmask: "string",
@@ -34,23 +40,73 @@ var ipInterfaceRecord = IPInterfaceGetDataModelONTAP{
 				Name: "string",
 			},
 		},
+		BroadcastDomain: IPInterfaceBroadcastDomain{
+			Name: "Default",
+		},
+		Failover:   "home_node_only",
+		AutoRevert: true,
+		Node: IPInterfaceResourceHomeNode{
+			Name: "string",
+		},
+		Port: IPInterfaceResourceHomePort{
+			Name: "string",
+			Node: IPInterfaceResourceHomeNode{
+				Name: "string",
+			},
+		},
+		IsHome: true,
 	},
 }
 
 // create network ip interface body
 var basicNetworkIPInterfacesBody = IPInterfaceResourceBodyDataModelONTAP{
 	Name: "string",
-	IP: IPInterfaceResourceIP{
+	IP: &IPInterfaceResourceIP{
 		Address: "string",
 		Netmask: 16,
 	},
-	Location: IPInterfaceResourceLocation{
-		HomeNode: IPInterfaceResourceHomeNode{
+	Location: &IPInterfaceResourceBodyLocation{
+		HomeNode: &IPInterfaceResourceHomeNode{
 			Name: "string",
 		},
-		HomePort: IPInterfaceResourceHomePort{
+		HomePort: &IPInterfaceResourceHomePort{
+			Name: "string",
+		},
+	},
+}
+
+// create network ip interface body with the address allocated from a subnet
+var subnetNetworkIPInterfacesBody = IPInterfaceResourceBodyDataModelONTAP{
+	Name: "string",
+	Subnet: &IPInterfaceSubnet{
+		Name: "data_subnet",
+	},
+	Location: &IPInterfaceResourceBodyLocation{
+		HomeNode: &IPInterfaceResourceHomeNode{
+			Name: "string",
+		},
+	},
+}
+
+// create cluster scoped intercluster interface body
+var interclusterNetworkIPInterfacesBody = IPInterfaceResourceBodyDataModelONTAP{
+	Name:    "string",
+	Scope:   "cluster",
+	IPspace: &IPInterfaceIPspace{Name: "Default"},
+	IP: &IPInterfaceResourceIP{
+		Address: "string",
+		Netmask: 24,
+	},
+	Location: &IPInterfaceResourceBodyLocation{
+		HomeNode: &IPInterfaceResourceHomeNode{
 			Name: "string",
 		},
+		HomePort: &IPInterfaceResourceHomePort{
+			Name: "string",
+		},
+	},
+	ServicePolicy: &IPInterfaceServicePolicy{
+		Name: "default-intercluster",
 	},
 }
 
@@ -62,20 +118,36 @@ var badNetworkIPInterfacesBody = IPInterfaceResourceBodyDataModelONTAP{
 // update network ip interface body with different netmask
 var updateNetworkIPInterfacesBody = IPInterfaceResourceBodyDataModelONTAP{
 	Name: "string",
-	IP: IPInterfaceResourceIP{
+	IP: &IPInterfaceResourceIP{
 		Address: "string",
 		Netmask: 20,
 	},
-	Location: IPInterfaceResourceLocation{
-		HomeNode: IPInterfaceResourceHomeNode{
+	Location: &IPInterfaceResourceBodyLocation{
+		HomeNode: &IPInterfaceResourceHomeNode{
 			Name: "string",
 		},
-		HomePort: IPInterfaceResourceHomePort{
+		HomePort: &IPInterfaceResourceHomePort{
 			Name: "string",
 		},
 	},
 }
 
+// migrate network ip interface body, moves the interface to another node and port
+var migrateNetworkIPInterfacesBody = IPInterfaceResourceBodyDataModelONTAP{
+	Name: "string",
+	Location: &IPInterfaceResourceBodyLocation{
+		Node: &IPInterfaceResourceHomeNode{
+			Name: "node2",
+		},
+		Port: &IPInterfaceResourceHomePort{
+			Name: "e0d",
+			Node: IPInterfaceResourceHomeNode{
+				Name: "node2",
+			},
+		},
+	},
+}
+
 func TestGetIPInterface(t *testing.T) {
 	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
 
@@ -130,7 +202,7 @@ func TestGetIPInterface(t *testing.T) {
 			if err != nil {
 				panic(err)
 			}
-			got, err := GetIPInterfaceByName(errorHandler, *r, "name", "svmName")
+			got, err := GetIPInterfaceByName(errorHandler, *r, "name", "svmName", nil)
 			if err != nil {
 				fmt.Printf("err: %s\n", err)
 			}
@@ -202,7 +274,7 @@ func TestGetListIPInterfaces(t *testing.T) {
 			if err != nil {
 				panic(err)
 			}
-			got, err := GetListIPInterfaces(errorHandler, *r, &IPInterfaceDataSourceFilterModel{})
+			got, err := GetListIPInterfaces(errorHandler, *r, &IPInterfaceDataSourceFilterModel{}, nil)
 			if err != nil {
 				fmt.Printf("err: %s\n", err)
 			}
@@ -238,6 +310,12 @@ func TestCreateIPInterfaces(t *testing.T) {
 		"test_create_basic_record_1": {
 			{ExpectedMethod: "POST", ExpectedURL: "network/ip/interfaces", StatusCode: 200, Response: onebasicNetworkIPInterfaceRecord, Err: nil},
 		},
+		"test_create_subnet_record_1": {
+			{ExpectedMethod: "POST", ExpectedURL: "network/ip/interfaces", StatusCode: 200, Response: onebasicNetworkIPInterfaceRecord, Err: nil},
+		},
+		"test_create_intercluster_record_1": {
+			{ExpectedMethod: "POST", ExpectedURL: "network/ip/interfaces", StatusCode: 200, Response: onebasicNetworkIPInterfaceRecord, Err: nil},
+		},
 		"test_create_error_1": {
 			{ExpectedMethod: "POST", ExpectedURL: "network/ip/interfaces", StatusCode: 200, Response: decodeError, Err: nil},
 		},
@@ -250,6 +328,8 @@ func TestCreateIPInterfaces(t *testing.T) {
 		wantErr     bool
 	}{
 		{name: "test_create_basic_record_1", responses: responses["test_create_basic_record_1"], requestbody: basicNetworkIPInterfacesBody, want: &ipInterfaceRecord, wantErr: false},
+		{name: "test_create_subnet_record_1", responses: responses["test_create_subnet_record_1"], requestbody: subnetNetworkIPInterfacesBody, want: &ipInterfaceRecord, wantErr: false},
+		{name: "test_create_intercluster_record_1", responses: responses["test_create_intercluster_record_1"], requestbody: interclusterNetworkIPInterfacesBody, want: &ipInterfaceRecord, wantErr: false},
 		{name: "test_create_error_1", responses: responses["test_create_error_1"], requestbody: badNetworkIPInterfacesBody, want: nil, wantErr: true},
 	}
 	for _, tt := range tests {
@@ -329,6 +409,9 @@ func TestUpdateIPInterfaces(t *testing.T) {
 		"test_update_network_ip_interface": {
 			{ExpectedMethod: "PATCH", ExpectedURL: "network/ip/interfaces/12884901889", StatusCode: 200, Response: noRecords, Err: nil},
 		},
+		"test_migrate_network_ip_interface": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "network/ip/interfaces/12884901889", StatusCode: 200, Response: noRecords, Err: nil},
+		},
 		"test_update_error_1": {
 			{ExpectedMethod: "PATCH", ExpectedURL: "network/ip/interfaces/12884901889", StatusCode: 200, Response: noRecords, Err: genericError},
 		},
@@ -340,6 +423,7 @@ func TestUpdateIPInterfaces(t *testing.T) {
 		wantErr     bool
 	}{
 		{name: "test_update_network_ip_interface", responses: responses["test_update_network_ip_interface"], requestbody: updateNetworkIPInterfacesBody, wantErr: false},
+		{name: "test_migrate_network_ip_interface", responses: responses["test_migrate_network_ip_interface"], requestbody: migrateNetworkIPInterfacesBody, wantErr: false},
 		{name: "test_update_error_1", responses: responses["test_update_error_1"], requestbody: badNetworkIPInterfacesBody, wantErr: true},
 	}
 	for _, tt := range tests {
@@ -0,0 +1,110 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// NetworkIPServicePolicyGetDataModelONTAP describes the GET record data model using go types for mapping.
+type NetworkIPServicePolicyGetDataModelONTAP struct {
+	Name     string                          `mapstructure:"name"`
+	UUID     string                          `mapstructure:"uuid"`
+	Scope    string                          `mapstructure:"scope"`
+	SVM      SvmDataModelONTAP               `mapstructure:"svm"`
+	IPspace  NetworkIPspaceGetDataModelONTAP `mapstructure:"ipspace"`
+	Services []string                        `mapstructure:"services"`
+}
+
+// NetworkIPServicePolicyResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+type NetworkIPServicePolicyResourceBodyDataModelONTAP struct {
+	Name     string                                    `mapstructure:"name,omitempty"`
+	Scope    string                                    `mapstructure:"scope,omitempty"`
+	SVM      *SvmDataModelONTAP                        `mapstructure:"svm,omitempty"`
+	IPspace  *NetworkIPspaceResourceBodyDataModelONTAP `mapstructure:"ipspace,omitempty"`
+	Services []string                                  `mapstructure:"services"`
+}
+
+// GetNetworkIPServicePolicyByName to get a service policy by name, in a SVM or, when svmName is empty, in the cluster scope of an ipspace
+func GetNetworkIPServicePolicyByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string, svmName string, ipspaceName string) (*NetworkIPServicePolicyGetDataModelONTAP, error) {
+	api := "network/ip/service-policies"
+	query := r.NewQuery()
+	query.Set("name", name)
+	if svmName != "" {
+		query.Set("svm.name", svmName)
+		query.Set("scope", "svm")
+	} else {
+		query.Set("scope", "cluster")
+		if ipspaceName != "" {
+			query.Set("ipspace.name", ipspaceName)
+		}
+	}
+	query.Fields([]string{"name", "uuid", "scope", "svm.name", "svm.uuid", "ipspace.name", "ipspace.uuid", "services"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading network_ip_service_policy info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP NetworkIPServicePolicyGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read network_ip_service_policy: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// CreateNetworkIPServicePolicy to create a service policy
+func CreateNetworkIPServicePolicy(errorHandler *utils.ErrorHandler, r restclient.RestClient, body NetworkIPServicePolicyResourceBodyDataModelONTAP) (*NetworkIPServicePolicyGetDataModelONTAP, error) {
+	api := "network/ip/service-policies"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return nil, errorHandler.MakeAndReportError("error encoding network_ip_service_policy body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	query := r.NewQuery()
+	query.Add("return_records", "true")
+	statusCode, response, err := r.CallCreateMethod(api, query, bodyMap)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error creating network_ip_service_policy", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if len(response.Records) == 0 {
+		return nil, errorHandler.MakeAndReportError("error creating network_ip_service_policy", fmt.Sprintf("no record returned on POST %s, statusCode %d", api, statusCode))
+	}
+
+	var dataONTAP NetworkIPServicePolicyGetDataModelONTAP
+	if err := mapstructure.Decode(response.Records[0], &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError("error decoding network_ip_service_policy info", fmt.Sprintf("error on decode %s info: %s, statusCode %d, response %#v", api, err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Create network_ip_service_policy: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// UpdateNetworkIPServicePolicy to rename a service policy or change its services
+func UpdateNetworkIPServicePolicy(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string, body NetworkIPServicePolicyResourceBodyDataModelONTAP) error {
+	api := "network/ip/service-policies/" + uuid
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding network_ip_service_policy body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating network_ip_service_policy", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteNetworkIPServicePolicy to delete a service policy
+func DeleteNetworkIPServicePolicy(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) error {
+	api := "network/ip/service-policies/" + uuid
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting network_ip_service_policy", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+func TestGetNetworkIPServicePolicyByName(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+
+	recordInterface := map[string]any{
+		"name":     "nfs-only",
+		"uuid":     "1234",
+		"scope":    "svm",
+		"svm":      map[string]any{"name": "svm1", "uuid": "5678"},
+		"ipspace":  map[string]any{"name": "Default", "uuid": "9012"},
+		"services": []any{"data_core", "data_nfs"},
+	}
+	record := NetworkIPServicePolicyGetDataModelONTAP{
+		Name:     "nfs-only",
+		UUID:     "1234",
+		Scope:    "svm",
+		SVM:      SvmDataModelONTAP{Name: "svm1", UUID: "5678"},
+		IPspace:  NetworkIPspaceGetDataModelONTAP{Name: "Default", UUID: "9012"},
+		Services: []string{"data_core", "data_nfs"},
+	}
+	genericError := errors.New("generic error for UT")
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	badRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{{"services": "data_nfs"}}}
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records": {
+			{ExpectedMethod: "GET", ExpectedURL: "network/ip/service-policies", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record": {
+			{ExpectedMethod: "GET", ExpectedURL: "network/ip/service-policies", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "network/ip/service-policies", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "network/ip/service-policies", StatusCode: 200, Response: badRecord, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *NetworkIPServicePolicyGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records", responses: responses["test_no_records"], want: nil, wantErr: false},
+		{name: "test_one_record", responses: responses["test_one_record"], want: &record, wantErr: false},
+		{name: "test_error", responses: responses["test_error"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetNetworkIPServicePolicyByName(errorHandler, *r, "nfs-only", "svm1", "")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetNetworkIPServicePolicyByName() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetNetworkIPServicePolicyByName() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
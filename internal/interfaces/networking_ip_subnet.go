@@ -0,0 +1,118 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// NetworkIPSubnetGetDataModelONTAP describes the GET record data model using go types for mapping.
+type NetworkIPSubnetGetDataModelONTAP struct {
+	Name            string                          `mapstructure:"name"`
+	UUID            string                          `mapstructure:"uuid"`
+	IPspace         NetworkIPspaceGetDataModelONTAP `mapstructure:"ipspace"`
+	BroadcastDomain NameDataModel                   `mapstructure:"broadcast_domain"`
+	Subnet          NetworkIPSubnetAddress          `mapstructure:"subnet"`
+	Gateway         string                          `mapstructure:"gateway"`
+	IPRanges        []NetworkIPSubnetRange          `mapstructure:"ip_ranges"`
+	AvailableCount  int64                           `mapstructure:"available_count"`
+	TotalCount      int64                           `mapstructure:"total_count"`
+}
+
+// NetworkIPSubnetAddress describes the address and netmask of a subnet.
+type NetworkIPSubnetAddress struct {
+	Address string `mapstructure:"address"`
+	Netmask string `mapstructure:"netmask"`
+}
+
+// NetworkIPSubnetRange describes a range of addresses of a subnet that can be allocated to interfaces.
+type NetworkIPSubnetRange struct {
+	Start string `mapstructure:"start"`
+	End   string `mapstructure:"end"`
+}
+
+// NetworkIPSubnetResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+type NetworkIPSubnetResourceBodyDataModelONTAP struct {
+	Name            string                                    `mapstructure:"name,omitempty"`
+	IPspace         *NetworkIPspaceResourceBodyDataModelONTAP `mapstructure:"ipspace,omitempty"`
+	BroadcastDomain *IPInterfaceBroadcastDomain               `mapstructure:"broadcast_domain,omitempty"`
+	Subnet          *NetworkIPSubnetAddress                   `mapstructure:"subnet,omitempty"`
+	Gateway         *string                                   `mapstructure:"gateway,omitempty"`
+	IPRanges        *[]map[string]string                      `mapstructure:"ip_ranges,omitempty"` // an empty list removes all the ranges
+}
+
+// GetNetworkIPSubnetByName to get a subnet by name and ipspace
+func GetNetworkIPSubnetByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string, ipspaceName string) (*NetworkIPSubnetGetDataModelONTAP, error) {
+	api := "network/ip/subnets"
+	query := r.NewQuery()
+	query.Set("name", name)
+	query.Set("ipspace.name", ipspaceName)
+	query.Fields([]string{"name", "uuid", "ipspace.name", "ipspace.uuid", "broadcast_domain.name", "subnet", "gateway", "ip_ranges", "available_count", "total_count"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading network_ip_subnet info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP NetworkIPSubnetGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read network_ip_subnet: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// CreateNetworkIPSubnet to create a subnet
+func CreateNetworkIPSubnet(errorHandler *utils.ErrorHandler, r restclient.RestClient, body NetworkIPSubnetResourceBodyDataModelONTAP) (*NetworkIPSubnetGetDataModelONTAP, error) {
+	api := "network/ip/subnets"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return nil, errorHandler.MakeAndReportError("error encoding network_ip_subnet body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	query := r.NewQuery()
+	query.Add("return_records", "true")
+	statusCode, response, err := r.CallCreateMethod(api, query, bodyMap)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error creating network_ip_subnet", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if len(response.Records) == 0 {
+		return nil, errorHandler.MakeAndReportError("error creating network_ip_subnet", fmt.Sprintf("no record returned on POST %s, statusCode %d", api, statusCode))
+	}
+
+	var dataONTAP NetworkIPSubnetGetDataModelONTAP
+	if err := mapstructure.Decode(response.Records[0], &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError("error decoding network_ip_subnet info", fmt.Sprintf("error on decode %s info: %s, statusCode %d, response %#v", api, err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Create network_ip_subnet: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// UpdateNetworkIPSubnet to rename a subnet or change its gateway and ranges
+func UpdateNetworkIPSubnet(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string, body NetworkIPSubnetResourceBodyDataModelONTAP) error {
+	api := "network/ip/subnets/" + uuid
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding network_ip_subnet body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating network_ip_subnet", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteNetworkIPSubnet to delete a subnet
+func DeleteNetworkIPSubnet(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) error {
+	api := "network/ip/subnets/" + uuid
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting network_ip_subnet", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
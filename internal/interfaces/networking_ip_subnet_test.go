@@ -0,0 +1,147 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var ipSubnetRecordInterface = map[string]any{
+	"name":             "data_subnet",
+	"uuid":             "1234",
+	"ipspace":          map[string]any{"name": "Default", "uuid": "5678"},
+	"broadcast_domain": map[string]any{"name": "Default", "uuid": "9012"},
+	"subnet":           map[string]any{"address": "10.10.10.0", "netmask": "24", "family": "ipv4"},
+	"gateway":          "10.10.10.1",
+	"ip_ranges":        []any{map[string]any{"start": "10.10.10.20", "end": "10.10.10.29", "family": "ipv4"}},
+	"available_count":  9,
+	"total_count":      10,
+}
+
+var ipSubnetRecord = NetworkIPSubnetGetDataModelONTAP{
+	Name:            "data_subnet",
+	UUID:            "1234",
+	IPspace:         NetworkIPspaceGetDataModelONTAP{Name: "Default", UUID: "5678"},
+	BroadcastDomain: NameDataModel{Name: "Default", UUID: "9012"},
+	Subnet:          NetworkIPSubnetAddress{Address: "10.10.10.0", Netmask: "24"},
+	Gateway:         "10.10.10.1",
+	IPRanges:        []NetworkIPSubnetRange{{Start: "10.10.10.20", End: "10.10.10.29"}},
+	AvailableCount:  9,
+	TotalCount:      10,
+}
+
+func TestGetNetworkIPSubnetByName(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+
+	genericError := errors.New("generic error for UT")
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{ipSubnetRecordInterface}}
+	badRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{{"ip_ranges": "10.10.10.20-10.10.10.29"}}}
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records": {
+			{ExpectedMethod: "GET", ExpectedURL: "network/ip/subnets", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record": {
+			{ExpectedMethod: "GET", ExpectedURL: "network/ip/subnets", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "network/ip/subnets", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "network/ip/subnets", StatusCode: 200, Response: badRecord, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *NetworkIPSubnetGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records", responses: responses["test_no_records"], want: nil, wantErr: false},
+		{name: "test_one_record", responses: responses["test_one_record"], want: &ipSubnetRecord, wantErr: false},
+		{name: "test_error", responses: responses["test_error"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetNetworkIPSubnetByName(errorHandler, *r, "data_subnet", "Default")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetNetworkIPSubnetByName() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetNetworkIPSubnetByName() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateNetworkIPSubnet(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+
+	genericError := errors.New("generic error for UT")
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{ipSubnetRecordInterface}}
+	responses := map[string][]restclient.MockResponse{
+		"test_create": {
+			{ExpectedMethod: "POST", ExpectedURL: "network/ip/subnets", StatusCode: 201, Response: oneRecord, Err: nil},
+		},
+		"test_no_records": {
+			{ExpectedMethod: "POST", ExpectedURL: "network/ip/subnets", StatusCode: 201, Response: noRecords, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "POST", ExpectedURL: "network/ip/subnets", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	gateway := "10.10.10.1"
+	body := NetworkIPSubnetResourceBodyDataModelONTAP{
+		Name:            "data_subnet",
+		IPspace:         &NetworkIPspaceResourceBodyDataModelONTAP{Name: "Default"},
+		BroadcastDomain: &IPInterfaceBroadcastDomain{Name: "Default"},
+		Subnet:          &NetworkIPSubnetAddress{Address: "10.10.10.0", Netmask: "24"},
+		Gateway:         &gateway,
+		IPRanges:        &[]map[string]string{{"start": "10.10.10.20", "end": "10.10.10.29"}},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *NetworkIPSubnetGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_create", responses: responses["test_create"], want: &ipSubnetRecord, wantErr: false},
+		{name: "test_no_records", responses: responses["test_no_records"], want: nil, wantErr: true},
+		{name: "test_error", responses: responses["test_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := CreateNetworkIPSubnet(errorHandler, *r, body)
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CreateNetworkIPSubnet() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CreateNetworkIPSubnet() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
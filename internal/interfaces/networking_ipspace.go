@@ -0,0 +1,131 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// NetworkIPspaceGetDataModelONTAP describes the GET record data model using go types for mapping.
+type NetworkIPspaceGetDataModelONTAP struct {
+	Name string `mapstructure:"name"`
+	UUID string `mapstructure:"uuid"`
+}
+
+// NetworkIPspaceResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+type NetworkIPspaceResourceBodyDataModelONTAP struct {
+	Name string `mapstructure:"name"`
+}
+
+// NetworkIPspaceDataSourceFilterModel describes the data source data model for queries.
+type NetworkIPspaceDataSourceFilterModel struct {
+	Name string `mapstructure:"name"`
+}
+
+// GetNetworkIPspaceByName to get an ipspace by name
+func GetNetworkIPspaceByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string) (*NetworkIPspaceGetDataModelONTAP, error) {
+	api := "network/ipspaces"
+	query := r.NewQuery()
+	query.Set("name", name)
+	query.Fields([]string{"name", "uuid"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading network_ipspace info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP NetworkIPspaceGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read network_ipspace: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// GetNetworkIPspaces to get the ipspaces matching a filter
+func GetNetworkIPspaces(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *NetworkIPspaceDataSourceFilterModel) ([]NetworkIPspaceGetDataModelONTAP, error) {
+	api := "network/ipspaces"
+	query := r.NewQuery()
+	query.Fields([]string{"name", "uuid"})
+	if filter != nil {
+		var filterMap map[string]interface{}
+		if err := mapstructure.Decode(filter, &filterMap); err != nil {
+			return nil, errorHandler.MakeAndReportError("error encoding network_ipspaces filter info", fmt.Sprintf("error on filter %#v: %s", filter, err))
+		}
+		query.SetValues(filterMap)
+	}
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading network_ipspaces info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []NetworkIPspaceGetDataModelONTAP
+	for _, info := range response {
+		var record NetworkIPspaceGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read network_ipspaces: %#v", dataONTAP))
+	return dataONTAP, nil
+}
+
+// CreateNetworkIPspace to create an ipspace
+func CreateNetworkIPspace(errorHandler *utils.ErrorHandler, r restclient.RestClient, body NetworkIPspaceResourceBodyDataModelONTAP) (*NetworkIPspaceGetDataModelONTAP, error) {
+	api := "network/ipspaces"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return nil, errorHandler.MakeAndReportError("error encoding network_ipspace body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	query := r.NewQuery()
+	query.Add("return_records", "true")
+	statusCode, response, err := r.CallCreateMethod(api, query, bodyMap)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error creating network_ipspace", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if len(response.Records) == 0 {
+		return nil, errorHandler.MakeAndReportError("error creating network_ipspace", fmt.Sprintf("no record returned on POST %s, statusCode %d", api, statusCode))
+	}
+
+	var dataONTAP NetworkIPspaceGetDataModelONTAP
+	if err := mapstructure.Decode(response.Records[0], &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError("error decoding network_ipspace info", fmt.Sprintf("error on decode %s info: %s, statusCode %d, response %#v", api, err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Create network_ipspace: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// UpdateNetworkIPspace to rename an ipspace
+func UpdateNetworkIPspace(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string, body NetworkIPspaceResourceBodyDataModelONTAP) error {
+	api := "network/ipspaces/" + uuid
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding network_ipspace body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating network_ipspace", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteNetworkIPspace to delete an ipspace
+func DeleteNetworkIPspace(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) error {
+	api := "network/ipspaces/" + uuid
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting network_ipspace", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
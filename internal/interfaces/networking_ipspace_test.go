@@ -0,0 +1,183 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var networkIPspaceRecord = NetworkIPspaceGetDataModelONTAP{
+	Name: "ipspace1",
+	UUID: "1234",
+}
+
+func TestGetNetworkIPspaceByName(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+
+	var recordInterface map[string]any
+	err := mapstructure.Decode(networkIPspaceRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	badRecordInterface := map[string]any{"name": 123}
+	genericError := errors.New("generic error for UT")
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	badRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	twoRecords := restclient.RestResponse{NumRecords: 2, Records: []map[string]any{recordInterface, recordInterface}}
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "network/ipspaces", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "network/ipspaces", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_two_records_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "network/ipspaces", StatusCode: 200, Response: twoRecords, Err: genericError},
+		},
+		"test_error_3": {
+			{ExpectedMethod: "GET", ExpectedURL: "network/ipspaces", StatusCode: 200, Response: badRecord, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *NetworkIPspaceGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: &networkIPspaceRecord, wantErr: false},
+		{name: "test_two_records_error", responses: responses["test_two_records_error"], want: nil, wantErr: true},
+		{name: "test_error_3", responses: responses["test_error_3"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetNetworkIPspaceByName(errorHandler, *r, "ipspace1")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetNetworkIPspaceByName() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetNetworkIPspaceByName() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateNetworkIPspace(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+
+	var recordInterface map[string]any
+	err := mapstructure.Decode(networkIPspaceRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	genericError := errors.New("generic error for UT")
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	responses := map[string][]restclient.MockResponse{
+		"test_create": {
+			{ExpectedMethod: "POST", ExpectedURL: "network/ipspaces", StatusCode: 201, Response: oneRecord, Err: nil},
+		},
+		"test_no_records": {
+			{ExpectedMethod: "POST", ExpectedURL: "network/ipspaces", StatusCode: 201, Response: noRecords, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "POST", ExpectedURL: "network/ipspaces", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *NetworkIPspaceGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_create", responses: responses["test_create"], want: &networkIPspaceRecord, wantErr: false},
+		{name: "test_no_records", responses: responses["test_no_records"], want: nil, wantErr: true},
+		{name: "test_error", responses: responses["test_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := CreateNetworkIPspace(errorHandler, *r, NetworkIPspaceResourceBodyDataModelONTAP{Name: "ipspace1"})
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CreateNetworkIPspace() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CreateNetworkIPspace() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateDeleteNetworkIPspace(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	responses := map[string][]restclient.MockResponse{
+		"test_update": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "network/ipspaces/1234", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_update_error": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "network/ipspaces/1234", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_delete": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "network/ipspaces/1234", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_delete_not_found": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "network/ipspaces/1234", StatusCode: 404, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		action    string
+		wantErr   bool
+	}{
+		{name: "test_update", responses: responses["test_update"], action: "update", wantErr: false},
+		{name: "test_update_error", responses: responses["test_update_error"], action: "update", wantErr: true},
+		{name: "test_delete", responses: responses["test_delete"], action: "delete", wantErr: false},
+		{name: "test_delete_not_found", responses: responses["test_delete_not_found"], action: "delete", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			switch tt.action {
+			case "update":
+				err = UpdateNetworkIPspace(errorHandler, *r, "1234", NetworkIPspaceResourceBodyDataModelONTAP{Name: "ipspace2"})
+			case "delete":
+				err = DeleteNetworkIPspace(errorHandler, *r, "1234")
+			}
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("%s error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			}
+		})
+	}
+}
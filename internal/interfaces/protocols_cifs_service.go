@@ -70,6 +70,20 @@ type CifsServiceResourceDeleteBodyDataModelONTAP struct {
 	AdDomain AdDomainDataModel `mapstructure:"ad_domain"`
 }
 
+// CifsDomainDataModelONTAP describes the CIFS domain record data model using go types for mapping.
+type CifsDomainDataModelONTAP struct {
+	PasswordSchedule CifsDomainPasswordScheduleDataModelONTAP `mapstructure:"password_schedule"`
+}
+
+// CifsDomainPasswordScheduleDataModelONTAP describes the machine account password schedule using go types for mapping.
+type CifsDomainPasswordScheduleDataModelONTAP struct {
+	ScheduleEnabled          bool   `mapstructure:"schedule_enabled"`
+	ScheduleWeeklyInterval   int64  `mapstructure:"schedule_weekly_interval,omitempty"`
+	ScheduleRandomizedMinute int64  `mapstructure:"schedule_randomized_minute,omitempty"`
+	ScheduleDayOfWeek        string `mapstructure:"schedule_day_of_week,omitempty"`
+	ScheduleStartTime        string `mapstructure:"schedule_start_time,omitempty"`
+}
+
 // CifsServiceDataSourceFilterModel describes the data source data model for queries.
 type CifsServiceDataSourceFilterModel struct {
 	Name    string `mapstructure:"name"`
@@ -197,3 +211,39 @@ func UpdateCifsService(errorHandler *utils.ErrorHandler, r restclient.RestClient
 
 	return nil
 }
+
+// GetCifsDomainPasswordSchedule to get the machine account password schedule of the CIFS domain
+func GetCifsDomainPasswordSchedule(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmid string) (*CifsDomainPasswordScheduleDataModelONTAP, error) {
+	api := "protocols/cifs/domains/" + svmid
+	query := r.NewQuery()
+	query.Fields([]string{"password_schedule"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading protocols_cifs_domain info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP CifsDomainDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_cifs_domain password schedule: %#v", dataONTAP))
+	return &dataONTAP.PasswordSchedule, nil
+}
+
+// UpdateCifsDomainPasswordSchedule to update the machine account password schedule of the CIFS domain
+func UpdateCifsDomainPasswordSchedule(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmid string, schedule CifsDomainPasswordScheduleDataModelONTAP) error {
+	api := "protocols/cifs/domains/" + svmid
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(CifsDomainDataModelONTAP{PasswordSchedule: schedule}, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding protocols_cifs_domain body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, schedule))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating protocols_cifs_domain password schedule", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var basicCifsDomainPasswordSchedule = CifsDomainPasswordScheduleDataModelONTAP{
+	ScheduleEnabled:          true,
+	ScheduleWeeklyInterval:   4,
+	ScheduleRandomizedMinute: 120,
+	ScheduleDayOfWeek:        "sunday",
+	ScheduleStartTime:        "01:00:00",
+}
+
+func TestGetCifsDomainPasswordSchedule(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+
+	var recordInterface map[string]any
+	err := mapstructure.Decode(CifsDomainDataModelONTAP{PasswordSchedule: basicCifsDomainPasswordSchedule}, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var badRecordInterface map[string]any
+	err = mapstructure.Decode(map[string]any{"password_schedule": map[string]any{"schedule_weekly_interval": "four"}}, &badRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	decodeError := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/cifs/domains/5678", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/cifs/domains/5678", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/cifs/domains/5678", StatusCode: 200, Response: decodeError, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *CifsDomainPasswordScheduleDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records", responses: responses["test_no_records"], want: nil, wantErr: true},
+		{name: "test_one_record", responses: responses["test_one_record"], want: &basicCifsDomainPasswordSchedule, wantErr: false},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetCifsDomainPasswordSchedule(errorHandler, *r, "5678")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetCifsDomainPasswordSchedule() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetCifsDomainPasswordSchedule() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateCifsDomainPasswordSchedule(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_update": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "protocols/cifs/domains/5678", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_update_error": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "protocols/cifs/domains/5678", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_update", responses: responses["test_update"], wantErr: false},
+		{name: "test_update_error", responses: responses["test_update_error"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = UpdateCifsDomainPasswordSchedule(errorHandler, *r, "5678", basicCifsDomainPasswordSchedule)
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateCifsDomainPasswordSchedule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"fmt"
+	"net/url"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/mitchellh/mapstructure"
@@ -11,9 +12,11 @@ import (
 
 // ProtocolsCIFSShareACLGetDataModelONTAP describes the GET record data model using go types for mapping.
 type ProtocolsCIFSShareACLGetDataModelONTAP struct {
-	Name        string `mapstructure:"name"`
-	UUID        string `mapstructure:"uuid"`
-	UserOrGroup string `mapstructure:"user_or_group"`
+	Share       string            `mapstructure:"share"`
+	SVM         SvmDataModelONTAP `mapstructure:"svm"`
+	UserOrGroup string            `mapstructure:"user_or_group"`
+	Type        string            `mapstructure:"type"`
+	Permission  string            `mapstructure:"permission"`
 }
 
 // ProtocolsCIFSShareACLResourceBodyDataModelONTAP describes the body data model using go types for mapping.
@@ -27,26 +30,19 @@ type ProtocolsCIFSShareACLResourceBodyDataModelONTAP struct {
 
 // ProtocolsCIFSShareACLDataSourceFilterModel describes the data source data model for queries.
 type ProtocolsCIFSShareACLDataSourceFilterModel struct {
-	Name        string `mapstructure:"name"`
-	SVMName     string `mapstructure:"svm.name"`
-	UserOrGroup string `mapstructure:"user_or_group"`
+	UserOrGroup string `mapstructure:"user_or_group,omitempty"`
+	Type        string `mapstructure:"type,omitempty"`
+	Permission  string `mapstructure:"permission,omitempty"`
 }
 
-// GetProtocolsCIFSShareACLByName to get protocols_cifs_share_acl info
-func GetProtocolsCIFSShareACLByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string, svmName string) (*ProtocolsCIFSShareACLGetDataModelONTAP, error) {
-	api := "api_url"
+// GetProtocolsCIFSShareACL to get protocols_cifs_share_acl info for one user or group
+func GetProtocolsCIFSShareACL(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmID string, shareName string, userOrGroup string, aclType string) (*ProtocolsCIFSShareACLGetDataModelONTAP, error) {
+	api := fmt.Sprintf("protocols/cifs/shares/%s/%s/acls/%s/%s", svmID, url.PathEscape(shareName), url.PathEscape(userOrGroup), aclType)
 	query := r.NewQuery()
-	query.Set("name", name)
-	if svmName == "" {
-		query.Set("scope", "cluster")
-	} else {
-		query.Set("svm.name", svmName)
-		query.Set("scope", "svm")
-	}
-	query.Fields([]string{"name", "svm.name", "ip", "scope"})
+	query.Fields([]string{"share", "svm.name", "svm.uuid", "user_or_group", "type", "permission"})
 	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
 	if err == nil && response == nil {
-		err = fmt.Errorf("no response for GET %s", api)
+		return nil, nil
 	}
 	if err != nil {
 		return nil, errorHandler.MakeAndReportError("error reading protocols_cifs_share_acl info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
@@ -62,10 +58,10 @@ func GetProtocolsCIFSShareACLByName(errorHandler *utils.ErrorHandler, r restclie
 }
 
 // GetProtocolsCIFSShareAcls to get protocols_cifs_share_acl info for all resources matching a filter
-func GetProtocolsCIFSShareAcls(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *ProtocolsCIFSShareACLDataSourceFilterModel, svmName string, shareName string) ([]ProtocolsCIFSShareACLGetDataModelONTAP, error) {
-	api := fmt.Sprintf("/protocols/cifs/shares/%s/%s/acls", svmName, shareName)
+func GetProtocolsCIFSShareAcls(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *ProtocolsCIFSShareACLDataSourceFilterModel, svmID string, shareName string) ([]ProtocolsCIFSShareACLGetDataModelONTAP, error) {
+	api := fmt.Sprintf("protocols/cifs/shares/%s/%s/acls", svmID, url.PathEscape(shareName))
 	query := r.NewQuery()
-	query.Fields([]string{"name", "svm.name", "scope"})
+	query.Fields([]string{"share", "svm.name", "svm.uuid", "user_or_group", "type", "permission"})
 	if filter != nil {
 		var filterMap map[string]interface{}
 		if err := mapstructure.Decode(filter, &filterMap); err != nil {
@@ -118,7 +114,7 @@ func CreateProtocolsCIFSShareACL(errorHandler *utils.ErrorHandler, r restclient.
 
 // UpdateProtocolsCIFSShareACL to update protocols_cifs_share_acl
 func UpdateProtocolsCIFSShareACL(errorHandler *utils.ErrorHandler, r restclient.RestClient, body ProtocolsCIFSShareACLResourceBodyDataModelONTAP, svmID string, shareName string, userOrGroup string, aclType string) error {
-	api := fmt.Sprintf("/protocols/cifs/shares/%s/%s/acls/%s/%s", svmID, shareName, userOrGroup, aclType)
+	api := fmt.Sprintf("/protocols/cifs/shares/%s/%s/acls/%s/%s", svmID, url.PathEscape(shareName), url.PathEscape(userOrGroup), aclType)
 	var bodyMap map[string]interface{}
 	if err := mapstructure.Decode(body, &bodyMap); err != nil {
 		return errorHandler.MakeAndReportError("error encoding protocols_cifs_share_acl body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
@@ -134,7 +130,7 @@ func UpdateProtocolsCIFSShareACL(errorHandler *utils.ErrorHandler, r restclient.
 
 // DeleteProtocolsCIFSShareACL to delete protocols_cifs_share_acl
 func DeleteProtocolsCIFSShareACL(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmID string, shareName string, userOrGroup string, aclType string) error {
-	api := fmt.Sprintf("/protocols/cifs/shares/%s/%s/acls/%s/%s", svmID, shareName, userOrGroup, aclType)
+	api := fmt.Sprintf("/protocols/cifs/shares/%s/%s/acls/%s/%s", svmID, url.PathEscape(shareName), url.PathEscape(userOrGroup), aclType)
 	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
 	if err != nil {
 		return errorHandler.MakeAndReportError("error deleting protocols_cifs_share_acl", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
@@ -0,0 +1,86 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var basicCIFSShareACLRecord = ProtocolsCIFSShareACLGetDataModelONTAP{
+	Share:       "share1",
+	SVM:         SvmDataModelONTAP{Name: "svm1", UUID: "1234"},
+	UserOrGroup: "Everyone",
+	Type:        "windows",
+	Permission:  "read",
+}
+
+func TestGetProtocolsCIFSShareAcls(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+
+	var recordInterface map[string]any
+	err := mapstructure.Decode(basicCIFSShareACLRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var badRecordInterface map[string]any
+	err = mapstructure.Decode(struct{ Permission int }{1}, &badRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	twoRecords := restclient.RestResponse{NumRecords: 2, Records: []map[string]any{recordInterface, recordInterface}}
+	decodeError := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	genericError := errors.New("generic error for UT")
+	api := "protocols/cifs/shares/1234/share1/acls"
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_two_records": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 200, Response: twoRecords, Err: nil},
+		},
+		"test_get_error": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 404, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: api, StatusCode: 200, Response: decodeError, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      []ProtocolsCIFSShareACLGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records", responses: responses["test_no_records"], want: nil, wantErr: false},
+		{name: "test_two_records", responses: responses["test_two_records"], want: []ProtocolsCIFSShareACLGetDataModelONTAP{basicCIFSShareACLRecord, basicCIFSShareACLRecord}, wantErr: false},
+		{name: "test_get_error", responses: responses["test_get_error"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetProtocolsCIFSShareAcls(errorHandler, *r, nil, "1234", "share1")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetProtocolsCIFSShareAcls() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetProtocolsCIFSShareAcls() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
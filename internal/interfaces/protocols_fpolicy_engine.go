@@ -0,0 +1,101 @@
+package interfaces
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// ProtocolsFpolicyEngineGetDataModelONTAP describes the GET record data model using go types for mapping.
+type ProtocolsFpolicyEngineGetDataModelONTAP struct {
+	Name             string   `mapstructure:"name"`
+	PrimaryServers   []string `mapstructure:"primary_servers"`
+	SecondaryServers []string `mapstructure:"secondary_servers,omitempty"`
+	Port             int64    `mapstructure:"port"`
+	Type             string   `mapstructure:"type"`
+	SslOption        string   `mapstructure:"ssl_option"`
+}
+
+// ProtocolsFpolicyEngineResourceBodyDataModelONTAP describes the POST body data model using go types for mapping.
+type ProtocolsFpolicyEngineResourceBodyDataModelONTAP struct {
+	Name             string   `mapstructure:"name"`
+	PrimaryServers   []string `mapstructure:"primary_servers"`
+	SecondaryServers []string `mapstructure:"secondary_servers,omitempty"`
+	Port             int64    `mapstructure:"port"`
+	Type             string   `mapstructure:"type,omitempty"`
+	SslOption        string   `mapstructure:"ssl_option,omitempty"`
+}
+
+// ProtocolsFpolicyEngineUpdateBodyDataModelONTAP describes the PATCH body data model using go types for mapping.
+type ProtocolsFpolicyEngineUpdateBodyDataModelONTAP struct {
+	PrimaryServers   []string `mapstructure:"primary_servers,omitempty"`
+	SecondaryServers []string `mapstructure:"secondary_servers,omitempty"`
+	Port             int64    `mapstructure:"port,omitempty"`
+	Type             string   `mapstructure:"type,omitempty"`
+	SslOption        string   `mapstructure:"ssl_option,omitempty"`
+}
+
+// GetProtocolsFpolicyEngineByName to get protocols_fpolicy_engine info
+func GetProtocolsFpolicyEngineByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, name string) (*ProtocolsFpolicyEngineGetDataModelONTAP, error) {
+	api := "protocols/fpolicy/" + svmUUID + "/engines"
+	query := r.NewQuery()
+	query.Set("name", name)
+	query.Fields([]string{"name", "primary_servers", "secondary_servers", "port", "type", "ssl_option"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading protocols_fpolicy_engine info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP ProtocolsFpolicyEngineGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_fpolicy_engine: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// CreateProtocolsFpolicyEngine to create protocols_fpolicy_engine
+func CreateProtocolsFpolicyEngine(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, body ProtocolsFpolicyEngineResourceBodyDataModelONTAP) error {
+	api := "protocols/fpolicy/" + svmUUID + "/engines"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding protocols_fpolicy_engine body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error creating protocols_fpolicy_engine", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// UpdateProtocolsFpolicyEngine to update protocols_fpolicy_engine
+func UpdateProtocolsFpolicyEngine(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, name string, body ProtocolsFpolicyEngineUpdateBodyDataModelONTAP) error {
+	api := "protocols/fpolicy/" + svmUUID + "/engines/" + url.PathEscape(name)
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding protocols_fpolicy_engine body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating protocols_fpolicy_engine", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteProtocolsFpolicyEngine to delete protocols_fpolicy_engine
+func DeleteProtocolsFpolicyEngine(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, name string) error {
+	api := "protocols/fpolicy/" + svmUUID + "/engines/" + url.PathEscape(name)
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting protocols_fpolicy_engine", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package interfaces
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// ProtocolsFpolicyEventGetDataModelONTAP describes the GET record data model using go types for mapping.
+// File operations and filters are returned as a map of flags, only the flags set to true are in effect.
+type ProtocolsFpolicyEventGetDataModelONTAP struct {
+	Name             string          `mapstructure:"name"`
+	Protocol         string          `mapstructure:"protocol,omitempty"`
+	VolumeMonitoring bool            `mapstructure:"volume_monitoring"`
+	FileOperations   map[string]bool `mapstructure:"file_operations,omitempty"`
+	Filters          map[string]bool `mapstructure:"filters,omitempty"`
+}
+
+// ProtocolsFpolicyEventResourceBodyDataModelONTAP describes the POST body data model using go types for mapping.
+type ProtocolsFpolicyEventResourceBodyDataModelONTAP struct {
+	Name             string          `mapstructure:"name"`
+	Protocol         string          `mapstructure:"protocol,omitempty"`
+	VolumeMonitoring bool            `mapstructure:"volume_monitoring"`
+	FileOperations   map[string]bool `mapstructure:"file_operations,omitempty"`
+	Filters          map[string]bool `mapstructure:"filters,omitempty"`
+}
+
+// ProtocolsFpolicyEventUpdateBodyDataModelONTAP describes the PATCH body data model using go types for mapping.
+// Operations and filters to turn off are sent with a false flag.
+type ProtocolsFpolicyEventUpdateBodyDataModelONTAP struct {
+	Protocol         string          `mapstructure:"protocol,omitempty"`
+	VolumeMonitoring *bool           `mapstructure:"volume_monitoring,omitempty"`
+	FileOperations   map[string]bool `mapstructure:"file_operations,omitempty"`
+	Filters          map[string]bool `mapstructure:"filters,omitempty"`
+}
+
+// GetProtocolsFpolicyEventByName to get protocols_fpolicy_event info
+func GetProtocolsFpolicyEventByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, name string) (*ProtocolsFpolicyEventGetDataModelONTAP, error) {
+	api := "protocols/fpolicy/" + svmUUID + "/events"
+	query := r.NewQuery()
+	query.Set("name", name)
+	query.Fields([]string{"name", "protocol", "volume_monitoring", "file_operations", "filters"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading protocols_fpolicy_event info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP ProtocolsFpolicyEventGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_fpolicy_event: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// CreateProtocolsFpolicyEvent to create protocols_fpolicy_event
+func CreateProtocolsFpolicyEvent(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, body ProtocolsFpolicyEventResourceBodyDataModelONTAP) error {
+	api := "protocols/fpolicy/" + svmUUID + "/events"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding protocols_fpolicy_event body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error creating protocols_fpolicy_event", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// UpdateProtocolsFpolicyEvent to update protocols_fpolicy_event
+func UpdateProtocolsFpolicyEvent(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, name string, body ProtocolsFpolicyEventUpdateBodyDataModelONTAP) error {
+	api := "protocols/fpolicy/" + svmUUID + "/events/" + url.PathEscape(name)
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding protocols_fpolicy_event body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating protocols_fpolicy_event", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteProtocolsFpolicyEvent to delete protocols_fpolicy_event
+func DeleteProtocolsFpolicyEvent(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, name string) error {
+	api := "protocols/fpolicy/" + svmUUID + "/events/" + url.PathEscape(name)
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting protocols_fpolicy_event", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
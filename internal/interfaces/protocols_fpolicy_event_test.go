@@ -0,0 +1,147 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var basicFpolicyEvent = ProtocolsFpolicyEventGetDataModelONTAP{
+	Name:             "event1",
+	Protocol:         "cifs",
+	VolumeMonitoring: false,
+	FileOperations:   map[string]bool{"create": true, "write": true, "read": false},
+	Filters:          map[string]bool{"first_write": true},
+}
+
+func TestGetProtocolsFpolicyEventByName(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+
+	var recordInterface map[string]any
+	err := mapstructure.Decode(basicFpolicyEvent, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var badRecordInterface map[string]any
+	err = mapstructure.Decode(map[string]any{"file_operations": map[string]any{"create": "yes"}}, &badRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	decodeError := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/fpolicy/1234/events", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/fpolicy/1234/events", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_get_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/fpolicy/1234/events", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/fpolicy/1234/events", StatusCode: 200, Response: decodeError, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *ProtocolsFpolicyEventGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records", responses: responses["test_no_records"], want: nil, wantErr: false},
+		{name: "test_one_record", responses: responses["test_one_record"], want: &basicFpolicyEvent, wantErr: false},
+		{name: "test_get_error", responses: responses["test_get_error"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetProtocolsFpolicyEventByName(errorHandler, *r, "1234", "event1")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetProtocolsFpolicyEventByName() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetProtocolsFpolicyEventByName() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateUpdateDeleteProtocolsFpolicyEvent(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	responses := map[string][]restclient.MockResponse{
+		"test_create": {
+			{ExpectedMethod: "POST", ExpectedURL: "protocols/fpolicy/1234/events", StatusCode: 201, Response: noRecords, Err: nil},
+		},
+		"test_create_error": {
+			{ExpectedMethod: "POST", ExpectedURL: "protocols/fpolicy/1234/events", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_update": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "protocols/fpolicy/1234/events/event1", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_update_error": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "protocols/fpolicy/1234/events/event1", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_delete": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "protocols/fpolicy/1234/events/event1", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_delete_not_found": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "protocols/fpolicy/1234/events/event1", StatusCode: 404, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		action    string
+		wantErr   bool
+	}{
+		{name: "test_create", responses: responses["test_create"], action: "create", wantErr: false},
+		{name: "test_create_error", responses: responses["test_create_error"], action: "create", wantErr: true},
+		{name: "test_update", responses: responses["test_update"], action: "update", wantErr: false},
+		{name: "test_update_error", responses: responses["test_update_error"], action: "update", wantErr: true},
+		{name: "test_delete", responses: responses["test_delete"], action: "delete", wantErr: false},
+		{name: "test_delete_not_found", responses: responses["test_delete_not_found"], action: "delete", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			switch tt.action {
+			case "create":
+				body := ProtocolsFpolicyEventResourceBodyDataModelONTAP{Name: "event1", Protocol: "cifs", FileOperations: map[string]bool{"create": true}}
+				err = CreateProtocolsFpolicyEvent(errorHandler, *r, "1234", body)
+			case "update":
+				err = UpdateProtocolsFpolicyEvent(errorHandler, *r, "1234", "event1", ProtocolsFpolicyEventUpdateBodyDataModelONTAP{FileOperations: map[string]bool{"create": false, "write": true}})
+			case "delete":
+				err = DeleteProtocolsFpolicyEvent(errorHandler, *r, "1234", "event1")
+			}
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("%s error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			}
+		})
+	}
+}
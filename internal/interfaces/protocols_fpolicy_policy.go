@@ -0,0 +1,116 @@
+package interfaces
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// ProtocolsFpolicyPolicyGetDataModelONTAP describes the GET record data model using go types for mapping.
+type ProtocolsFpolicyPolicyGetDataModelONTAP struct {
+	Name      string                      `mapstructure:"name"`
+	Enabled   bool                        `mapstructure:"enabled"`
+	Priority  int64                       `mapstructure:"priority,omitempty"`
+	Engine    NameDataModel               `mapstructure:"engine"`
+	Events    []NameDataModel             `mapstructure:"events"`
+	Mandatory bool                        `mapstructure:"mandatory"`
+	Scope     FpolicyPolicyScopeDataModel `mapstructure:"scope"`
+}
+
+// FpolicyPolicyScopeDataModel describes the scope of an FPolicy policy.
+type FpolicyPolicyScopeDataModel struct {
+	IncludeVolumes                  []string `mapstructure:"include_volumes,omitempty"`
+	ExcludeVolumes                  []string `mapstructure:"exclude_volumes,omitempty"`
+	IncludeShares                   []string `mapstructure:"include_shares,omitempty"`
+	ExcludeShares                   []string `mapstructure:"exclude_shares,omitempty"`
+	IncludeExportPolicies           []string `mapstructure:"include_export_policies,omitempty"`
+	ExcludeExportPolicies           []string `mapstructure:"exclude_export_policies,omitempty"`
+	IncludeExtension                []string `mapstructure:"include_extension,omitempty"`
+	ExcludeExtension                []string `mapstructure:"exclude_extension,omitempty"`
+	ObjectMonitoringWithNoExtension *bool    `mapstructure:"object_monitoring_with_no_extension,omitempty"`
+}
+
+// ProtocolsFpolicyPolicyResourceBodyDataModelONTAP describes the POST body data model using go types for mapping.
+// The policy is always created disabled, enabling it is done with UpdateProtocolsFpolicyPolicy.
+type ProtocolsFpolicyPolicyResourceBodyDataModelONTAP struct {
+	Name      string                       `mapstructure:"name"`
+	Engine    NameDataModel                `mapstructure:"engine"`
+	Events    []NameDataModel              `mapstructure:"events"`
+	Mandatory bool                         `mapstructure:"mandatory"`
+	Scope     *FpolicyPolicyScopeDataModel `mapstructure:"scope,omitempty"`
+}
+
+// ProtocolsFpolicyPolicyUpdateBodyDataModelONTAP describes the PATCH body data model using go types for mapping.
+type ProtocolsFpolicyPolicyUpdateBodyDataModelONTAP struct {
+	Engine    *NameDataModel               `mapstructure:"engine,omitempty"`
+	Events    []NameDataModel              `mapstructure:"events,omitempty"`
+	Mandatory *bool                        `mapstructure:"mandatory,omitempty"`
+	Scope     *FpolicyPolicyScopeDataModel `mapstructure:"scope,omitempty"`
+	Enabled   *bool                        `mapstructure:"enabled,omitempty"`
+	Priority  int64                        `mapstructure:"priority,omitempty"`
+}
+
+// GetProtocolsFpolicyPolicyByName to get protocols_fpolicy_policy info
+func GetProtocolsFpolicyPolicyByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, name string) (*ProtocolsFpolicyPolicyGetDataModelONTAP, error) {
+	api := "protocols/fpolicy/" + svmUUID + "/policies"
+	query := r.NewQuery()
+	query.Set("name", name)
+	query.Fields([]string{"name", "enabled", "priority", "engine.name", "events.name", "mandatory", "scope"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading protocols_fpolicy_policy info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP ProtocolsFpolicyPolicyGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_fpolicy_policy: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// CreateProtocolsFpolicyPolicy to create protocols_fpolicy_policy
+func CreateProtocolsFpolicyPolicy(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, body ProtocolsFpolicyPolicyResourceBodyDataModelONTAP) error {
+	api := "protocols/fpolicy/" + svmUUID + "/policies"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding protocols_fpolicy_policy body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error creating protocols_fpolicy_policy", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// UpdateProtocolsFpolicyPolicy to update protocols_fpolicy_policy
+func UpdateProtocolsFpolicyPolicy(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, name string, body ProtocolsFpolicyPolicyUpdateBodyDataModelONTAP) error {
+	api := "protocols/fpolicy/" + svmUUID + "/policies/" + url.PathEscape(name)
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding protocols_fpolicy_policy body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating protocols_fpolicy_policy", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteProtocolsFpolicyPolicy to delete protocols_fpolicy_policy
+func DeleteProtocolsFpolicyPolicy(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, name string) error {
+	api := "protocols/fpolicy/" + svmUUID + "/policies/" + url.PathEscape(name)
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting protocols_fpolicy_policy", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
@@ -19,10 +19,10 @@ type ExportpolicyRuleResourceBodyDataModelONTAP struct {
 	Protocols           []string            `mapstructure:"protocols,omitempty"`
 	AnonymousUser       string              `mapstructure:"anonymous_user,omitempty"`
 	Superuser           []string            `mapstructure:"superuser,omitempty"`
-	AllowDeviceCreation bool                `mapstructure:"allow_device_creation,omitempty"`
+	AllowDeviceCreation *bool               `mapstructure:"allow_device_creation,omitempty"`
 	NtfsUnixSecurity    string              `mapstructure:"ntfs_unix_security,omitempty"`
 	ChownMode           string              `mapstructure:"chown_mode,omitempty"`
-	AllowSuid           bool                `mapstructure:"allow_suid,omitempty"`
+	AllowSuid           *bool               `mapstructure:"allow_suid,omitempty"`
 	Index               int64               `mapstructure:"index,omitempty"`
 }
 
@@ -196,6 +196,18 @@ func UpdateExportPolicyRule(errorHandler *utils.ErrorHandler, r restclient.RestC
 	return &dataONTAP, nil
 }
 
+// UpdateExportPolicyRuleIndex moves an export policy rule to a new index, ONTAP shifts the rules in between
+func UpdateExportPolicyRuleIndex(errorHandler *utils.ErrorHandler, r restclient.RestClient, exportPolicyID string, index int64, newIndex int64) error {
+	api := fmt.Sprintf("protocols/nfs/export-policies/%s/rules/%d", exportPolicyID, index)
+	body := map[string]interface{}{"index": newIndex}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating export policy rule index", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Moved export policy rule %d to index %d", index, newIndex))
+	return nil
+}
+
 // DeleteExportPolicyRule to delete export policy rule
 func DeleteExportPolicyRule(errorHandler *utils.ErrorHandler, r restclient.RestClient, exportPolicyID string, index int64) error {
 	statusCode, _, err := r.CallDeleteMethod("protocols/nfs/export-policies/"+exportPolicyID+"/rules/"+strconv.FormatInt(index, 10), nil, nil)
@@ -32,6 +32,8 @@ var basicExportPolicyRuleRecord = ExportPolicyRuleGetDataModelONTAP{
 	Index:            8,
 }
 
+var trueValue = true
+
 // bad record with wrong type
 var badExportPolicyRuleRecord = struct{ Index string }{"123"}
 
@@ -41,8 +43,8 @@ var basicExportPolicyRuleBody = ExportpolicyRuleResourceBodyDataModelONTAP{
 	RwRule:              []string{"any"},
 	Protocols:           []string{"any"},
 	Superuser:           []string{"any"},
-	AllowDeviceCreation: true,
-	AllowSuid:           true,
+	AllowDeviceCreation: &trueValue,
+	AllowSuid:           &trueValue,
 	AnonymousUser:       "65534",
 	ChownMode:           "restricted",
 	ClientsMatch: []map[string]string{
@@ -64,8 +66,8 @@ var updateProtocolsExportPolicyRuleBody = ExportpolicyRuleResourceBodyDataModelO
 	RwRule:              []string{"any"},
 	Protocols:           []string{"nfs3", "nfs"},
 	Superuser:           []string{"any"},
-	AllowDeviceCreation: true,
-	AllowSuid:           true,
+	AllowDeviceCreation: &trueValue,
+	AllowSuid:           &trueValue,
 	AnonymousUser:       "65534",
 	ChownMode:           "restricted",
 	ClientsMatch: []map[string]string{
@@ -82,8 +84,8 @@ var updateExportPolicyRuleErrorBody = ExportpolicyRuleResourceBodyDataModelONTAP
 	RwRule:              []string{"any"},
 	Protocols:           []string{"nfs3", "nfs"},
 	Superuser:           []string{"any"},
-	AllowDeviceCreation: true,
-	AllowSuid:           true,
+	AllowDeviceCreation: &trueValue,
+	AllowSuid:           &trueValue,
 	AnonymousUser:       "65534",
 	ChownMode:           "restricted",
 	ClientsMatch: []map[string]string{
@@ -437,3 +439,83 @@ func TestUpdateExportPolicyRule(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateExportPolicyRuleIndex(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_update_export_policy_rule_index": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "protocols/nfs/export-policies/12884901889/rules/3", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_update_error_1": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "protocols/nfs/export-policies/12884901889/rules/3", StatusCode: 200, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_update_export_policy_rule_index", responses: responses["test_update_export_policy_rule_index"], wantErr: false},
+		{name: "test_update_error_1", responses: responses["test_update_error_1"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = UpdateExportPolicyRuleIndex(errorHandler, *r, "12884901889", 3, 1)
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateExportPolicyRuleIndex() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestExportPolicyRuleBodyFlags(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	created := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{{"index": 1}}}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	responses := []restclient.MockResponse{
+		{ExpectedMethod: "POST", ExpectedURL: "protocols/nfs/export-policies/12884901889/rules", StatusCode: 201, Response: created, Err: nil},
+		{ExpectedMethod: "PATCH", ExpectedURL: "protocols/nfs/export-policies/12884901889/rules/1", StatusCode: 200, Response: noRecords, Err: nil},
+		{ExpectedMethod: "PATCH", ExpectedURL: "protocols/nfs/export-policies/12884901889/rules/1", StatusCode: 200, Response: noRecords, Err: nil},
+	}
+	r, err := restclient.NewRecordingMockedRestClient(responses)
+	if err != nil {
+		panic(err)
+	}
+	// false must be sent, otherwise ONTAP keeps its default or the previous value
+	falseValue := false
+	body := ExportpolicyRuleResourceBodyDataModelONTAP{RoRule: []string{"any"}, RwRule: []string{"any"}, AllowDeviceCreation: &falseValue, AllowSuid: &falseValue}
+	if _, err = CreateExportPolicyRule(errorHandler, *r, body, "12884901889"); err != nil {
+		t.Fatalf("CreateExportPolicyRule() error = %v", err)
+	}
+	if _, err = UpdateExportPolicyRule(errorHandler, *r, body, "12884901889", 1); err != nil {
+		t.Fatalf("UpdateExportPolicyRule() error = %v", err)
+	}
+	// unset flags are left out, so ONTAP keeps the current value
+	body = ExportpolicyRuleResourceBodyDataModelONTAP{RoRule: []string{"any"}}
+	if _, err = UpdateExportPolicyRule(errorHandler, *r, body, "12884901889", 1); err != nil {
+		t.Fatalf("UpdateExportPolicyRule() error = %v", err)
+	}
+	requests := r.MockRequests()
+	for _, request := range requests[:2] {
+		for _, key := range []string{"allow_device_creation", "allow_suid"} {
+			if value, ok := request.Body[key].(*bool); !ok || *value != false {
+				t.Errorf("%s %s body %s = %v, want false", request.Method, request.URL, key, request.Body[key])
+			}
+		}
+	}
+	for _, key := range []string{"allow_device_creation", "allow_suid"} {
+		if value, ok := requests[2].Body[key]; ok {
+			t.Errorf("%s %s body %s = %v, want none", requests[2].Method, requests[2].URL, key, value)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// ProtocolsNvmeNamespaceGetDataModelONTAP describes the GET record data model using go types for mapping.
+type ProtocolsNvmeNamespaceGetDataModelONTAP struct {
+	Name         string                    `mapstructure:"name"`
+	UUID         string                    `mapstructure:"uuid"`
+	SVM          SvmDataModelONTAP         `mapstructure:"svm"`
+	OSType       string                    `mapstructure:"os_type"`
+	Comment      string                    `mapstructure:"comment,omitempty"`
+	Location     NvmeNamespaceLocation     `mapstructure:"location"`
+	Space        NvmeNamespaceSpace        `mapstructure:"space"`
+	Status       NvmeNamespaceStatus       `mapstructure:"status"`
+	SubsystemMap NvmeNamespaceSubsystemMap `mapstructure:"subsystem_map,omitempty"`
+}
+
+// NvmeNamespaceLocation describes the data model for location.
+type NvmeNamespaceLocation struct {
+	Namespace string `mapstructure:"namespace,omitempty"`
+	Volume    volume `mapstructure:"volume"`
+}
+
+// NvmeNamespaceSpace describes the data model for space.
+type NvmeNamespaceSpace struct {
+	Size      int64 `mapstructure:"size,omitempty"`
+	BlockSize int64 `mapstructure:"block_size,omitempty"`
+	Used      int64 `mapstructure:"used,omitempty"`
+}
+
+// NvmeNamespaceStatus describes the data model for status.
+type NvmeNamespaceStatus struct {
+	State  string `mapstructure:"state,omitempty"`
+	Mapped bool   `mapstructure:"mapped"`
+}
+
+// NvmeNamespaceSubsystemMap describes the subsystem the namespace is mapped to.
+type NvmeNamespaceSubsystemMap struct {
+	Subsystem NvmeSubsystemMapReference `mapstructure:"subsystem,omitempty"`
+}
+
+// ProtocolsNvmeNamespaceResourceBodyDataModelONTAP describes the POST body data model using go types for mapping.
+type ProtocolsNvmeNamespaceResourceBodyDataModelONTAP struct {
+	Name    string                    `mapstructure:"name"`
+	SVM     SvmDataModelONTAP         `mapstructure:"svm"`
+	OSType  string                    `mapstructure:"os_type"`
+	Comment string                    `mapstructure:"comment,omitempty"`
+	Space   NvmeNamespaceSpaceRequest `mapstructure:"space"`
+}
+
+// NvmeNamespaceSpaceRequest describes the space data model used on POST and PATCH.
+type NvmeNamespaceSpaceRequest struct {
+	Size      int64 `mapstructure:"size,omitempty"`
+	BlockSize int64 `mapstructure:"block_size,omitempty"`
+}
+
+// ProtocolsNvmeNamespaceUpdateBodyDataModelONTAP describes the PATCH body data model using go types for mapping.
+type ProtocolsNvmeNamespaceUpdateBodyDataModelONTAP struct {
+	Comment *string                    `mapstructure:"comment,omitempty"`
+	Space   *NvmeNamespaceSpaceRequest `mapstructure:"space,omitempty"`
+}
+
+// ProtocolsNvmeNamespaceDataSourceFilterModel describes the data source data model for queries.
+type ProtocolsNvmeNamespaceDataSourceFilterModel struct {
+	Name       string `mapstructure:"name"`
+	SVMName    string `mapstructure:"svm.name"`
+	VolumeName string `mapstructure:"location.volume.name"`
+}
+
+var protocolsNvmeNamespaceFields = []string{"name", "uuid", "svm.name", "svm.uuid", "os_type", "comment", "location", "space.size", "space.block_size", "space.used", "status.state", "status.mapped", "subsystem_map.subsystem.name"}
+
+// GetProtocolsNvmeNamespaceByName to get protocols_nvme_namespace info
+func GetProtocolsNvmeNamespaceByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string, svmName string) (*ProtocolsNvmeNamespaceGetDataModelONTAP, error) {
+	api := "storage/namespaces"
+	query := r.NewQuery()
+	query.Set("name", name)
+	query.Set("svm.name", svmName)
+	query.Fields(protocolsNvmeNamespaceFields)
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading protocols_nvme_namespace info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP ProtocolsNvmeNamespaceGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_nvme_namespace: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// GetProtocolsNvmeNamespaces to get protocols_nvme_namespace info for all resources matching a filter
+func GetProtocolsNvmeNamespaces(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *ProtocolsNvmeNamespaceDataSourceFilterModel) ([]ProtocolsNvmeNamespaceGetDataModelONTAP, error) {
+	api := "storage/namespaces"
+	query := r.NewQuery()
+	query.Fields(protocolsNvmeNamespaceFields)
+	if filter != nil {
+		var filterMap map[string]interface{}
+		if err := mapstructure.Decode(filter, &filterMap); err != nil {
+			return nil, errorHandler.MakeAndReportError("error encoding protocols_nvme_namespaces filter info", fmt.Sprintf("error on filter %#v: %s", filter, err))
+		}
+		query.SetValues(filterMap)
+	}
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading protocols_nvme_namespaces info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []ProtocolsNvmeNamespaceGetDataModelONTAP
+	for _, info := range response {
+		var record ProtocolsNvmeNamespaceGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_nvme_namespaces data source: %#v", dataONTAP))
+	return dataONTAP, nil
+}
+
+// CreateProtocolsNvmeNamespace to create protocols_nvme_namespace
+func CreateProtocolsNvmeNamespace(errorHandler *utils.ErrorHandler, r restclient.RestClient, body ProtocolsNvmeNamespaceResourceBodyDataModelONTAP) (*ProtocolsNvmeNamespaceGetDataModelONTAP, error) {
+	api := "storage/namespaces"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return nil, errorHandler.MakeAndReportError("error encoding protocols_nvme_namespace body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	query := r.NewQuery()
+	query.Add("return_records", "true")
+	statusCode, response, err := r.CallCreateMethod(api, query, bodyMap)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error creating protocols_nvme_namespace", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if len(response.Records) == 0 {
+		return nil, errorHandler.MakeAndReportError("error creating protocols_nvme_namespace", fmt.Sprintf("no record returned on POST %s, statusCode %d", api, statusCode))
+	}
+
+	var dataONTAP ProtocolsNvmeNamespaceGetDataModelONTAP
+	if err := mapstructure.Decode(response.Records[0], &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError("error decoding protocols_nvme_namespace info", fmt.Sprintf("error on decode %s info: %s, statusCode %d, response %#v", api, err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Create protocols_nvme_namespace source - udata: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// UpdateProtocolsNvmeNamespace to update protocols_nvme_namespace
+func UpdateProtocolsNvmeNamespace(errorHandler *utils.ErrorHandler, r restclient.RestClient, data ProtocolsNvmeNamespaceUpdateBodyDataModelONTAP, uuid string) error {
+	api := "storage/namespaces/" + uuid
+	var body map[string]interface{}
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return errorHandler.MakeAndReportError("error encoding protocols_nvme_namespace body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, data))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating protocols_nvme_namespace", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteProtocolsNvmeNamespace to delete protocols_nvme_namespace
+func DeleteProtocolsNvmeNamespace(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) error {
+	api := "storage/namespaces/" + uuid
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting protocols_nvme_namespace", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
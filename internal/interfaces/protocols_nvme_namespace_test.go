@@ -0,0 +1,251 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var basicNvmeNamespaceRecord = ProtocolsNvmeNamespaceGetDataModelONTAP{
+	Name:     "/vol/vol1/namespace1",
+	UUID:     "1234",
+	SVM:      SvmDataModelONTAP{Name: "svm1", UUID: "5678"},
+	OSType:   "linux",
+	Location: NvmeNamespaceLocation{Namespace: "namespace1", Volume: volume{Name: "vol1"}},
+	Space:    NvmeNamespaceSpace{Size: 1073741824, BlockSize: 4096},
+	Status:   NvmeNamespaceStatus{State: "online", Mapped: false},
+}
+
+func TestGetProtocolsNvmeNamespaceByName(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+
+	var recordInterface map[string]any
+	err := mapstructure.Decode(basicNvmeNamespaceRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	badRecordInterface := map[string]any{"name": 123}
+	genericError := errors.New("generic error for UT")
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	twoRecords := restclient.RestResponse{NumRecords: 2, Records: []map[string]any{recordInterface, recordInterface}}
+	decodeError := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/namespaces", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/namespaces", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_two_records_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/namespaces", StatusCode: 200, Response: twoRecords, Err: nil},
+		},
+		"test_get_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/namespaces", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/namespaces", StatusCode: 200, Response: decodeError, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *ProtocolsNvmeNamespaceGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records", responses: responses["test_no_records"], want: nil, wantErr: false},
+		{name: "test_one_record", responses: responses["test_one_record"], want: &basicNvmeNamespaceRecord, wantErr: false},
+		{name: "test_two_records_error", responses: responses["test_two_records_error"], want: nil, wantErr: true},
+		{name: "test_get_error", responses: responses["test_get_error"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetProtocolsNvmeNamespaceByName(errorHandler, *r, "/vol/vol1/namespace1", "svm1")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetProtocolsNvmeNamespaceByName() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetProtocolsNvmeNamespaceByName() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetProtocolsNvmeNamespaces(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+
+	var recordInterface map[string]any
+	err := mapstructure.Decode(basicNvmeNamespaceRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	genericError := errors.New("generic error for UT")
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	twoRecords := restclient.RestResponse{NumRecords: 2, Records: []map[string]any{recordInterface, recordInterface}}
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/namespaces", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_two_records": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/namespaces", StatusCode: 200, Response: twoRecords, Err: nil},
+		},
+		"test_get_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/namespaces", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      []ProtocolsNvmeNamespaceGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records", responses: responses["test_no_records"], want: nil, wantErr: false},
+		{name: "test_two_records", responses: responses["test_two_records"], want: []ProtocolsNvmeNamespaceGetDataModelONTAP{basicNvmeNamespaceRecord, basicNvmeNamespaceRecord}, wantErr: false},
+		{name: "test_get_error", responses: responses["test_get_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetProtocolsNvmeNamespaces(errorHandler, *r, &ProtocolsNvmeNamespaceDataSourceFilterModel{SVMName: "svm1"})
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetProtocolsNvmeNamespaces() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetProtocolsNvmeNamespaces() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateProtocolsNvmeNamespace(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+
+	var recordInterface map[string]any
+	err := mapstructure.Decode(basicNvmeNamespaceRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	genericError := errors.New("generic error for UT")
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	responses := map[string][]restclient.MockResponse{
+		"test_create": {
+			{ExpectedMethod: "POST", ExpectedURL: "storage/namespaces", StatusCode: 201, Response: oneRecord, Err: nil},
+		},
+		"test_no_records": {
+			{ExpectedMethod: "POST", ExpectedURL: "storage/namespaces", StatusCode: 201, Response: noRecords, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "POST", ExpectedURL: "storage/namespaces", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *ProtocolsNvmeNamespaceGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_create", responses: responses["test_create"], want: &basicNvmeNamespaceRecord, wantErr: false},
+		{name: "test_no_records", responses: responses["test_no_records"], want: nil, wantErr: true},
+		{name: "test_error", responses: responses["test_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			body := ProtocolsNvmeNamespaceResourceBodyDataModelONTAP{
+				Name:   "/vol/vol1/namespace1",
+				SVM:    SvmDataModelONTAP{Name: "svm1"},
+				OSType: "linux",
+				Space:  NvmeNamespaceSpaceRequest{Size: 1073741824},
+			}
+			got, err := CreateProtocolsNvmeNamespace(errorHandler, *r, body)
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CreateProtocolsNvmeNamespace() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CreateProtocolsNvmeNamespace() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateDeleteProtocolsNvmeNamespace(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	genericError := errors.New("generic error for UT")
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	responses := map[string][]restclient.MockResponse{
+		"test_update": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "storage/namespaces/1234", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_update_error": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "storage/namespaces/1234", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_delete": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "storage/namespaces/1234", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_delete_not_found": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "storage/namespaces/1234", StatusCode: 404, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		delete    bool
+		wantErr   bool
+	}{
+		{name: "test_update", responses: responses["test_update"], delete: false, wantErr: false},
+		{name: "test_update_error", responses: responses["test_update_error"], delete: false, wantErr: true},
+		{name: "test_delete", responses: responses["test_delete"], delete: true, wantErr: false},
+		{name: "test_delete_not_found", responses: responses["test_delete_not_found"], delete: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			if tt.delete {
+				err = DeleteProtocolsNvmeNamespace(errorHandler, *r, "1234")
+			} else {
+				body := ProtocolsNvmeNamespaceUpdateBodyDataModelONTAP{Space: &NvmeNamespaceSpaceRequest{Size: 2147483648}}
+				err = UpdateProtocolsNvmeNamespace(errorHandler, *r, body, "1234")
+			}
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("%s error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			}
+		})
+	}
+}
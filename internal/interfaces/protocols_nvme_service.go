@@ -0,0 +1,137 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// ProtocolsNvmeServiceGetDataModelONTAP describes the GET record data model using go types for mapping.
+type ProtocolsNvmeServiceGetDataModelONTAP struct {
+	Enabled bool              `mapstructure:"enabled"`
+	SVM     SvmDataModelONTAP `mapstructure:"svm"`
+}
+
+// ProtocolsNvmeServiceResourceBodyDataModelONTAP describes the POST body data model using go types for mapping.
+type ProtocolsNvmeServiceResourceBodyDataModelONTAP struct {
+	Enabled bool              `mapstructure:"enabled"`
+	SVM     SvmDataModelONTAP `mapstructure:"svm"`
+}
+
+// ProtocolsNvmeServiceUpdateBodyDataModelONTAP describes the PATCH body data model using go types for mapping.
+type ProtocolsNvmeServiceUpdateBodyDataModelONTAP struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// ProtocolsNvmeServiceDataSourceFilterModel describes the data source data model for queries.
+type ProtocolsNvmeServiceDataSourceFilterModel struct {
+	SVMName string `mapstructure:"svm.name"`
+}
+
+// GetProtocolsNvmeService to get protocols_nvme_service info
+func GetProtocolsNvmeService(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmName string) (*ProtocolsNvmeServiceGetDataModelONTAP, error) {
+	api := "protocols/nvme/services"
+	query := r.NewQuery()
+	query.Set("svm.name", svmName)
+	query.Fields([]string{"svm.name", "svm.uuid", "enabled"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading protocols_nvme_service info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP ProtocolsNvmeServiceGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_nvme_service: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// GetProtocolsNvmeServices to get protocols_nvme_service info for all resources matching a filter
+func GetProtocolsNvmeServices(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *ProtocolsNvmeServiceDataSourceFilterModel) ([]ProtocolsNvmeServiceGetDataModelONTAP, error) {
+	api := "protocols/nvme/services"
+	query := r.NewQuery()
+	query.Fields([]string{"svm.name", "svm.uuid", "enabled"})
+	if filter != nil {
+		var filterMap map[string]interface{}
+		if err := mapstructure.Decode(filter, &filterMap); err != nil {
+			return nil, errorHandler.MakeAndReportError("error encoding protocols_nvme_services filter info", fmt.Sprintf("error on filter %#v: %s", filter, err))
+		}
+		query.SetValues(filterMap)
+	}
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading protocols_nvme_services info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []ProtocolsNvmeServiceGetDataModelONTAP
+	for _, info := range response {
+		var record ProtocolsNvmeServiceGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_nvme_services data source: %#v", dataONTAP))
+	return dataONTAP, nil
+}
+
+// CreateProtocolsNvmeService to create protocols_nvme_service
+func CreateProtocolsNvmeService(errorHandler *utils.ErrorHandler, r restclient.RestClient, body ProtocolsNvmeServiceResourceBodyDataModelONTAP) (*ProtocolsNvmeServiceGetDataModelONTAP, error) {
+	api := "protocols/nvme/services"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return nil, errorHandler.MakeAndReportError("error encoding protocols_nvme_service body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	query := r.NewQuery()
+	query.Add("return_records", "true")
+	statusCode, response, err := r.CallCreateMethod(api, query, bodyMap)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error creating protocols_nvme_service", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if len(response.Records) == 0 {
+		return nil, errorHandler.MakeAndReportError("error creating protocols_nvme_service", fmt.Sprintf("no record returned on POST %s, statusCode %d", api, statusCode))
+	}
+
+	var dataONTAP ProtocolsNvmeServiceGetDataModelONTAP
+	if err := mapstructure.Decode(response.Records[0], &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError("error decoding protocols_nvme_service info", fmt.Sprintf("error on decode %s info: %s, statusCode %d, response %#v", api, err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Create protocols_nvme_service source - udata: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// UpdateProtocolsNvmeService to update protocols_nvme_service
+func UpdateProtocolsNvmeService(errorHandler *utils.ErrorHandler, r restclient.RestClient, data ProtocolsNvmeServiceUpdateBodyDataModelONTAP, svmUUID string) error {
+	api := "protocols/nvme/services/" + svmUUID
+	var body map[string]interface{}
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return errorHandler.MakeAndReportError("error encoding protocols_nvme_service body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, data))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating protocols_nvme_service", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteProtocolsNvmeService to delete protocols_nvme_service, the service needs to be disabled first
+func DeleteProtocolsNvmeService(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string) error {
+	api := "protocols/nvme/services/" + svmUUID
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting protocols_nvme_service", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
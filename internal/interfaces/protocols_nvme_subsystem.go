@@ -0,0 +1,182 @@
+package interfaces
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// ProtocolsNvmeSubsystemGetDataModelONTAP describes the GET record data model using go types for mapping.
+type ProtocolsNvmeSubsystemGetDataModelONTAP struct {
+	Name      string              `mapstructure:"name"`
+	UUID      string              `mapstructure:"uuid"`
+	SVM       SvmDataModelONTAP   `mapstructure:"svm"`
+	OSType    string              `mapstructure:"os_type"`
+	Comment   string              `mapstructure:"comment,omitempty"`
+	TargetNqn string              `mapstructure:"target_nqn,omitempty"`
+	Hosts     []NvmeSubsystemHost `mapstructure:"hosts,omitempty"`
+}
+
+// NvmeSubsystemHost describes the data model for a host allowed to access the subsystem.
+type NvmeSubsystemHost struct {
+	Nqn string `mapstructure:"nqn"`
+}
+
+// ProtocolsNvmeSubsystemResourceBodyDataModelONTAP describes the POST body data model using go types for mapping.
+type ProtocolsNvmeSubsystemResourceBodyDataModelONTAP struct {
+	Name    string              `mapstructure:"name"`
+	SVM     SvmDataModelONTAP   `mapstructure:"svm"`
+	OSType  string              `mapstructure:"os_type"`
+	Comment string              `mapstructure:"comment,omitempty"`
+	Hosts   []NvmeSubsystemHost `mapstructure:"hosts,omitempty"`
+}
+
+// ProtocolsNvmeSubsystemUpdateBodyDataModelONTAP describes the PATCH body data model using go types for mapping.
+type ProtocolsNvmeSubsystemUpdateBodyDataModelONTAP struct {
+	Comment string `mapstructure:"comment"`
+}
+
+// ProtocolsNvmeSubsystemDataSourceFilterModel describes the data source data model for queries.
+type ProtocolsNvmeSubsystemDataSourceFilterModel struct {
+	Name    string `mapstructure:"name"`
+	SVMName string `mapstructure:"svm.name"`
+	OSType  string `mapstructure:"os_type"`
+}
+
+var protocolsNvmeSubsystemFields = []string{"name", "uuid", "svm.name", "svm.uuid", "os_type", "comment", "target_nqn", "hosts.nqn"}
+
+// GetProtocolsNvmeSubsystemByName to get protocols_nvme_subsystem info
+func GetProtocolsNvmeSubsystemByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string, svmName string) (*ProtocolsNvmeSubsystemGetDataModelONTAP, error) {
+	api := "protocols/nvme/subsystems"
+	query := r.NewQuery()
+	query.Set("name", name)
+	query.Set("svm.name", svmName)
+	query.Fields(protocolsNvmeSubsystemFields)
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading protocols_nvme_subsystem info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP ProtocolsNvmeSubsystemGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_nvme_subsystem: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// GetProtocolsNvmeSubsystems to get protocols_nvme_subsystem info for all resources matching a filter
+func GetProtocolsNvmeSubsystems(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *ProtocolsNvmeSubsystemDataSourceFilterModel) ([]ProtocolsNvmeSubsystemGetDataModelONTAP, error) {
+	api := "protocols/nvme/subsystems"
+	query := r.NewQuery()
+	query.Fields(protocolsNvmeSubsystemFields)
+	if filter != nil {
+		var filterMap map[string]interface{}
+		if err := mapstructure.Decode(filter, &filterMap); err != nil {
+			return nil, errorHandler.MakeAndReportError("error encoding protocols_nvme_subsystems filter info", fmt.Sprintf("error on filter %#v: %s", filter, err))
+		}
+		query.SetValues(filterMap)
+	}
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading protocols_nvme_subsystems info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []ProtocolsNvmeSubsystemGetDataModelONTAP
+	for _, info := range response {
+		var record ProtocolsNvmeSubsystemGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_nvme_subsystems data source: %#v", dataONTAP))
+	return dataONTAP, nil
+}
+
+// CreateProtocolsNvmeSubsystem to create protocols_nvme_subsystem
+func CreateProtocolsNvmeSubsystem(errorHandler *utils.ErrorHandler, r restclient.RestClient, body ProtocolsNvmeSubsystemResourceBodyDataModelONTAP) (*ProtocolsNvmeSubsystemGetDataModelONTAP, error) {
+	api := "protocols/nvme/subsystems"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return nil, errorHandler.MakeAndReportError("error encoding protocols_nvme_subsystem body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	query := r.NewQuery()
+	query.Add("return_records", "true")
+	statusCode, response, err := r.CallCreateMethod(api, query, bodyMap)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error creating protocols_nvme_subsystem", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if len(response.Records) == 0 {
+		return nil, errorHandler.MakeAndReportError("error creating protocols_nvme_subsystem", fmt.Sprintf("no record returned on POST %s, statusCode %d", api, statusCode))
+	}
+
+	var dataONTAP ProtocolsNvmeSubsystemGetDataModelONTAP
+	if err := mapstructure.Decode(response.Records[0], &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError("error decoding protocols_nvme_subsystem info", fmt.Sprintf("error on decode %s info: %s, statusCode %d, response %#v", api, err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Create protocols_nvme_subsystem source - udata: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// UpdateProtocolsNvmeSubsystem to update protocols_nvme_subsystem
+func UpdateProtocolsNvmeSubsystem(errorHandler *utils.ErrorHandler, r restclient.RestClient, data ProtocolsNvmeSubsystemUpdateBodyDataModelONTAP, uuid string) error {
+	api := "protocols/nvme/subsystems/" + uuid
+	var body map[string]interface{}
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return errorHandler.MakeAndReportError("error encoding protocols_nvme_subsystem body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, data))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating protocols_nvme_subsystem", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteProtocolsNvmeSubsystem to delete protocols_nvme_subsystem, hosts are removed with the subsystem
+func DeleteProtocolsNvmeSubsystem(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) error {
+	api := "protocols/nvme/subsystems/" + uuid
+	query := r.NewQuery()
+	query.Add("allow_delete_with_hosts", "true")
+	statusCode, _, err := r.CallDeleteMethod(api, query, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting protocols_nvme_subsystem", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// AddProtocolsNvmeSubsystemHost to allow a host NQN to access the subsystem
+func AddProtocolsNvmeSubsystemHost(errorHandler *utils.ErrorHandler, r restclient.RestClient, subsystemUUID string, nqn string) error {
+	api := "protocols/nvme/subsystems/" + subsystemUUID + "/hosts"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(NvmeSubsystemHost{Nqn: nqn}, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding protocols_nvme_subsystem host body", fmt.Sprintf("error on encoding %s body: %s, nqn: %s", api, err, nqn))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error adding protocols_nvme_subsystem host", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// RemoveProtocolsNvmeSubsystemHost to remove a host NQN from the subsystem
+func RemoveProtocolsNvmeSubsystemHost(errorHandler *utils.ErrorHandler, r restclient.RestClient, subsystemUUID string, nqn string) error {
+	api := "protocols/nvme/subsystems/" + subsystemUUID + "/hosts/" + url.PathEscape(nqn)
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error removing protocols_nvme_subsystem host", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
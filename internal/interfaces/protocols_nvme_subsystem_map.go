@@ -0,0 +1,134 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// ProtocolsNvmeSubsystemMapGetDataModelONTAP describes the GET record data model using go types for mapping.
+type ProtocolsNvmeSubsystemMapGetDataModelONTAP struct {
+	SVM       SvmDataModelONTAP         `mapstructure:"svm"`
+	Subsystem NvmeSubsystemMapReference `mapstructure:"subsystem"`
+	Namespace NvmeSubsystemMapReference `mapstructure:"namespace"`
+	Anagrpid  string                    `mapstructure:"anagrpid,omitempty"`
+	Nsid      string                    `mapstructure:"nsid,omitempty"`
+}
+
+// ProtocolsNvmeSubsystemMapResourceBodyDataModelONTAP describes the POST body data model using go types for mapping.
+type ProtocolsNvmeSubsystemMapResourceBodyDataModelONTAP struct {
+	SVM       SvmDataModelONTAP         `mapstructure:"svm"`
+	Subsystem NvmeSubsystemMapReference `mapstructure:"subsystem"`
+	Namespace NvmeSubsystemMapReference `mapstructure:"namespace"`
+}
+
+// NvmeSubsystemMapReference describes the data model for the subsystem or namespace of a map.
+type NvmeSubsystemMapReference struct {
+	Name string `mapstructure:"name,omitempty"`
+	UUID string `mapstructure:"uuid,omitempty"`
+}
+
+// ProtocolsNvmeSubsystemMapDataSourceFilterModel describes the data source data model for queries.
+type ProtocolsNvmeSubsystemMapDataSourceFilterModel struct {
+	SVMName       string `mapstructure:"svm.name"`
+	SubsystemName string `mapstructure:"subsystem.name"`
+	NamespaceName string `mapstructure:"namespace.name"`
+}
+
+var protocolsNvmeSubsystemMapFields = []string{"svm.name", "svm.uuid", "subsystem.name", "subsystem.uuid", "namespace.name", "namespace.uuid", "anagrpid", "nsid"}
+
+// GetProtocolsNvmeSubsystemMapByName to get protocols_nvme_subsystem_map info
+func GetProtocolsNvmeSubsystemMapByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, subsystemName string, namespaceName string, svmName string) (*ProtocolsNvmeSubsystemMapGetDataModelONTAP, error) {
+	api := "protocols/nvme/subsystem-maps"
+	query := r.NewQuery()
+	query.Set("subsystem.name", subsystemName)
+	query.Set("namespace.name", namespaceName)
+	query.Set("svm.name", svmName)
+	query.Fields(protocolsNvmeSubsystemMapFields)
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading protocols_nvme_subsystem_map info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP ProtocolsNvmeSubsystemMapGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_nvme_subsystem_map: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// GetProtocolsNvmeSubsystemMaps to get protocols_nvme_subsystem_map info for all resources matching a filter
+func GetProtocolsNvmeSubsystemMaps(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *ProtocolsNvmeSubsystemMapDataSourceFilterModel) ([]ProtocolsNvmeSubsystemMapGetDataModelONTAP, error) {
+	api := "protocols/nvme/subsystem-maps"
+	query := r.NewQuery()
+	query.Fields(protocolsNvmeSubsystemMapFields)
+	if filter != nil {
+		var filterMap map[string]interface{}
+		if err := mapstructure.Decode(filter, &filterMap); err != nil {
+			return nil, errorHandler.MakeAndReportError("error encoding protocols_nvme_subsystem_maps filter info", fmt.Sprintf("error on filter %#v: %s", filter, err))
+		}
+		query.SetValues(filterMap)
+	}
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading protocols_nvme_subsystem_maps info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []ProtocolsNvmeSubsystemMapGetDataModelONTAP
+	for _, info := range response {
+		var record ProtocolsNvmeSubsystemMapGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_nvme_subsystem_maps data source: %#v", dataONTAP))
+	return dataONTAP, nil
+}
+
+// CreateProtocolsNvmeSubsystemMap to create protocols_nvme_subsystem_map
+func CreateProtocolsNvmeSubsystemMap(errorHandler *utils.ErrorHandler, r restclient.RestClient, body ProtocolsNvmeSubsystemMapResourceBodyDataModelONTAP) (*ProtocolsNvmeSubsystemMapGetDataModelONTAP, error) {
+	api := "protocols/nvme/subsystem-maps"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return nil, errorHandler.MakeAndReportError("error encoding protocols_nvme_subsystem_map body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	query := r.NewQuery()
+	query.Add("return_records", "true")
+	statusCode, response, err := r.CallCreateMethod(api, query, bodyMap)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error creating protocols_nvme_subsystem_map", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if len(response.Records) == 0 {
+		return nil, errorHandler.MakeAndReportError("error creating protocols_nvme_subsystem_map", fmt.Sprintf("no record returned on POST %s, statusCode %d", api, statusCode))
+	}
+
+	var dataONTAP ProtocolsNvmeSubsystemMapGetDataModelONTAP
+	if err := mapstructure.Decode(response.Records[0], &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError("error decoding protocols_nvme_subsystem_map info", fmt.Sprintf("error on decode %s info: %s, statusCode %d, response %#v", api, err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Create protocols_nvme_subsystem_map source - udata: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// DeleteProtocolsNvmeSubsystemMap to delete protocols_nvme_subsystem_map
+func DeleteProtocolsNvmeSubsystemMap(errorHandler *utils.ErrorHandler, r restclient.RestClient, subsystemUUID string, namespaceUUID string) error {
+	api := fmt.Sprintf("protocols/nvme/subsystem-maps/%s/%s", subsystemUUID, namespaceUUID)
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting protocols_nvme_subsystem_map", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
@@ -0,0 +1,251 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var basicNvmeSubsystemRecord = ProtocolsNvmeSubsystemGetDataModelONTAP{
+	Name:      "subsystem1",
+	UUID:      "1234",
+	SVM:       SvmDataModelONTAP{Name: "svm1", UUID: "5678"},
+	OSType:    "linux",
+	TargetNqn: "nqn.1992-08.com.netapp:sn.5678:subsystem.subsystem1",
+	Hosts:     []NvmeSubsystemHost{{Nqn: "nqn.2014-08.org.nvmexpress:uuid:host1"}},
+}
+
+func TestGetProtocolsNvmeSubsystemByName(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+
+	var recordInterface map[string]any
+	err := mapstructure.Decode(basicNvmeSubsystemRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var badRecordInterface map[string]any
+	err = mapstructure.Decode(struct{ Hosts string }{"host1"}, &badRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	twoRecords := restclient.RestResponse{NumRecords: 2, Records: []map[string]any{recordInterface, recordInterface}}
+	decodeError := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/nvme/subsystems", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/nvme/subsystems", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_two_records_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/nvme/subsystems", StatusCode: 200, Response: twoRecords, Err: genericError},
+		},
+		"test_get_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/nvme/subsystems", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/nvme/subsystems", StatusCode: 200, Response: decodeError, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *ProtocolsNvmeSubsystemGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records", responses: responses["test_no_records"], want: nil, wantErr: false},
+		{name: "test_one_record", responses: responses["test_one_record"], want: &basicNvmeSubsystemRecord, wantErr: false},
+		{name: "test_two_records_error", responses: responses["test_two_records_error"], want: nil, wantErr: true},
+		{name: "test_get_error", responses: responses["test_get_error"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetProtocolsNvmeSubsystemByName(errorHandler, *r, "subsystem1", "svm1")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetProtocolsNvmeSubsystemByName() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetProtocolsNvmeSubsystemByName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateProtocolsNvmeSubsystem(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+
+	var recordInterface map[string]any
+	err := mapstructure.Decode(basicNvmeSubsystemRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	genericError := errors.New("generic error for UT")
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	responses := map[string][]restclient.MockResponse{
+		"test_create": {
+			{ExpectedMethod: "POST", ExpectedURL: "protocols/nvme/subsystems", StatusCode: 201, Response: oneRecord, Err: nil},
+		},
+		"test_no_records": {
+			{ExpectedMethod: "POST", ExpectedURL: "protocols/nvme/subsystems", StatusCode: 201, Response: noRecords, Err: nil},
+		},
+		"test_error": {
+			{ExpectedMethod: "POST", ExpectedURL: "protocols/nvme/subsystems", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *ProtocolsNvmeSubsystemGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_create", responses: responses["test_create"], want: &basicNvmeSubsystemRecord, wantErr: false},
+		{name: "test_no_records", responses: responses["test_no_records"], want: nil, wantErr: true},
+		{name: "test_error", responses: responses["test_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			body := ProtocolsNvmeSubsystemResourceBodyDataModelONTAP{
+				Name:   "subsystem1",
+				SVM:    SvmDataModelONTAP{Name: "svm1"},
+				OSType: "linux",
+				Hosts:  []NvmeSubsystemHost{{Nqn: "nqn.2014-08.org.nvmexpress:uuid:host1"}},
+			}
+			got, err := CreateProtocolsNvmeSubsystem(errorHandler, *r, body)
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CreateProtocolsNvmeSubsystem() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CreateProtocolsNvmeSubsystem() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateDeleteProtocolsNvmeSubsystem(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_update": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "protocols/nvme/subsystems/1234", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_update_error": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "protocols/nvme/subsystems/1234", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_delete": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "protocols/nvme/subsystems/1234", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_delete_not_found": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "protocols/nvme/subsystems/1234", StatusCode: 404, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		delete    bool
+		wantErr   bool
+	}{
+		{name: "test_update", responses: responses["test_update"], delete: false, wantErr: false},
+		{name: "test_update_error", responses: responses["test_update_error"], delete: false, wantErr: true},
+		{name: "test_delete", responses: responses["test_delete"], delete: true, wantErr: false},
+		{name: "test_delete_not_found", responses: responses["test_delete_not_found"], delete: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			if tt.delete {
+				err = DeleteProtocolsNvmeSubsystem(errorHandler, *r, "1234")
+			} else {
+				err = UpdateProtocolsNvmeSubsystem(errorHandler, *r, ProtocolsNvmeSubsystemUpdateBodyDataModelONTAP{Comment: "updated"}, "1234")
+			}
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("%s error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAddRemoveProtocolsNvmeSubsystemHost(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	genericError := errors.New("generic error for UT")
+	nqn := "nqn.2014-08.org.nvmexpress:uuid:host1"
+	responses := map[string][]restclient.MockResponse{
+		"test_add": {
+			{ExpectedMethod: "POST", ExpectedURL: "protocols/nvme/subsystems/1234/hosts", StatusCode: 201, Response: noRecords, Err: nil},
+		},
+		"test_add_error": {
+			{ExpectedMethod: "POST", ExpectedURL: "protocols/nvme/subsystems/1234/hosts", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_remove": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "protocols/nvme/subsystems/1234/hosts/" + nqn, StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_remove_error": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "protocols/nvme/subsystems/1234/hosts/" + nqn, StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		remove    bool
+		wantErr   bool
+	}{
+		{name: "test_add", responses: responses["test_add"], remove: false, wantErr: false},
+		{name: "test_add_error", responses: responses["test_add_error"], remove: false, wantErr: true},
+		{name: "test_remove", responses: responses["test_remove"], remove: true, wantErr: false},
+		{name: "test_remove_error", responses: responses["test_remove_error"], remove: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			if tt.remove {
+				err = RemoveProtocolsNvmeSubsystemHost(errorHandler, *r, "1234", nqn)
+			} else {
+				err = AddProtocolsNvmeSubsystemHost(errorHandler, *r, "1234", nqn)
+			}
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("%s error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			}
+		})
+	}
+}
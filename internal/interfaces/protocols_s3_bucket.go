@@ -0,0 +1,163 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// ProtocolsS3BucketGetDataModelONTAP describes the GET record data model using go types for mapping.
+type ProtocolsS3BucketGetDataModelONTAP struct {
+	UUID            string                           `mapstructure:"uuid"`
+	Name            string                           `mapstructure:"name"`
+	SVM             SvmDataModelONTAP                `mapstructure:"svm"`
+	Comment         string                           `mapstructure:"comment"`
+	Size            int64                            `mapstructure:"size"`
+	LogicalUsedSize int64                            `mapstructure:"logical_used_size"`
+	VersioningState string                           `mapstructure:"versioning_state"`
+	Type            string                           `mapstructure:"type"`
+	NasPath         string                           `mapstructure:"nas_path"`
+	Volume          NameDataModel                    `mapstructure:"volume"`
+	Policy          ProtocolsS3BucketPolicyDataModel `mapstructure:"policy"`
+}
+
+// ProtocolsS3BucketPolicyDataModel describes the access policy of a bucket.
+type ProtocolsS3BucketPolicyDataModel struct {
+	Statements []ProtocolsS3BucketPolicyStatementDataModel `mapstructure:"statements"`
+}
+
+// ProtocolsS3BucketPolicyStatementDataModel describes a statement of a bucket access policy.
+type ProtocolsS3BucketPolicyStatementDataModel struct {
+	Sid        string   `mapstructure:"sid,omitempty"`
+	Effect     string   `mapstructure:"effect"`
+	Actions    []string `mapstructure:"actions"`
+	Principals []string `mapstructure:"principals,omitempty"`
+	Resources  []string `mapstructure:"resources"`
+}
+
+// ProtocolsS3BucketResourceBodyDataModelONTAP describes the POST body data model using go types for mapping.
+type ProtocolsS3BucketResourceBodyDataModelONTAP struct {
+	Name            string                            `mapstructure:"name"`
+	Comment         string                            `mapstructure:"comment,omitempty"`
+	Size            int64                             `mapstructure:"size,omitempty"`
+	VersioningState string                            `mapstructure:"versioning_state,omitempty"`
+	Type            string                            `mapstructure:"type,omitempty"`
+	NasPath         string                            `mapstructure:"nas_path,omitempty"`
+	Policy          *ProtocolsS3BucketPolicyDataModel `mapstructure:"policy,omitempty"`
+}
+
+// ProtocolsS3BucketUpdateBodyDataModelONTAP describes the PATCH body data model using go types for mapping.
+type ProtocolsS3BucketUpdateBodyDataModelONTAP struct {
+	Comment         *string                           `mapstructure:"comment,omitempty"`
+	Size            int64                             `mapstructure:"size,omitempty"`
+	VersioningState string                            `mapstructure:"versioning_state,omitempty"`
+	Policy          *ProtocolsS3BucketPolicyDataModel `mapstructure:"policy,omitempty"`
+}
+
+// ProtocolsS3BucketDataSourceFilterModel describes the data source data model for queries.
+type ProtocolsS3BucketDataSourceFilterModel struct {
+	Name    string `mapstructure:"name,omitempty"`
+	SVMName string `mapstructure:"svm.name,omitempty"`
+	Type    string `mapstructure:"type,omitempty"`
+}
+
+var protocolsS3BucketFields = []string{"uuid", "name", "svm.name", "svm.uuid", "comment", "size", "logical_used_size", "versioning_state", "type", "nas_path", "volume.name", "volume.uuid", "policy"}
+
+// GetProtocolsS3BucketByName to get a S3 bucket
+func GetProtocolsS3BucketByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string, svmName string) (*ProtocolsS3BucketGetDataModelONTAP, error) {
+	api := "protocols/s3/buckets"
+	query := r.NewQuery()
+	query.Set("name", name)
+	query.Set("svm.name", svmName)
+	query.Fields(protocolsS3BucketFields)
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading protocols_s3_bucket info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP ProtocolsS3BucketGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_s3_bucket: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// GetProtocolsS3Buckets to get protocols_s3_bucket info for all buckets matching a filter
+func GetProtocolsS3Buckets(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *ProtocolsS3BucketDataSourceFilterModel) ([]ProtocolsS3BucketGetDataModelONTAP, error) {
+	api := "protocols/s3/buckets"
+	query := r.NewQuery()
+	query.Fields(protocolsS3BucketFields)
+
+	if filter != nil {
+		var filterMap map[string]interface{}
+		if err := mapstructure.Decode(filter, &filterMap); err != nil {
+			return nil, errorHandler.MakeAndReportError("error encoding protocols_s3_buckets filter info", fmt.Sprintf("error on filter %#v: %s", filter, err))
+		}
+		query.SetValues(filterMap)
+	}
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading protocols_s3_buckets info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []ProtocolsS3BucketGetDataModelONTAP
+	for _, info := range response {
+		var record ProtocolsS3BucketGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_s3_buckets: %#v", dataONTAP))
+	return dataONTAP, nil
+}
+
+// CreateProtocolsS3Bucket to create a S3 bucket, ONTAP runs the creation as a job
+func CreateProtocolsS3Bucket(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, body ProtocolsS3BucketResourceBodyDataModelONTAP) error {
+	api := fmt.Sprintf("protocols/s3/services/%s/buckets", svmUUID)
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding protocols_s3_bucket body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error creating protocols_s3_bucket", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// UpdateProtocolsS3Bucket to update a S3 bucket
+func UpdateProtocolsS3Bucket(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, uuid string, body ProtocolsS3BucketUpdateBodyDataModelONTAP) error {
+	api := fmt.Sprintf("protocols/s3/services/%s/buckets/%s", svmUUID, uuid)
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding protocols_s3_bucket body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating protocols_s3_bucket", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteProtocolsS3Bucket to delete a S3 bucket
+func DeleteProtocolsS3Bucket(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, uuid string) error {
+	api := fmt.Sprintf("protocols/s3/services/%s/buckets/%s", svmUUID, uuid)
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting protocols_s3_bucket", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// ProtocolsS3GroupGetDataModelONTAP describes the GET record data model using go types for mapping.
+type ProtocolsS3GroupGetDataModelONTAP struct {
+	ID       int64           `mapstructure:"id"`
+	Name     string          `mapstructure:"name"`
+	Comment  string          `mapstructure:"comment"`
+	Users    []NameDataModel `mapstructure:"users"`
+	Policies []NameDataModel `mapstructure:"policies"`
+}
+
+// ProtocolsS3GroupResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+type ProtocolsS3GroupResourceBodyDataModelONTAP struct {
+	Name     string              `mapstructure:"name,omitempty"`
+	Comment  string              `mapstructure:"comment"`
+	Users    []map[string]string `mapstructure:"users"`
+	Policies []map[string]string `mapstructure:"policies"`
+}
+
+// GetProtocolsS3GroupByName to get a S3 group
+func GetProtocolsS3GroupByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, name string) (*ProtocolsS3GroupGetDataModelONTAP, error) {
+	api := fmt.Sprintf("protocols/s3/services/%s/groups", svmUUID)
+	query := r.NewQuery()
+	query.Set("name", name)
+	query.Fields([]string{"id", "name", "comment", "users.name", "policies.name"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading protocols_s3_group info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP ProtocolsS3GroupGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_s3_group: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// CreateProtocolsS3Group to create a S3 group
+func CreateProtocolsS3Group(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, body ProtocolsS3GroupResourceBodyDataModelONTAP) (*ProtocolsS3GroupGetDataModelONTAP, error) {
+	api := fmt.Sprintf("protocols/s3/services/%s/groups", svmUUID)
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return nil, errorHandler.MakeAndReportError("error encoding protocols_s3_group body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	query := r.NewQuery()
+	query.Add("return_records", "true")
+	statusCode, response, err := r.CallCreateMethod(api, query, bodyMap)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error creating protocols_s3_group", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if len(response.Records) == 0 {
+		return nil, errorHandler.MakeAndReportError("error creating protocols_s3_group", fmt.Sprintf("no record returned on POST %s, statusCode %d", api, statusCode))
+	}
+
+	var dataONTAP ProtocolsS3GroupGetDataModelONTAP
+	if err := mapstructure.Decode(response.Records[0], &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError("error decoding protocols_s3_group info", fmt.Sprintf("error on decode %s info: %s, statusCode %d, response %#v", api, err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Create protocols_s3_group: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// UpdateProtocolsS3Group to update a S3 group
+func UpdateProtocolsS3Group(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, id string, body ProtocolsS3GroupResourceBodyDataModelONTAP) error {
+	api := fmt.Sprintf("protocols/s3/services/%s/groups/%s", svmUUID, id)
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding protocols_s3_group body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating protocols_s3_group", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteProtocolsS3Group to delete a S3 group
+func DeleteProtocolsS3Group(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, id string) error {
+	api := fmt.Sprintf("protocols/s3/services/%s/groups/%s", svmUUID, id)
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting protocols_s3_group", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
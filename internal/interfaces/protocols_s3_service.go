@@ -0,0 +1,102 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// ProtocolsS3ServiceGetDataModelONTAP describes the GET record data model using go types for mapping.
+type ProtocolsS3ServiceGetDataModelONTAP struct {
+	Name           string                          `mapstructure:"name"`
+	SVM            SvmDataModelONTAP               `mapstructure:"svm"`
+	Enabled        bool                            `mapstructure:"enabled"`
+	Comment        string                          `mapstructure:"comment"`
+	IsHTTPEnabled  bool                            `mapstructure:"is_http_enabled"`
+	IsHTTPSEnabled bool                            `mapstructure:"is_https_enabled"`
+	Port           int64                           `mapstructure:"port"`
+	SecurePort     int64                           `mapstructure:"secure_port"`
+	Certificate    ProtocolsS3CertificateDataModel `mapstructure:"certificate"`
+}
+
+// ProtocolsS3CertificateDataModel describes the server certificate used for HTTPS.
+type ProtocolsS3CertificateDataModel struct {
+	Name string `mapstructure:"name"`
+}
+
+// ProtocolsS3ServiceResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+type ProtocolsS3ServiceResourceBodyDataModelONTAP struct {
+	Name           string                           `mapstructure:"name,omitempty"`
+	SVM            *SvmDataModelONTAP               `mapstructure:"svm,omitempty"`
+	Enabled        bool                             `mapstructure:"enabled"`
+	Comment        string                           `mapstructure:"comment"`
+	IsHTTPEnabled  bool                             `mapstructure:"is_http_enabled"`
+	IsHTTPSEnabled bool                             `mapstructure:"is_https_enabled"`
+	Port           int64                            `mapstructure:"port,omitempty"`
+	SecurePort     int64                            `mapstructure:"secure_port,omitempty"`
+	Certificate    *ProtocolsS3CertificateDataModel `mapstructure:"certificate,omitempty"`
+}
+
+// GetProtocolsS3ServiceBySVMName to get the S3 server of an SVM
+func GetProtocolsS3ServiceBySVMName(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmName string) (*ProtocolsS3ServiceGetDataModelONTAP, error) {
+	api := "protocols/s3/services"
+	query := r.NewQuery()
+	query.Set("svm.name", svmName)
+	query.Fields([]string{"name", "svm.name", "svm.uuid", "enabled", "comment", "is_http_enabled", "is_https_enabled", "port", "secure_port", "certificate.name"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading protocols_s3_service info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP ProtocolsS3ServiceGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_s3_service: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// CreateProtocolsS3Service to create the S3 server of an SVM
+func CreateProtocolsS3Service(errorHandler *utils.ErrorHandler, r restclient.RestClient, body ProtocolsS3ServiceResourceBodyDataModelONTAP) error {
+	api := "protocols/s3/services"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding protocols_s3_service body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error creating protocols_s3_service", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// UpdateProtocolsS3Service to update the S3 server of an SVM
+func UpdateProtocolsS3Service(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, body ProtocolsS3ServiceResourceBodyDataModelONTAP) error {
+	api := "protocols/s3/services/" + svmUUID
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding protocols_s3_service body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating protocols_s3_service", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteProtocolsS3Service to delete the S3 server of an SVM
+func DeleteProtocolsS3Service(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string) error {
+	api := "protocols/s3/services/" + svmUUID
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting protocols_s3_service", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package interfaces
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// ProtocolsS3UserGetDataModelONTAP describes the GET record data model using go types for mapping.
+type ProtocolsS3UserGetDataModelONTAP struct {
+	Name          string `mapstructure:"name"`
+	Comment       string `mapstructure:"comment"`
+	AccessKey     string `mapstructure:"access_key"`
+	KeyExpiryTime string `mapstructure:"key_expiry_time"`
+}
+
+// ProtocolsS3UserKeysDataModelONTAP describes the keys ONTAP returns once, when the user is created.
+type ProtocolsS3UserKeysDataModelONTAP struct {
+	Name      string `mapstructure:"name"`
+	AccessKey string `mapstructure:"access_key"`
+	SecretKey string `mapstructure:"secret_key"`
+}
+
+// ProtocolsS3UserResourceBodyDataModelONTAP describes the body data model using go types for mapping.
+type ProtocolsS3UserResourceBodyDataModelONTAP struct {
+	Name          string `mapstructure:"name,omitempty"`
+	Comment       string `mapstructure:"comment"`
+	KeyTimeToLive string `mapstructure:"key_time_to_live,omitempty"`
+}
+
+// GetProtocolsS3UserByName to get a S3 user
+func GetProtocolsS3UserByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, name string) (*ProtocolsS3UserGetDataModelONTAP, error) {
+	api := fmt.Sprintf("protocols/s3/services/%s/users", svmUUID)
+	query := r.NewQuery()
+	query.Set("name", name)
+	query.Fields([]string{"name", "comment", "access_key", "key_expiry_time"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading protocols_s3_user info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP ProtocolsS3UserGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_s3_user: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// CreateProtocolsS3User to create a S3 user, the secret key is only returned here
+func CreateProtocolsS3User(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, body ProtocolsS3UserResourceBodyDataModelONTAP) (*ProtocolsS3UserKeysDataModelONTAP, error) {
+	api := fmt.Sprintf("protocols/s3/services/%s/users", svmUUID)
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return nil, errorHandler.MakeAndReportError("error encoding protocols_s3_user body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	query := r.NewQuery()
+	query.Add("return_records", "true")
+	statusCode, response, err := r.CallCreateMethod(api, query, bodyMap)
+	if err != nil {
+		// the body is not logged, ONTAP does not expect a secret in it but keep the keys out of the logs
+		return nil, errorHandler.MakeAndReportError("error creating protocols_s3_user", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if len(response.Records) == 0 {
+		return nil, errorHandler.MakeAndReportError("error creating protocols_s3_user", fmt.Sprintf("no record returned on POST %s, statusCode %d", api, statusCode))
+	}
+
+	var dataONTAP ProtocolsS3UserKeysDataModelONTAP
+	if err := mapstructure.Decode(response.Records[0], &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError("error decoding protocols_s3_user info", fmt.Sprintf("error on decode %s info: %s, statusCode %d", api, err, statusCode))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Create protocols_s3_user: %s", dataONTAP.Name))
+	return &dataONTAP, nil
+}
+
+// UpdateProtocolsS3User to update a S3 user
+func UpdateProtocolsS3User(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, name string, body ProtocolsS3UserResourceBodyDataModelONTAP) error {
+	api := fmt.Sprintf("protocols/s3/services/%s/users/%s", svmUUID, url.PathEscape(name))
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding protocols_s3_user body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating protocols_s3_user", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteProtocolsS3User to delete a S3 user
+func DeleteProtocolsS3User(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, name string) error {
+	api := fmt.Sprintf("protocols/s3/services/%s/users/%s", svmUUID, url.PathEscape(name))
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting protocols_s3_user", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
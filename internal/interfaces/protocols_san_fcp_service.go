@@ -0,0 +1,143 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// ProtocolsSanFcpServiceGetDataModelONTAP describes the GET record data model using go types for mapping.
+type ProtocolsSanFcpServiceGetDataModelONTAP struct {
+	Enabled bool                `mapstructure:"enabled"`
+	SVM     SvmDataModelONTAP   `mapstructure:"svm"`
+	Target  SanFcpServiceTarget `mapstructure:"target"`
+}
+
+// SanFcpServiceTarget describes the FC target of an SVM, the name is the world wide node name assigned by ONTAP.
+type SanFcpServiceTarget struct {
+	Name string `mapstructure:"name"`
+}
+
+// ProtocolsSanFcpServiceResourceBodyDataModelONTAP describes the POST body data model using go types for mapping.
+type ProtocolsSanFcpServiceResourceBodyDataModelONTAP struct {
+	Enabled bool              `mapstructure:"enabled"`
+	SVM     SvmDataModelONTAP `mapstructure:"svm"`
+}
+
+// ProtocolsSanFcpServiceUpdateBodyDataModelONTAP describes the PATCH body data model using go types for mapping.
+type ProtocolsSanFcpServiceUpdateBodyDataModelONTAP struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// ProtocolsSanFcpServiceDataSourceFilterModel describes the data source data model for queries.
+type ProtocolsSanFcpServiceDataSourceFilterModel struct {
+	SVMName string `mapstructure:"svm.name"`
+}
+
+// GetProtocolsSanFcpService to get protocols_san_fcp_service info
+func GetProtocolsSanFcpService(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmName string) (*ProtocolsSanFcpServiceGetDataModelONTAP, error) {
+	api := "protocols/san/fcp/services"
+	query := r.NewQuery()
+	query.Set("svm.name", svmName)
+	query.Fields([]string{"svm.name", "svm.uuid", "enabled", "target.name"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading protocols_san_fcp_service info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP ProtocolsSanFcpServiceGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_san_fcp_service: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// GetProtocolsSanFcpServices to get protocols_san_fcp_service info for all resources matching a filter
+func GetProtocolsSanFcpServices(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *ProtocolsSanFcpServiceDataSourceFilterModel) ([]ProtocolsSanFcpServiceGetDataModelONTAP, error) {
+	api := "protocols/san/fcp/services"
+	query := r.NewQuery()
+	query.Fields([]string{"svm.name", "svm.uuid", "enabled", "target.name"})
+	if filter != nil {
+		var filterMap map[string]interface{}
+		if err := mapstructure.Decode(filter, &filterMap); err != nil {
+			return nil, errorHandler.MakeAndReportError("error encoding protocols_san_fcp_services filter info", fmt.Sprintf("error on filter %#v: %s", filter, err))
+		}
+		query.SetValues(filterMap)
+	}
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading protocols_san_fcp_services info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []ProtocolsSanFcpServiceGetDataModelONTAP
+	for _, info := range response {
+		var record ProtocolsSanFcpServiceGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_san_fcp_services data source: %#v", dataONTAP))
+	return dataONTAP, nil
+}
+
+// CreateProtocolsSanFcpService to create protocols_san_fcp_service
+func CreateProtocolsSanFcpService(errorHandler *utils.ErrorHandler, r restclient.RestClient, body ProtocolsSanFcpServiceResourceBodyDataModelONTAP) (*ProtocolsSanFcpServiceGetDataModelONTAP, error) {
+	api := "protocols/san/fcp/services"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return nil, errorHandler.MakeAndReportError("error encoding protocols_san_fcp_service body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	query := r.NewQuery()
+	query.Add("return_records", "true")
+	statusCode, response, err := r.CallCreateMethod(api, query, bodyMap)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error creating protocols_san_fcp_service", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if len(response.Records) == 0 {
+		return nil, errorHandler.MakeAndReportError("error creating protocols_san_fcp_service", fmt.Sprintf("no record returned on POST %s, statusCode %d", api, statusCode))
+	}
+
+	var dataONTAP ProtocolsSanFcpServiceGetDataModelONTAP
+	if err := mapstructure.Decode(response.Records[0], &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError("error decoding protocols_san_fcp_service info", fmt.Sprintf("error on decode %s info: %s, statusCode %d, response %#v", api, err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Create protocols_san_fcp_service source - udata: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// UpdateProtocolsSanFcpService to update protocols_san_fcp_service
+func UpdateProtocolsSanFcpService(errorHandler *utils.ErrorHandler, r restclient.RestClient, data ProtocolsSanFcpServiceUpdateBodyDataModelONTAP, svmUUID string) error {
+	api := "protocols/san/fcp/services/" + svmUUID
+	var body map[string]interface{}
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return errorHandler.MakeAndReportError("error encoding protocols_san_fcp_service body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, data))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating protocols_san_fcp_service", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteProtocolsSanFcpService to delete protocols_san_fcp_service, the service needs to be disabled first
+func DeleteProtocolsSanFcpService(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string) error {
+	api := "protocols/san/fcp/services/" + svmUUID
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting protocols_san_fcp_service", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
@@ -68,9 +68,15 @@ type ProtocolsSanIgroupResourceBodyDataModelONTAP struct {
 
 // UpdateProtocolsSanIgroupResourceBodyDataModelONTAP describes the body data model using go types for mapping.
 type UpdateProtocolsSanIgroupResourceBodyDataModelONTAP struct {
-	Name    string `mapstructure:"name,omitempty"`
-	OsType  string `mapstructure:"os_type,omitempty"`
-	Comment string `mapstructure:"comment,omitempty"`
+	Name    string                `mapstructure:"name,omitempty"`
+	OsType  string                `mapstructure:"os_type,omitempty"`
+	Comment string                `mapstructure:"comment,omitempty"`
+	Portset *IgroupPortsetBinding `mapstructure:"portset,omitempty"`
+}
+
+// IgroupPortsetBinding describes the portset an igroup is bound to, an empty name unbinds the portset.
+type IgroupPortsetBinding struct {
+	Name string `mapstructure:"name"`
 }
 
 // ProtocolsSanIgroupDataSourceFilterModel describes the data source data model for queries.
@@ -0,0 +1,122 @@
+package interfaces
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// ProtocolsSanIscsiCredentialsGetDataModelONTAP describes the GET record data model using go types for mapping.
+// CHAP passwords are never returned by ONTAP.
+type ProtocolsSanIscsiCredentialsGetDataModelONTAP struct {
+	SVM                SvmDataModelONTAP       `mapstructure:"svm"`
+	Initiator          string                  `mapstructure:"initiator"`
+	AuthenticationType string                  `mapstructure:"authentication_type"`
+	Chap               SanIscsiCredentialsChap `mapstructure:"chap"`
+}
+
+// SanIscsiCredentialsChap describes the inbound and outbound CHAP credentials.
+type SanIscsiCredentialsChap struct {
+	Inbound  *SanIscsiCredentialsChapUser `mapstructure:"inbound,omitempty"`
+	Outbound *SanIscsiCredentialsChapUser `mapstructure:"outbound,omitempty"`
+}
+
+// SanIscsiCredentialsChapUser describes a CHAP user and password.
+type SanIscsiCredentialsChapUser struct {
+	User     string `mapstructure:"user,omitempty"`
+	Password string `mapstructure:"password,omitempty"`
+}
+
+// SanIscsiCredentialsChapUpdate describes the CHAP credentials of a PATCH body.
+type SanIscsiCredentialsChapUpdate struct {
+	Inbound  *SanIscsiCredentialsChapUser       `mapstructure:"inbound,omitempty"`
+	Outbound *SanIscsiCredentialsChapUserUpdate `mapstructure:"outbound,omitempty"`
+}
+
+// SanIscsiCredentialsChapUserUpdate describes the outbound CHAP user of a PATCH body, an empty user clears the outbound credentials.
+type SanIscsiCredentialsChapUserUpdate struct {
+	User     string `mapstructure:"user"`
+	Password string `mapstructure:"password,omitempty"`
+}
+
+// ProtocolsSanIscsiCredentialsResourceBodyDataModelONTAP describes the POST body data model using go types for mapping.
+type ProtocolsSanIscsiCredentialsResourceBodyDataModelONTAP struct {
+	SVM                svm                      `mapstructure:"svm"`
+	Initiator          string                   `mapstructure:"initiator"`
+	AuthenticationType string                   `mapstructure:"authentication_type"`
+	Chap               *SanIscsiCredentialsChap `mapstructure:"chap,omitempty"`
+}
+
+// ProtocolsSanIscsiCredentialsUpdateBodyDataModelONTAP describes the PATCH body data model using go types for mapping.
+type ProtocolsSanIscsiCredentialsUpdateBodyDataModelONTAP struct {
+	AuthenticationType string                         `mapstructure:"authentication_type,omitempty"`
+	Chap               *SanIscsiCredentialsChapUpdate `mapstructure:"chap,omitempty"`
+}
+
+// GetProtocolsSanIscsiCredentials to get protocols_san_iscsi_credentials info for an initiator
+func GetProtocolsSanIscsiCredentials(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmName string, initiator string) (*ProtocolsSanIscsiCredentialsGetDataModelONTAP, error) {
+	api := "protocols/san/iscsi/credentials"
+	query := r.NewQuery()
+	query.Set("svm.name", svmName)
+	query.Set("initiator", initiator)
+	query.Fields([]string{"svm.name", "svm.uuid", "initiator", "authentication_type", "chap.inbound.user", "chap.outbound.user"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading protocols_san_iscsi_credentials info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP ProtocolsSanIscsiCredentialsGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_san_iscsi_credentials: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// CreateProtocolsSanIscsiCredentials to create protocols_san_iscsi_credentials
+func CreateProtocolsSanIscsiCredentials(errorHandler *utils.ErrorHandler, r restclient.RestClient, body ProtocolsSanIscsiCredentialsResourceBodyDataModelONTAP) error {
+	api := "protocols/san/iscsi/credentials"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding protocols_san_iscsi_credentials body", fmt.Sprintf("error on encoding %s body: %s, initiator: %s", api, err, body.Initiator))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error creating protocols_san_iscsi_credentials", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Create protocols_san_iscsi_credentials for initiator %s", body.Initiator))
+	return nil
+}
+
+// UpdateProtocolsSanIscsiCredentials to update protocols_san_iscsi_credentials
+func UpdateProtocolsSanIscsiCredentials(errorHandler *utils.ErrorHandler, r restclient.RestClient, data ProtocolsSanIscsiCredentialsUpdateBodyDataModelONTAP, svmUUID string, initiator string) error {
+	api := "protocols/san/iscsi/credentials/" + svmUUID + "/" + url.PathEscape(initiator)
+	var body map[string]interface{}
+	// the body is not logged as it may contain CHAP passwords
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return errorHandler.MakeAndReportError("error encoding protocols_san_iscsi_credentials body", fmt.Sprintf("error on encoding %s body: %s", api, err))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating protocols_san_iscsi_credentials", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteProtocolsSanIscsiCredentials to delete protocols_san_iscsi_credentials
+func DeleteProtocolsSanIscsiCredentials(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, initiator string) error {
+	api := "protocols/san/iscsi/credentials/" + svmUUID + "/" + url.PathEscape(initiator)
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting protocols_san_iscsi_credentials", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+func TestUpdateProtocolsSanIscsiCredentials(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_update": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "protocols/san/iscsi/credentials/5678/iqn.1995-08.com.example:host1", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_update_error": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "protocols/san/iscsi/credentials/5678/iqn.1995-08.com.example:host1", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	body := ProtocolsSanIscsiCredentialsUpdateBodyDataModelONTAP{
+		AuthenticationType: "chap",
+		Chap:               &SanIscsiCredentialsChapUpdate{Inbound: &SanIscsiCredentialsChapUser{User: "user1", Password: "password1"}},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_update", responses: responses["test_update"], wantErr: false},
+		{name: "test_update_error", responses: responses["test_update_error"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = UpdateProtocolsSanIscsiCredentials(errorHandler, *r, body, "5678", "iqn.1995-08.com.example:host1")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateProtocolsSanIscsiCredentials() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUpdateProtocolsSanIscsiCredentialsClearOutbound(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	responses := []restclient.MockResponse{
+		{ExpectedMethod: "PATCH", ExpectedURL: "protocols/san/iscsi/credentials/5678/iqn.1995-08.com.example:host1", StatusCode: 200, Response: noRecords, Err: nil},
+	}
+	r, err := restclient.NewRecordingMockedRestClient(responses)
+	if err != nil {
+		panic(err)
+	}
+	body := ProtocolsSanIscsiCredentialsUpdateBodyDataModelONTAP{
+		AuthenticationType: "chap",
+		Chap:               &SanIscsiCredentialsChapUpdate{Outbound: &SanIscsiCredentialsChapUserUpdate{}},
+	}
+	err = UpdateProtocolsSanIscsiCredentials(errorHandler, *r, body, "5678", "iqn.1995-08.com.example:host1")
+	if err != nil {
+		t.Fatalf("UpdateProtocolsSanIscsiCredentials() error = %v", err)
+	}
+	// the empty outbound user must be sent for ONTAP to clear the outbound credentials
+	chap, _ := r.MockRequests()[0].Body["chap"].(map[string]interface{})
+	outbound, _ := chap["outbound"].(map[string]interface{})
+	if user, ok := outbound["user"]; !ok || user != "" {
+		t.Errorf("UpdateProtocolsSanIscsiCredentials() chap = %#v, want an empty outbound user", r.MockRequests()[0].Body["chap"])
+	}
+	if _, ok := outbound["password"]; ok {
+		t.Errorf("UpdateProtocolsSanIscsiCredentials() chap = %#v, want no outbound password", r.MockRequests()[0].Body["chap"])
+	}
+}
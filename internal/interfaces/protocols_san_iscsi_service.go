@@ -0,0 +1,146 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// ProtocolsSanIscsiServiceGetDataModelONTAP describes the GET record data model using go types for mapping.
+type ProtocolsSanIscsiServiceGetDataModelONTAP struct {
+	Enabled bool                  `mapstructure:"enabled"`
+	SVM     SvmDataModelONTAP     `mapstructure:"svm"`
+	Target  SanIscsiServiceTarget `mapstructure:"target"`
+}
+
+// SanIscsiServiceTarget describes the iSCSI target of an SVM.
+type SanIscsiServiceTarget struct {
+	Name  string `mapstructure:"name,omitempty"`
+	Alias string `mapstructure:"alias,omitempty"`
+}
+
+// ProtocolsSanIscsiServiceResourceBodyDataModelONTAP describes the POST body data model using go types for mapping.
+type ProtocolsSanIscsiServiceResourceBodyDataModelONTAP struct {
+	Enabled bool                   `mapstructure:"enabled"`
+	SVM     SvmDataModelONTAP      `mapstructure:"svm"`
+	Target  *SanIscsiServiceTarget `mapstructure:"target,omitempty"`
+}
+
+// ProtocolsSanIscsiServiceUpdateBodyDataModelONTAP describes the PATCH body data model using go types for mapping.
+type ProtocolsSanIscsiServiceUpdateBodyDataModelONTAP struct {
+	Enabled *bool                  `mapstructure:"enabled,omitempty"`
+	Target  *SanIscsiServiceTarget `mapstructure:"target,omitempty"`
+}
+
+// ProtocolsSanIscsiServiceDataSourceFilterModel describes the data source data model for queries.
+type ProtocolsSanIscsiServiceDataSourceFilterModel struct {
+	SVMName string `mapstructure:"svm.name"`
+}
+
+// GetProtocolsSanIscsiService to get protocols_san_iscsi_service info
+func GetProtocolsSanIscsiService(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmName string) (*ProtocolsSanIscsiServiceGetDataModelONTAP, error) {
+	api := "protocols/san/iscsi/services"
+	query := r.NewQuery()
+	query.Set("svm.name", svmName)
+	query.Fields([]string{"svm.name", "svm.uuid", "enabled", "target.name", "target.alias"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading protocols_san_iscsi_service info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP ProtocolsSanIscsiServiceGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_san_iscsi_service: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// GetProtocolsSanIscsiServices to get protocols_san_iscsi_service info for all resources matching a filter
+func GetProtocolsSanIscsiServices(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *ProtocolsSanIscsiServiceDataSourceFilterModel) ([]ProtocolsSanIscsiServiceGetDataModelONTAP, error) {
+	api := "protocols/san/iscsi/services"
+	query := r.NewQuery()
+	query.Fields([]string{"svm.name", "svm.uuid", "enabled", "target.name", "target.alias"})
+	if filter != nil {
+		var filterMap map[string]interface{}
+		if err := mapstructure.Decode(filter, &filterMap); err != nil {
+			return nil, errorHandler.MakeAndReportError("error encoding protocols_san_iscsi_services filter info", fmt.Sprintf("error on filter %#v: %s", filter, err))
+		}
+		query.SetValues(filterMap)
+	}
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading protocols_san_iscsi_services info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []ProtocolsSanIscsiServiceGetDataModelONTAP
+	for _, info := range response {
+		var record ProtocolsSanIscsiServiceGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_san_iscsi_services data source: %#v", dataONTAP))
+	return dataONTAP, nil
+}
+
+// CreateProtocolsSanIscsiService to create protocols_san_iscsi_service
+func CreateProtocolsSanIscsiService(errorHandler *utils.ErrorHandler, r restclient.RestClient, body ProtocolsSanIscsiServiceResourceBodyDataModelONTAP) (*ProtocolsSanIscsiServiceGetDataModelONTAP, error) {
+	api := "protocols/san/iscsi/services"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return nil, errorHandler.MakeAndReportError("error encoding protocols_san_iscsi_service body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	query := r.NewQuery()
+	query.Add("return_records", "true")
+	statusCode, response, err := r.CallCreateMethod(api, query, bodyMap)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error creating protocols_san_iscsi_service", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if len(response.Records) == 0 {
+		return nil, errorHandler.MakeAndReportError("error creating protocols_san_iscsi_service", fmt.Sprintf("no record returned on POST %s, statusCode %d", api, statusCode))
+	}
+
+	var dataONTAP ProtocolsSanIscsiServiceGetDataModelONTAP
+	if err := mapstructure.Decode(response.Records[0], &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError("error decoding protocols_san_iscsi_service info", fmt.Sprintf("error on decode %s info: %s, statusCode %d, response %#v", api, err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Create protocols_san_iscsi_service source - udata: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// UpdateProtocolsSanIscsiService to update protocols_san_iscsi_service
+func UpdateProtocolsSanIscsiService(errorHandler *utils.ErrorHandler, r restclient.RestClient, data ProtocolsSanIscsiServiceUpdateBodyDataModelONTAP, svmUUID string) error {
+	api := "protocols/san/iscsi/services/" + svmUUID
+	var body map[string]interface{}
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return errorHandler.MakeAndReportError("error encoding protocols_san_iscsi_service body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, data))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating protocols_san_iscsi_service", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteProtocolsSanIscsiService to delete protocols_san_iscsi_service, the service needs to be disabled first
+func DeleteProtocolsSanIscsiService(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string) error {
+	api := "protocols/san/iscsi/services/" + svmUUID
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting protocols_san_iscsi_service", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
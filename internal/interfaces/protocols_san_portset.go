@@ -0,0 +1,172 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// ProtocolsSanPortsetGetDataModelONTAP describes the GET record data model using go types for mapping.
+type ProtocolsSanPortsetGetDataModelONTAP struct {
+	Name       string             `mapstructure:"name"`
+	UUID       string             `mapstructure:"uuid"`
+	SVM        SvmDataModelONTAP  `mapstructure:"svm"`
+	Protocol   string             `mapstructure:"protocol"`
+	Interfaces []PortsetInterface `mapstructure:"interfaces,omitempty"`
+}
+
+// PortsetInterface describes a portset member, either an iSCSI (ip) or an FC network interface.
+type PortsetInterface struct {
+	UUID string                `mapstructure:"uuid,omitempty"`
+	IP   *PortsetInterfaceName `mapstructure:"ip,omitempty"`
+	FC   *PortsetInterfaceName `mapstructure:"fc,omitempty"`
+}
+
+// PortsetInterfaceName describes the name of a network interface.
+type PortsetInterfaceName struct {
+	Name string `mapstructure:"name"`
+}
+
+// ProtocolsSanPortsetResourceBodyDataModelONTAP describes the POST body data model using go types for mapping.
+type ProtocolsSanPortsetResourceBodyDataModelONTAP struct {
+	Name       string             `mapstructure:"name"`
+	SVM        svm                `mapstructure:"svm"`
+	Protocol   string             `mapstructure:"protocol"`
+	Interfaces []PortsetInterface `mapstructure:"interfaces,omitempty"`
+}
+
+// ProtocolsSanPortsetDataSourceFilterModel describes the data source data model for queries.
+type ProtocolsSanPortsetDataSourceFilterModel struct {
+	Name     string `mapstructure:"name"`
+	SVMName  string `mapstructure:"svm.name"`
+	Protocol string `mapstructure:"protocol"`
+}
+
+var protocolsSanPortsetFields = []string{"name", "uuid", "svm.name", "svm.uuid", "protocol", "interfaces.uuid", "interfaces.ip.name", "interfaces.fc.name"}
+
+// Name returns the name of the network interface of a portset member.
+func (i PortsetInterface) Name() string {
+	if i.IP != nil {
+		return i.IP.Name
+	}
+	if i.FC != nil {
+		return i.FC.Name
+	}
+	return ""
+}
+
+// GetProtocolsSanPortsetByName to get protocols_san_portset info
+func GetProtocolsSanPortsetByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string, svmName string) (*ProtocolsSanPortsetGetDataModelONTAP, error) {
+	api := "protocols/san/portsets"
+	query := r.NewQuery()
+	query.Set("name", name)
+	query.Set("svm.name", svmName)
+	query.Fields(protocolsSanPortsetFields)
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading protocols_san_portset info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP ProtocolsSanPortsetGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_san_portset: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// GetProtocolsSanPortsets to get protocols_san_portset info for all resources matching a filter
+func GetProtocolsSanPortsets(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *ProtocolsSanPortsetDataSourceFilterModel) ([]ProtocolsSanPortsetGetDataModelONTAP, error) {
+	api := "protocols/san/portsets"
+	query := r.NewQuery()
+	query.Fields(protocolsSanPortsetFields)
+	if filter != nil {
+		var filterMap map[string]interface{}
+		if err := mapstructure.Decode(filter, &filterMap); err != nil {
+			return nil, errorHandler.MakeAndReportError("error encoding protocols_san_portsets filter info", fmt.Sprintf("error on filter %#v: %s", filter, err))
+		}
+		query.SetValues(filterMap)
+	}
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading protocols_san_portsets info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []ProtocolsSanPortsetGetDataModelONTAP
+	for _, info := range response {
+		var record ProtocolsSanPortsetGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_san_portsets data source: %#v", dataONTAP))
+	return dataONTAP, nil
+}
+
+// CreateProtocolsSanPortset to create protocols_san_portset
+func CreateProtocolsSanPortset(errorHandler *utils.ErrorHandler, r restclient.RestClient, body ProtocolsSanPortsetResourceBodyDataModelONTAP) (*ProtocolsSanPortsetGetDataModelONTAP, error) {
+	api := "protocols/san/portsets"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return nil, errorHandler.MakeAndReportError("error encoding protocols_san_portset body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	query := r.NewQuery()
+	query.Add("return_records", "true")
+	statusCode, response, err := r.CallCreateMethod(api, query, bodyMap)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error creating protocols_san_portset", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP ProtocolsSanPortsetGetDataModelONTAP
+	if err := mapstructure.Decode(response.Records[0], &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError("error decoding protocols_san_portset info", fmt.Sprintf("error on decode %s info: %s, statusCode %d, response %#v", api, err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Create protocols_san_portset source - udata: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// DeleteProtocolsSanPortset to delete protocols_san_portset
+func DeleteProtocolsSanPortset(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) error {
+	api := "protocols/san/portsets/" + uuid
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting protocols_san_portset", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// AddProtocolsSanPortsetInterface to add a network interface to the portset
+func AddProtocolsSanPortsetInterface(errorHandler *utils.ErrorHandler, r restclient.RestClient, portsetUUID string, member PortsetInterface) error {
+	api := "protocols/san/portsets/" + portsetUUID + "/interfaces"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(member, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding protocols_san_portset interface body", fmt.Sprintf("error on encoding %s body: %s, interface: %s", api, err, member.Name()))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error adding protocols_san_portset interface", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// RemoveProtocolsSanPortsetInterface to remove a network interface from the portset
+func RemoveProtocolsSanPortsetInterface(errorHandler *utils.ErrorHandler, r restclient.RestClient, portsetUUID string, interfaceUUID string) error {
+	api := "protocols/san/portsets/" + portsetUUID + "/interfaces/" + interfaceUUID
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error removing protocols_san_portset interface", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var basicSanPortsetRecord = ProtocolsSanPortsetGetDataModelONTAP{
+	Name:     "portset1",
+	UUID:     "1234",
+	SVM:      SvmDataModelONTAP{Name: "svm1", UUID: "5678"},
+	Protocol: "mixed",
+	Interfaces: []PortsetInterface{
+		{UUID: "9abc", IP: &PortsetInterfaceName{Name: "lif1"}},
+		{UUID: "def0", FC: &PortsetInterfaceName{Name: "fc_lif1"}},
+	},
+}
+
+func TestGetProtocolsSanPortsetByName(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+
+	var recordInterface map[string]any
+	err := mapstructure.Decode(basicSanPortsetRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var badRecordInterface map[string]any
+	err = mapstructure.Decode(struct{ Interfaces string }{"lif1"}, &badRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	twoRecords := restclient.RestResponse{NumRecords: 2, Records: []map[string]any{recordInterface, recordInterface}}
+	decodeError := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/san/portsets", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/san/portsets", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_two_records_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/san/portsets", StatusCode: 200, Response: twoRecords, Err: genericError},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/san/portsets", StatusCode: 200, Response: decodeError, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *ProtocolsSanPortsetGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records", responses: responses["test_no_records"], want: nil, wantErr: false},
+		{name: "test_one_record", responses: responses["test_one_record"], want: &basicSanPortsetRecord, wantErr: false},
+		{name: "test_two_records_error", responses: responses["test_two_records_error"], want: nil, wantErr: true},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetProtocolsSanPortsetByName(errorHandler, *r, "portset1", "svm1")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetProtocolsSanPortsetByName() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetProtocolsSanPortsetByName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddRemoveProtocolsSanPortsetInterface(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_add": {
+			{ExpectedMethod: "POST", ExpectedURL: "protocols/san/portsets/1234/interfaces", StatusCode: 201, Response: noRecords, Err: nil},
+		},
+		"test_add_error": {
+			{ExpectedMethod: "POST", ExpectedURL: "protocols/san/portsets/1234/interfaces", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+		"test_remove": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "protocols/san/portsets/1234/interfaces/9abc", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_remove_error": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "protocols/san/portsets/1234/interfaces/9abc", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		remove    bool
+		wantErr   bool
+	}{
+		{name: "test_add", responses: responses["test_add"], remove: false, wantErr: false},
+		{name: "test_add_error", responses: responses["test_add_error"], remove: false, wantErr: true},
+		{name: "test_remove", responses: responses["test_remove"], remove: true, wantErr: false},
+		{name: "test_remove_error", responses: responses["test_remove_error"], remove: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			if tt.remove {
+				err = RemoveProtocolsSanPortsetInterface(errorHandler, *r, "1234", "9abc")
+			} else {
+				err = AddProtocolsSanPortsetInterface(errorHandler, *r, "1234", PortsetInterface{IP: &PortsetInterfaceName{Name: "lif1"}})
+			}
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("%s error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPortsetInterfaceName(t *testing.T) {
+	for _, member := range basicSanPortsetRecord.Interfaces {
+		if member.Name() == "" {
+			t.Errorf("PortsetInterface.Name() is empty for %#v", member)
+		}
+	}
+	if name := (PortsetInterface{}).Name(); name != "" {
+		t.Errorf("PortsetInterface.Name() = %s, want empty", name)
+	}
+}
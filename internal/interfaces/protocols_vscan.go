@@ -0,0 +1,78 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// ProtocolsVscanGetDataModelONTAP describes the GET record data model using go types for mapping.
+type ProtocolsVscanGetDataModelONTAP struct {
+	SVM     SvmDataModelONTAP `mapstructure:"svm"`
+	Enabled bool              `mapstructure:"enabled"`
+}
+
+// ProtocolsVscanResourceBodyDataModelONTAP describes the POST body data model using go types for mapping.
+type ProtocolsVscanResourceBodyDataModelONTAP struct {
+	SVM     svm  `mapstructure:"svm"`
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// ProtocolsVscanUpdateBodyDataModelONTAP describes the PATCH body data model using go types for mapping.
+type ProtocolsVscanUpdateBodyDataModelONTAP struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// GetProtocolsVscanBySVMName to get the Vscan configuration of an SVM
+func GetProtocolsVscanBySVMName(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmName string) (*ProtocolsVscanGetDataModelONTAP, error) {
+	api := "protocols/vscan"
+	query := r.NewQuery()
+	query.Set("svm.name", svmName)
+	query.Fields([]string{"svm.name", "svm.uuid", "enabled"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading protocols_vscan info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP ProtocolsVscanGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_vscan: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// CreateProtocolsVscan to create the Vscan configuration of an SVM
+func CreateProtocolsVscan(errorHandler *utils.ErrorHandler, r restclient.RestClient, body ProtocolsVscanResourceBodyDataModelONTAP) error {
+	api := "protocols/vscan"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding protocols_vscan body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error creating protocols_vscan", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// UpdateProtocolsVscan to enable or disable Vscan on an SVM
+func UpdateProtocolsVscan(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, body ProtocolsVscanUpdateBodyDataModelONTAP) error {
+	api := "protocols/vscan/" + svmUUID
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding protocols_vscan body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating protocols_vscan", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
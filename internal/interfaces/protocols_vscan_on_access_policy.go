@@ -0,0 +1,106 @@
+package interfaces
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// ProtocolsVscanOnAccessPolicyGetDataModelONTAP describes the GET record data model using go types for mapping.
+type ProtocolsVscanOnAccessPolicyGetDataModelONTAP struct {
+	Name      string                      `mapstructure:"name"`
+	Enabled   bool                        `mapstructure:"enabled"`
+	Mandatory bool                        `mapstructure:"mandatory"`
+	Scope     VscanOnAccessScopeDataModel `mapstructure:"scope"`
+}
+
+// VscanOnAccessScopeDataModel describes the scope of an on-access policy.
+type VscanOnAccessScopeDataModel struct {
+	MaxFileSize          int64    `mapstructure:"max_file_size,omitempty"`
+	IncludeExtensions    []string `mapstructure:"include_extensions,omitempty"`
+	ExcludeExtensions    []string `mapstructure:"exclude_extensions,omitempty"`
+	ExcludePaths         []string `mapstructure:"exclude_paths,omitempty"`
+	ScanWithoutExtension *bool    `mapstructure:"scan_without_extension,omitempty"`
+	OnlyExecuteAccess    *bool    `mapstructure:"only_execute_access,omitempty"`
+	ScanReadonlyVolumes  *bool    `mapstructure:"scan_readonly_volumes,omitempty"`
+}
+
+// ProtocolsVscanOnAccessPolicyResourceBodyDataModelONTAP describes the POST body data model using go types for mapping.
+type ProtocolsVscanOnAccessPolicyResourceBodyDataModelONTAP struct {
+	Name      string                       `mapstructure:"name"`
+	Enabled   bool                         `mapstructure:"enabled"`
+	Mandatory bool                         `mapstructure:"mandatory"`
+	Scope     *VscanOnAccessScopeDataModel `mapstructure:"scope,omitempty"`
+}
+
+// ProtocolsVscanOnAccessPolicyUpdateBodyDataModelONTAP describes the PATCH body data model using go types for mapping.
+type ProtocolsVscanOnAccessPolicyUpdateBodyDataModelONTAP struct {
+	Enabled   *bool                        `mapstructure:"enabled,omitempty"`
+	Mandatory *bool                        `mapstructure:"mandatory,omitempty"`
+	Scope     *VscanOnAccessScopeDataModel `mapstructure:"scope,omitempty"`
+}
+
+// GetProtocolsVscanOnAccessPolicyByName to get protocols_vscan_on_access_policy info
+func GetProtocolsVscanOnAccessPolicyByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, name string) (*ProtocolsVscanOnAccessPolicyGetDataModelONTAP, error) {
+	api := "protocols/vscan/" + svmUUID + "/on-access-policies"
+	query := r.NewQuery()
+	query.Set("name", name)
+	query.Fields([]string{"name", "enabled", "mandatory", "scope"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading protocols_vscan_on_access_policy info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP ProtocolsVscanOnAccessPolicyGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_vscan_on_access_policy: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// CreateProtocolsVscanOnAccessPolicy to create protocols_vscan_on_access_policy
+func CreateProtocolsVscanOnAccessPolicy(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, body ProtocolsVscanOnAccessPolicyResourceBodyDataModelONTAP) error {
+	api := "protocols/vscan/" + svmUUID + "/on-access-policies"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding protocols_vscan_on_access_policy body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error creating protocols_vscan_on_access_policy", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// UpdateProtocolsVscanOnAccessPolicy to update protocols_vscan_on_access_policy
+func UpdateProtocolsVscanOnAccessPolicy(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, name string, body ProtocolsVscanOnAccessPolicyUpdateBodyDataModelONTAP) error {
+	api := "protocols/vscan/" + svmUUID + "/on-access-policies/" + url.PathEscape(name)
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding protocols_vscan_on_access_policy body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating protocols_vscan_on_access_policy", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteProtocolsVscanOnAccessPolicy to delete protocols_vscan_on_access_policy
+func DeleteProtocolsVscanOnAccessPolicy(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, name string) error {
+	api := "protocols/vscan/" + svmUUID + "/on-access-policies/" + url.PathEscape(name)
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting protocols_vscan_on_access_policy", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
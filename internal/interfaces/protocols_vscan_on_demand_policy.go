@@ -0,0 +1,107 @@
+package interfaces
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// ProtocolsVscanOnDemandPolicyGetDataModelONTAP describes the GET record data model using go types for mapping.
+type ProtocolsVscanOnDemandPolicyGetDataModelONTAP struct {
+	Name      string                      `mapstructure:"name"`
+	LogPath   string                      `mapstructure:"log_path"`
+	ScanPaths []string                    `mapstructure:"scan_paths"`
+	Schedule  *NameDataModel              `mapstructure:"schedule,omitempty"`
+	Scope     VscanOnDemandScopeDataModel `mapstructure:"scope"`
+}
+
+// VscanOnDemandScopeDataModel describes the scope of an on-demand task.
+type VscanOnDemandScopeDataModel struct {
+	MaxFileSize          int64    `mapstructure:"max_file_size,omitempty"`
+	IncludeExtensions    []string `mapstructure:"include_extensions,omitempty"`
+	ExcludeExtensions    []string `mapstructure:"exclude_extensions,omitempty"`
+	ExcludePaths         []string `mapstructure:"exclude_paths,omitempty"`
+	ScanWithoutExtension *bool    `mapstructure:"scan_without_extension,omitempty"`
+}
+
+// ProtocolsVscanOnDemandPolicyResourceBodyDataModelONTAP describes the POST body data model using go types for mapping.
+type ProtocolsVscanOnDemandPolicyResourceBodyDataModelONTAP struct {
+	Name      string                       `mapstructure:"name"`
+	LogPath   string                       `mapstructure:"log_path"`
+	ScanPaths []string                     `mapstructure:"scan_paths"`
+	Schedule  *NameDataModel               `mapstructure:"schedule,omitempty"`
+	Scope     *VscanOnDemandScopeDataModel `mapstructure:"scope,omitempty"`
+}
+
+// ProtocolsVscanOnDemandPolicyUpdateBodyDataModelONTAP describes the PATCH body data model using go types for mapping.
+type ProtocolsVscanOnDemandPolicyUpdateBodyDataModelONTAP struct {
+	LogPath   string                       `mapstructure:"log_path,omitempty"`
+	ScanPaths []string                     `mapstructure:"scan_paths,omitempty"`
+	Schedule  *NameDataModel               `mapstructure:"schedule,omitempty"`
+	Scope     *VscanOnDemandScopeDataModel `mapstructure:"scope,omitempty"`
+}
+
+// GetProtocolsVscanOnDemandPolicyByName to get protocols_vscan_on_demand_policy info
+func GetProtocolsVscanOnDemandPolicyByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, name string) (*ProtocolsVscanOnDemandPolicyGetDataModelONTAP, error) {
+	api := "protocols/vscan/" + svmUUID + "/on-demand-policies"
+	query := r.NewQuery()
+	query.Set("name", name)
+	query.Fields([]string{"name", "log_path", "scan_paths", "schedule", "scope"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading protocols_vscan_on_demand_policy info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP ProtocolsVscanOnDemandPolicyGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_vscan_on_demand_policy: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// CreateProtocolsVscanOnDemandPolicy to create protocols_vscan_on_demand_policy
+func CreateProtocolsVscanOnDemandPolicy(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, body ProtocolsVscanOnDemandPolicyResourceBodyDataModelONTAP) error {
+	api := "protocols/vscan/" + svmUUID + "/on-demand-policies"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding protocols_vscan_on_demand_policy body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error creating protocols_vscan_on_demand_policy", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// UpdateProtocolsVscanOnDemandPolicy to update protocols_vscan_on_demand_policy
+func UpdateProtocolsVscanOnDemandPolicy(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, name string, body ProtocolsVscanOnDemandPolicyUpdateBodyDataModelONTAP) error {
+	api := "protocols/vscan/" + svmUUID + "/on-demand-policies/" + url.PathEscape(name)
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding protocols_vscan_on_demand_policy body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating protocols_vscan_on_demand_policy", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteProtocolsVscanOnDemandPolicy to delete protocols_vscan_on_demand_policy
+func DeleteProtocolsVscanOnDemandPolicy(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, name string) error {
+	api := "protocols/vscan/" + svmUUID + "/on-demand-policies/" + url.PathEscape(name)
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting protocols_vscan_on_demand_policy", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package interfaces
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// ProtocolsVscanScannerPoolGetDataModelONTAP describes the GET record data model using go types for mapping.
+type ProtocolsVscanScannerPoolGetDataModelONTAP struct {
+	Name            string   `mapstructure:"name"`
+	Servers         []string `mapstructure:"servers,omitempty"`
+	PrivilegedUsers []string `mapstructure:"privileged_users,omitempty"`
+	Role            string   `mapstructure:"role,omitempty"`
+}
+
+// VscanScannerPoolCluster describes the cluster on which the scanner pool role is applied.
+type VscanScannerPoolCluster struct {
+	Name string `mapstructure:"name"`
+}
+
+// ProtocolsVscanScannerPoolResourceBodyDataModelONTAP describes the POST body data model using go types for mapping.
+type ProtocolsVscanScannerPoolResourceBodyDataModelONTAP struct {
+	Name            string                   `mapstructure:"name"`
+	Servers         []string                 `mapstructure:"servers"`
+	PrivilegedUsers []string                 `mapstructure:"privileged_users"`
+	Role            string                   `mapstructure:"role,omitempty"`
+	Cluster         *VscanScannerPoolCluster `mapstructure:"cluster,omitempty"`
+}
+
+// ProtocolsVscanScannerPoolUpdateBodyDataModelONTAP describes the PATCH body data model using go types for mapping.
+type ProtocolsVscanScannerPoolUpdateBodyDataModelONTAP struct {
+	Servers         []string                 `mapstructure:"servers,omitempty"`
+	PrivilegedUsers []string                 `mapstructure:"privileged_users,omitempty"`
+	Role            string                   `mapstructure:"role,omitempty"`
+	Cluster         *VscanScannerPoolCluster `mapstructure:"cluster,omitempty"`
+}
+
+// GetProtocolsVscanScannerPoolByName to get protocols_vscan_scanner_pool info
+func GetProtocolsVscanScannerPoolByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, name string) (*ProtocolsVscanScannerPoolGetDataModelONTAP, error) {
+	api := "protocols/vscan/" + svmUUID + "/scanner-pools"
+	query := r.NewQuery()
+	query.Set("name", name)
+	query.Fields([]string{"name", "servers", "privileged_users", "role"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading protocols_vscan_scanner_pool info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP ProtocolsVscanScannerPoolGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read protocols_vscan_scanner_pool: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// CreateProtocolsVscanScannerPool to create protocols_vscan_scanner_pool
+func CreateProtocolsVscanScannerPool(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, body ProtocolsVscanScannerPoolResourceBodyDataModelONTAP) error {
+	api := "protocols/vscan/" + svmUUID + "/scanner-pools"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding protocols_vscan_scanner_pool body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error creating protocols_vscan_scanner_pool", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// UpdateProtocolsVscanScannerPool to update protocols_vscan_scanner_pool
+func UpdateProtocolsVscanScannerPool(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, name string, body ProtocolsVscanScannerPoolUpdateBodyDataModelONTAP) error {
+	api := "protocols/vscan/" + svmUUID + "/scanner-pools/" + url.PathEscape(name)
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding protocols_vscan_scanner_pool body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating protocols_vscan_scanner_pool", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteProtocolsVscanScannerPool to delete protocols_vscan_scanner_pool
+func DeleteProtocolsVscanScannerPool(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, name string) error {
+	api := "protocols/vscan/" + svmUUID + "/scanner-pools/" + url.PathEscape(name)
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting protocols_vscan_scanner_pool", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
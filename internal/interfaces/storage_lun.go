@@ -20,6 +20,29 @@ type StorageLunGetDataModelONTAP struct {
 	QoSPolicy    LunQoSPolicy `mapstructure:"qos_policy,omitempty"`
 	Space        LunSpace     `mapstructure:"space,omitempty"`
 	SerialNumber string       `mapstructure:"serial_number,omitempty"`
+	Status       LunStatus    `mapstructure:"status,omitempty"`
+	Movement     LunMovement  `mapstructure:"movement,omitempty"`
+}
+
+// LunStatus describes the data model for status.
+type LunStatus struct {
+	Mapped bool `mapstructure:"mapped"`
+}
+
+// LunMovement describes the data model for movement, set while or after a LUN is moved between volumes.
+type LunMovement struct {
+	Progress LunMovementProgress `mapstructure:"progress,omitempty"`
+}
+
+// LunMovementProgress describes the data model for movement progress.
+type LunMovementProgress struct {
+	State   string             `mapstructure:"state,omitempty"`
+	Failure LunMovementFailure `mapstructure:"failure,omitempty"`
+}
+
+// LunMovementFailure describes the data model for a movement failure.
+type LunMovementFailure struct {
+	Message string `mapstructure:"message,omitempty"`
 }
 
 // LunLocation describes the data model for location.
@@ -48,12 +71,24 @@ type LunSpace struct {
 
 // StorageLunResourceBodyDataModelONTAP describes the body data model using go types for mapping.
 type StorageLunResourceBodyDataModelONTAP struct {
-	Name      string   `mapstructure:"name,omitempty"`
-	SVM       svm      `mapstructure:"svm,omitempty"`
-	Locations location `mapstructure:"location,omitempty"`
-	OsType    string   `mapstructure:"os_type,omitempty"`
-	Space     space    `mapstructure:"space,omitempty"`
-	QosPolicy string   `mapstructure:"qos_policy,omitempty"`
+	Name      string    `mapstructure:"name,omitempty"`
+	SVM       svm       `mapstructure:"svm,omitempty"`
+	Locations location  `mapstructure:"location,omitempty"`
+	OsType    string    `mapstructure:"os_type,omitempty"`
+	Space     space     `mapstructure:"space,omitempty"`
+	QosPolicy string    `mapstructure:"qos_policy,omitempty"`
+	Clone     *LunClone `mapstructure:"clone,omitempty"`
+}
+
+// LunClone describes the data model to create a LUN as a clone of another LUN or of a LUN in a snapshot.
+type LunClone struct {
+	Source LunCloneSource `mapstructure:"source"`
+}
+
+// LunCloneSource describes the source of a LUN clone, the name is the path of the source LUN.
+type LunCloneSource struct {
+	Name string `mapstructure:"name,omitempty"`
+	UUID string `mapstructure:"uuid,omitempty"`
 }
 
 type location struct {
@@ -105,7 +140,7 @@ func GetStorageLunByName(errorHandler *utils.ErrorHandler, r restclient.RestClie
 func GetStorageLunByUUID(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) (*StorageLunGetDataModelONTAP, error) {
 	api := "storage/luns/" + uuid
 	query := r.NewQuery()
-	query.Fields([]string{"name", "svm.name", "create_time", "location", "os_type", "qos_policy", "space", "serial_number", "uuid"})
+	query.Fields([]string{"name", "svm.name", "create_time", "location", "os_type", "qos_policy", "space", "serial_number", "uuid", "status.mapped", "movement.progress"})
 	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
 	if err == nil && response == nil {
 		err = fmt.Errorf("no response for GET %s", api)
@@ -0,0 +1,79 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// StorageQuotaReportGetDataModelONTAP describes the GET record data model using go types for mapping.
+type StorageQuotaReportGetDataModelONTAP struct {
+	Index     int64                 `mapstructure:"index"`
+	SVM       svm                   `mapstructure:"svm"`
+	Volume    volume                `mapstructure:"volume"`
+	Type      string                `mapstructure:"type"`
+	Qtree     Qtree                 `mapstructure:"qtree,omitempty"`
+	Users     []User                `mapstructure:"users,omitempty"`
+	Group     Group                 `mapstructure:"group,omitempty"`
+	Specifier string                `mapstructure:"specifier,omitempty"`
+	Space     QuotaReportUsageONTAP `mapstructure:"space,omitempty"`
+	Files     QuotaReportUsageONTAP `mapstructure:"files,omitempty"`
+}
+
+// QuotaReportUsageONTAP describes the limits and usage reported for space or files.
+type QuotaReportUsageONTAP struct {
+	HardLimit int64                `mapstructure:"hard_limit,omitempty"`
+	SoftLimit int64                `mapstructure:"soft_limit,omitempty"`
+	Used      QuotaReportUsedONTAP `mapstructure:"used,omitempty"`
+}
+
+// QuotaReportUsedONTAP describes the usage reported for space or files.
+type QuotaReportUsedONTAP struct {
+	Total            int64 `mapstructure:"total"`
+	HardLimitPercent int64 `mapstructure:"hard_limit_percent,omitempty"`
+	SoftLimitPercent int64 `mapstructure:"soft_limit_percent,omitempty"`
+}
+
+// StorageQuotaReportsDataSourceFilterModel describes the data source data model for queries.
+type StorageQuotaReportsDataSourceFilterModel struct {
+	Type       string `mapstructure:"type"`
+	SVMName    string `mapstructure:"svm.name"`
+	VolumeName string `mapstructure:"volume.name"`
+	QtreeName  string `mapstructure:"qtree.name"`
+}
+
+// GetStorageQuotaReports to get quota reports info for all records matching a filter
+func GetStorageQuotaReports(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *StorageQuotaReportsDataSourceFilterModel) ([]StorageQuotaReportGetDataModelONTAP, error) {
+	api := "storage/quota/reports"
+	query := r.NewQuery()
+	query.Fields([]string{"index", "svm.name", "volume.name", "type", "qtree.name", "users.name", "group.name", "specifier", "space", "files"})
+	if filter != nil {
+		var filterMap map[string]interface{}
+		if err := mapstructure.Decode(filter, &filterMap); err != nil {
+			return nil, errorHandler.MakeAndReportError("error encoding quota_reports filter info", fmt.Sprintf("error on filter %#v: %s", filter, err))
+		}
+		query.SetValues(filterMap)
+	}
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading quota_reports info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []StorageQuotaReportGetDataModelONTAP
+	for _, info := range response {
+		var record StorageQuotaReportGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read quota_reports data source: %#v", dataONTAP))
+	return dataONTAP, nil
+}
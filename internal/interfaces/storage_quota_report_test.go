@@ -0,0 +1,105 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var basicStorageQuotaReportRecord = StorageQuotaReportGetDataModelONTAP{
+	Index:  1,
+	SVM:    svm{Name: "svm1"},
+	Volume: volume{Name: "vol1"},
+	Type:   "tree",
+	Qtree:  Qtree{Name: "qt1"},
+	Space: QuotaReportUsageONTAP{
+		HardLimit: 1073741824,
+		SoftLimit: 858993459,
+		Used: QuotaReportUsedONTAP{
+			Total:            536870912,
+			HardLimitPercent: 50,
+			SoftLimitPercent: 62,
+		},
+	},
+	Files: QuotaReportUsageONTAP{
+		HardLimit: 100,
+		Used: QuotaReportUsedONTAP{
+			Total:            10,
+			HardLimitPercent: 10,
+		},
+	},
+}
+
+func TestGetStorageQuotaReports(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(basicStorageQuotaReportRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var badRecordInterface map[string]any
+	err = mapstructure.Decode(struct{ Index string }{"one"}, &badRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	twoRecords := restclient.RestResponse{NumRecords: 2, Records: []map[string]any{recordInterface, recordInterface}}
+	decodeError := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/quota/reports", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/quota/reports", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_two_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/quota/reports", StatusCode: 200, Response: twoRecords, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/quota/reports", StatusCode: 200, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/quota/reports", StatusCode: 200, Response: decodeError, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      []StorageQuotaReportGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: []StorageQuotaReportGetDataModelONTAP{basicStorageQuotaReportRecord}, wantErr: false},
+		{name: "test_two_records_1", responses: responses["test_two_records_1"], want: []StorageQuotaReportGetDataModelONTAP{basicStorageQuotaReportRecord, basicStorageQuotaReportRecord}, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+		{name: "test_decode_error_1", responses: responses["test_decode_error_1"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetStorageQuotaReports(errorHandler, *r, &StorageQuotaReportsDataSourceFilterModel{VolumeName: "vol1"})
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetStorageQuotaReports() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetStorageQuotaReports() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
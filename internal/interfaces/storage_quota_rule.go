@@ -11,31 +11,34 @@ import (
 
 // StorageQuotaRulesGetDataModelONTAP describes the GET record data model using go types for mapping.
 type StorageQuotaRulesGetDataModelONTAP struct {
-	SVM         svm    `mapstructure:"svm"`
-	Volume      volume `mapstructure:"volume"`
-	Users       []User `mapstructure:"users,omitempty"`
-	Group       Group  `mapstructure:"group,omitempty"`
-	Qtree       Qtree  `mapstructure:"qtree,omitempty"`
-	Type        string `mapstructure:"type"`
-	Files       Files  `mapstructure:"files,omitempty"`
-	UserMapping bool   `mapstructure:"user_mapping,omitempty"`
-	UUID        string `mapstructure:"uuid"`
+	SVM         svm        `mapstructure:"svm"`
+	Volume      volume     `mapstructure:"volume"`
+	Users       []User     `mapstructure:"users,omitempty"`
+	Group       Group      `mapstructure:"group,omitempty"`
+	Qtree       Qtree      `mapstructure:"qtree,omitempty"`
+	Type        string     `mapstructure:"type"`
+	Files       Files      `mapstructure:"files,omitempty"`
+	Space       QuotaSpace `mapstructure:"space,omitempty"`
+	UserMapping bool       `mapstructure:"user_mapping,omitempty"`
+	UUID        string     `mapstructure:"uuid"`
 }
 
 // StorageQuotaRulesResourceBodyDataModelONTAP describes the body data model using go types for mapping.
 type StorageQuotaRulesResourceBodyDataModelONTAP struct {
-	SVM    svm      `mapstructure:"svm,omitempty"`
-	Volume volume   `mapstructure:"volume,omitempty"`
-	Users  []string `mapstructure:"users,omitempty"`
-	Group  Group    `mapstructure:"group,omitempty"`
-	Qtree  Qtree    `mapstructure:"qtree"`
-	Type   string   `mapstructure:"type,omitempty"`
-	Files  Files    `mapstructure:"files,omitempty"`
+	SVM    svm         `mapstructure:"svm,omitempty"`
+	Volume volume      `mapstructure:"volume,omitempty"`
+	Users  []string    `mapstructure:"users,omitempty"`
+	Group  Group       `mapstructure:"group,omitempty"`
+	Qtree  Qtree       `mapstructure:"qtree"`
+	Type   string      `mapstructure:"type,omitempty"`
+	Files  Files       `mapstructure:"files,omitempty"`
+	Space  *QuotaSpace `mapstructure:"space,omitempty"`
 }
 
 // StorageQuotaRulesResourceBodyUpdateModelONTAP describes the body data model using go types for mapping.
 type StorageQuotaRulesResourceBodyUpdateModelONTAP struct {
-	Files Files `mapstructure:"files,omitempty"`
+	Files *Files      `mapstructure:"files,omitempty"`
+	Space *QuotaSpace `mapstructure:"space,omitempty"`
 }
 
 // StorageQuotaRulesCreateResponse describes the Create record data model using go types for mapping.
@@ -60,6 +63,29 @@ type Files struct {
 	HardLimit int64 `mapstructure:"hard_limit"`
 }
 
+// QuotaSpace describes the space limits, in bytes, of a quota rule.
+type QuotaSpace struct {
+	SoftLimit int64 `mapstructure:"soft_limit"`
+	HardLimit int64 `mapstructure:"hard_limit"`
+}
+
+// StorageVolumeQuotaGetDataModelONTAP describes the quota state of a volume.
+type StorageVolumeQuotaGetDataModelONTAP struct {
+	UUID  string      `mapstructure:"uuid"`
+	Quota VolumeQuota `mapstructure:"quota"`
+}
+
+// VolumeQuota describes the quota data model of a volume.
+type VolumeQuota struct {
+	Enabled bool   `mapstructure:"enabled"`
+	State   string `mapstructure:"state,omitempty"`
+}
+
+// StorageVolumeQuotaUpdateModelONTAP describes the PATCH body to turn quotas on or off on a volume.
+type StorageVolumeQuotaUpdateModelONTAP struct {
+	Quota VolumeQuota `mapstructure:"quota"`
+}
+
 // StorageQuotaRulesDataSourceFilterModel describes the data source data model for queries.
 type StorageQuotaRulesDataSourceFilterModel struct {
 	Type       string `mapstructure:"type"`
@@ -76,7 +102,7 @@ func GetStorageQuotaRules(errorHandler *utils.ErrorHandler, r restclient.RestCli
 	query.Set("type", quotaType)
 	query.Set("qtree.name", qtree)
 	query.Set("svm.name", svmName)
-	query.Fields([]string{"volume", "svm", "type", "qtree", "users", "group", "files", "user_mapping", "uuid"})
+	query.Fields([]string{"volume", "svm", "type", "qtree", "users", "group", "files", "space", "user_mapping", "uuid"})
 	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
 	if err == nil && response == nil {
 		err = fmt.Errorf("no response for GET %s", api)
@@ -98,7 +124,7 @@ func GetStorageQuotaRules(errorHandler *utils.ErrorHandler, r restclient.RestCli
 func GetStorageQuotaRulesByUUID(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) (*StorageQuotaRulesGetDataModelONTAP, error) {
 	api := "storage/quota/rules/" + uuid
 	query := r.NewQuery()
-	query.Fields([]string{"svm.name", "volume.name", "users", "group", "qtree", "type", "files", "uuid"})
+	query.Fields([]string{"svm.name", "volume.name", "users", "group", "qtree", "type", "files", "space", "uuid"})
 	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
 	if err == nil && response == nil {
 		err = fmt.Errorf("no response for GET %s", api)
@@ -120,7 +146,7 @@ func GetStorageQuotaRulesByUUID(errorHandler *utils.ErrorHandler, r restclient.R
 func GetOneORMoreStorageQuotaRules(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter *StorageQuotaRulesDataSourceFilterModel) ([]StorageQuotaRulesGetDataModelONTAP, error) {
 	api := "storage/quota/rules"
 	query := r.NewQuery()
-	query.Fields([]string{"volume", "svm", "type", "qtree", "users", "group", "files", "user_mapping", "uuid"})
+	query.Fields([]string{"volume", "svm", "type", "qtree", "users", "group", "files", "space", "user_mapping", "uuid"})
 	if filter != nil {
 		var filterMap map[string]interface{}
 		if err := mapstructure.Decode(filter, &filterMap); err != nil {
@@ -207,3 +233,42 @@ func UpdateQuotaRules(errorHandler *utils.ErrorHandler, r restclient.RestClient,
 	}
 	return nil
 }
+
+// GetStorageVolumeQuota to get the quota state of a volume
+func GetStorageVolumeQuota(errorHandler *utils.ErrorHandler, r restclient.RestClient, volumeName string, svmName string) (*StorageVolumeQuotaGetDataModelONTAP, error) {
+	api := "storage/volumes"
+	query := r.NewQuery()
+	query.Set("name", volumeName)
+	query.Set("svm.name", svmName)
+	query.Fields([]string{"uuid", "quota.enabled", "quota.state"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("volume %s not found in svm %s", volumeName, svmName)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading volume quota info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP StorageVolumeQuotaGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read volume quota: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// UpdateStorageVolumeQuota to turn quotas on or off on a volume
+func UpdateStorageVolumeQuota(errorHandler *utils.ErrorHandler, r restclient.RestClient, volumeUUID string, enabled bool) error {
+	api := "storage/volumes/" + volumeUUID
+	data := StorageVolumeQuotaUpdateModelONTAP{Quota: VolumeQuota{Enabled: enabled}}
+	var body map[string]interface{}
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return errorHandler.MakeAndReportError("error encoding volume quota body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, data))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating volume quota", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
@@ -181,3 +181,83 @@ func UpdateSnapshotPolicy(errorHandler *utils.ErrorHandler, r restclient.RestCli
 	}
 	return nil
 }
+
+// SnapshotPolicyScheduleGetDataModelONTAP describes the GET record data model for a schedule of a snapshot policy.
+type SnapshotPolicyScheduleGetDataModelONTAP struct {
+	Schedule        NameDataModel `mapstructure:"schedule"`
+	Count           int64         `mapstructure:"count"`
+	Prefix          string        `mapstructure:"prefix,omitempty"`
+	RetentionPeriod string        `mapstructure:"retention_period,omitempty"`
+	SnapmirrorLabel string        `mapstructure:"snapmirror_label,omitempty"`
+}
+
+// SnapshotPolicyScheduleUpdateRequestONTAP describes the PATCH body data model for a schedule of a snapshot policy.
+type SnapshotPolicyScheduleUpdateRequestONTAP struct {
+	Count           int64  `mapstructure:"count"`
+	SnapmirrorLabel string `mapstructure:"snapmirror_label"`
+	RetentionPeriod string `mapstructure:"retention_period,omitempty"`
+}
+
+// GetSnapshotPolicySchedules to get the schedules of a snapshot policy
+func GetSnapshotPolicySchedules(errorHandler *utils.ErrorHandler, r restclient.RestClient, policyUUID string) ([]SnapshotPolicyScheduleGetDataModelONTAP, error) {
+	api := fmt.Sprintf("storage/snapshot-policies/%s/schedules", policyUUID)
+	query := r.NewQuery()
+	query.Fields([]string{"schedule.name", "schedule.uuid", "count", "prefix", "snapmirror_label", "retention_period"})
+	statusCode, response, err := r.GetZeroOrMoreRecords(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading snapshot policy schedules info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP []SnapshotPolicyScheduleGetDataModelONTAP
+	for _, info := range response {
+		var record SnapshotPolicyScheduleGetDataModelONTAP
+		if err := mapstructure.Decode(info, &record); err != nil {
+			return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+				fmt.Sprintf("error: %s, statusCode %d, info %#v", err, statusCode, info))
+		}
+		dataONTAP = append(dataONTAP, record)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read snapshot policy schedules: %#v", dataONTAP))
+	return dataONTAP, nil
+}
+
+// CreateSnapshotPolicySchedule to add a schedule to a snapshot policy
+func CreateSnapshotPolicySchedule(errorHandler *utils.ErrorHandler, r restclient.RestClient, data CopyType, policyUUID string) error {
+	api := fmt.Sprintf("storage/snapshot-policies/%s/schedules", policyUUID)
+	var body map[string]interface{}
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return errorHandler.MakeAndReportError("error encoding snapshot policy schedule body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, data))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error adding snapshot policy schedule", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// UpdateSnapshotPolicySchedule to modify the count, snapmirror label or retention period of a schedule in a snapshot policy
+func UpdateSnapshotPolicySchedule(errorHandler *utils.ErrorHandler, r restclient.RestClient, data SnapshotPolicyScheduleUpdateRequestONTAP, policyUUID string, scheduleUUID string) error {
+	api := fmt.Sprintf("storage/snapshot-policies/%s/schedules/%s", policyUUID, scheduleUUID)
+	var body map[string]interface{}
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return errorHandler.MakeAndReportError("error encoding snapshot policy schedule body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, data))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating snapshot policy schedule", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteSnapshotPolicySchedule to remove a schedule from a snapshot policy
+func DeleteSnapshotPolicySchedule(errorHandler *utils.ErrorHandler, r restclient.RestClient, policyUUID string, scheduleUUID string) error {
+	api := fmt.Sprintf("storage/snapshot-policies/%s/schedules/%s", policyUUID, scheduleUUID)
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error removing snapshot policy schedule", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
@@ -494,3 +494,197 @@ func TestGetSnapshotPolicyByName(t *testing.T) {
 		})
 	}
 }
+
+// schedule of a snapshot policy
+var basicSnapshotPolicyScheduleRecord = SnapshotPolicyScheduleGetDataModelONTAP{
+	Schedule: NameDataModel{
+		Name: "daily",
+		UUID: "1234",
+	},
+	Count:           2,
+	Prefix:          "daily",
+	SnapmirrorLabel: "daily",
+}
+
+func TestGetSnapshotPolicySchedules(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	var recordInterface map[string]any
+	err := mapstructure.Decode(basicSnapshotPolicyScheduleRecord, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var badRecordInterface map[string]any
+	err = mapstructure.Decode(struct{ Count string }{"two"}, &badRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	twoRecords := restclient.RestResponse{NumRecords: 2, Records: []map[string]any{recordInterface, recordInterface}}
+	decodeError := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/snapshot-policies/1234/schedules", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/snapshot-policies/1234/schedules", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_two_records_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/snapshot-policies/1234/schedules", StatusCode: 200, Response: twoRecords, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/snapshot-policies/1234/schedules", StatusCode: 200, Response: noRecords, Err: genericError},
+		},
+		"test_decode_error_1": {
+			{ExpectedMethod: "GET", ExpectedURL: "storage/snapshot-policies/1234/schedules", StatusCode: 200, Response: decodeError, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      []SnapshotPolicyScheduleGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records_1", responses: responses["test_no_records_1"], want: nil, wantErr: false},
+		{name: "test_one_record_1", responses: responses["test_one_record_1"], want: []SnapshotPolicyScheduleGetDataModelONTAP{basicSnapshotPolicyScheduleRecord}, wantErr: false},
+		{name: "test_two_records_1", responses: responses["test_two_records_1"], want: []SnapshotPolicyScheduleGetDataModelONTAP{basicSnapshotPolicyScheduleRecord, basicSnapshotPolicyScheduleRecord}, wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], want: nil, wantErr: true},
+		{name: "test_decode_error_1", responses: responses["test_decode_error_1"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetSnapshotPolicySchedules(errorHandler, *r, "1234")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetSnapshotPolicySchedules() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetSnapshotPolicySchedules() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateSnapshotPolicySchedule(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_create_1": {
+			{ExpectedMethod: "POST", ExpectedURL: "storage/snapshot-policies/1234/schedules", StatusCode: 201, Response: noRecords, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "POST", ExpectedURL: "storage/snapshot-policies/1234/schedules", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_create_1", responses: responses["test_create_1"], wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			body := CopyType{Count: 2, Schedule: Schedule{Name: "daily"}, SnapmirrorLabel: "daily"}
+			err = CreateSnapshotPolicySchedule(errorHandler, *r, body, "1234")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CreateSnapshotPolicySchedule() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestUpdateSnapshotPolicySchedule(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_update_1": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "storage/snapshot-policies/1234/schedules/5678", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "storage/snapshot-policies/1234/schedules/5678", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_update_1", responses: responses["test_update_1"], wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			body := SnapshotPolicyScheduleUpdateRequestONTAP{Count: 5, SnapmirrorLabel: "weekly"}
+			err = UpdateSnapshotPolicySchedule(errorHandler, *r, body, "1234", "5678")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateSnapshotPolicySchedule() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestDeleteSnapshotPolicySchedule(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_delete_1": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "storage/snapshot-policies/1234/schedules/5678", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_error_1": {
+			{ExpectedMethod: "DELETE", ExpectedURL: "storage/snapshot-policies/1234/schedules/5678", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantErr   bool
+	}{
+		{name: "test_delete_1", responses: responses["test_delete_1"], wantErr: false},
+		{name: "test_error_1", responses: responses["test_error_1"], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = DeleteSnapshotPolicySchedule(errorHandler, *r, "1234", "5678")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DeleteSnapshotPolicySchedule() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// StorageVolumeSnapshotRestoreBodyDataModelONTAP describes the PATCH body used to restore a volume, or a single file, to a snapshot.
+type StorageVolumeSnapshotRestoreBodyDataModelONTAP struct {
+	RestoreTo SnapshotRestoreTo `mapstructure:"restore_to"`
+}
+
+// SnapshotRestoreTo describes the restore_to data model.
+type SnapshotRestoreTo struct {
+	Snapshot SnapshotRestoreSnapshot `mapstructure:"snapshot"`
+	File     *SnapshotRestoreFile    `mapstructure:"file,omitempty"`
+}
+
+// SnapshotRestoreSnapshot describes the snapshot to restore from.
+type SnapshotRestoreSnapshot struct {
+	Name string `mapstructure:"name,omitempty"`
+	UUID string `mapstructure:"uuid,omitempty"`
+}
+
+// SnapshotRestoreFile describes the file to restore. When not set, the whole volume is restored.
+type SnapshotRestoreFile struct {
+	Path        string `mapstructure:"path"`
+	RestorePath string `mapstructure:"restore_path,omitempty"`
+}
+
+// RestoreStorageVolumeSnapshot restores a volume, or a single file when data.RestoreTo.File is set, to a snapshot.
+// The PATCH is asynchronous, CallUpdateMethod waits for the job to complete.
+func RestoreStorageVolumeSnapshot(errorHandler *utils.ErrorHandler, r restclient.RestClient, data StorageVolumeSnapshotRestoreBodyDataModelONTAP, volumeUUID string) error {
+	api := "storage/volumes/" + volumeUUID
+	var body map[string]interface{}
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return errorHandler.MakeAndReportError("error encoding snapshot restore body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, data))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error restoring snapshot", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Restored volume %s to snapshot %s", volumeUUID, data.RestoreTo.Snapshot.Name))
+	return nil
+}
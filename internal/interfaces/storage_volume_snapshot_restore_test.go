@@ -0,0 +1,70 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// restore a whole volume
+var volumeStorageVolumeSnapshotRestoreBody = StorageVolumeSnapshotRestoreBodyDataModelONTAP{
+	RestoreTo: SnapshotRestoreTo{
+		Snapshot: SnapshotRestoreSnapshot{Name: "snap1"},
+	},
+}
+
+// restore a single file
+var fileStorageVolumeSnapshotRestoreBody = StorageVolumeSnapshotRestoreBodyDataModelONTAP{
+	RestoreTo: SnapshotRestoreTo{
+		Snapshot: SnapshotRestoreSnapshot{Name: "snap1"},
+		File:     &SnapshotRestoreFile{Path: "/dir1/file1"},
+	},
+}
+
+func TestRestoreStorageVolumeSnapshot(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	genericError := errors.New("generic error for UT")
+	responses := map[string][]restclient.MockResponse{
+		"test_restore_volume": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "storage/volumes/1234", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_restore_file": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "storage/volumes/1234", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_restore_error_1": {
+			{ExpectedMethod: "PATCH", ExpectedURL: "storage/volumes/1234", StatusCode: 400, Response: noRecords, Err: genericError},
+		},
+	}
+	tests := []struct {
+		name        string
+		responses   []restclient.MockResponse
+		requestbody StorageVolumeSnapshotRestoreBodyDataModelONTAP
+		wantErr     bool
+	}{
+		{name: "test_restore_volume", responses: responses["test_restore_volume"], requestbody: volumeStorageVolumeSnapshotRestoreBody, wantErr: false},
+		{name: "test_restore_file", responses: responses["test_restore_file"], requestbody: fileStorageVolumeSnapshotRestoreBody, wantErr: false},
+		{name: "test_restore_error_1", responses: responses["test_restore_error_1"], requestbody: volumeStorageVolumeSnapshotRestoreBody, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = RestoreStorageVolumeSnapshot(errorHandler, *r, tt.requestbody, "1234")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RestoreStorageVolumeSnapshot() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
@@ -57,6 +57,13 @@ type SnapshotPolicy struct {
 	Name string `mapstructure:"name,omitempty"`
 }
 
+// SvmNsswitch describes the name service switch of a svm, the sources are looked up in order.
+type SvmNsswitch struct {
+	Hosts  []string `mapstructure:"hosts,omitempty"`
+	Passwd []string `mapstructure:"passwd,omitempty"`
+	Group  []string `mapstructure:"group,omitempty"`
+}
+
 // SvmDataSourceFilterModel describes the data source data model for queries.
 type SvmDataSourceFilterModel struct {
 	Name string `mapstructure:"name"`
@@ -241,6 +248,44 @@ func UpdateSvm(errorHandler *utils.ErrorHandler, r restclient.RestClient, data S
 	return nil
 }
 
+// GetSvmNsswitch to get the name service switch of a svm by uuid
+func GetSvmNsswitch(errorHandler *utils.ErrorHandler, r restclient.RestClient, uuid string) (*SvmNsswitch, error) {
+	api := "svm/svms/" + uuid
+	query := r.NewQuery()
+	query.Fields([]string{"nsswitch"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading svm nsswitch", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP struct {
+		Nsswitch SvmNsswitch `mapstructure:"nsswitch"`
+	}
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api), fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read svm nsswitch: %#v", dataONTAP.Nsswitch))
+	return &dataONTAP.Nsswitch, nil
+}
+
+// UpdateSvmNsswitch to update the name service switch of a svm, only the databases set in data are changed
+func UpdateSvmNsswitch(errorHandler *utils.ErrorHandler, r restclient.RestClient, data SvmNsswitch, uuid string) error {
+	api := "svm/svms/" + uuid
+	var nsswitch map[string]interface{}
+	if err := mapstructure.Decode(data, &nsswitch); err != nil {
+		return errorHandler.MakeAndReportError("error encoding svm nsswitch", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, data))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Update svm nsswitch: %#v", data))
+	statusCode, _, err := r.CallUpdateMethod(api, nil, map[string]interface{}{"nsswitch": nsswitch})
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating svm nsswitch", fmt.Sprintf("error on PATCH %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
 // ValidateIntORString to validate int or string
 func ValidateIntORString(errorHandler *utils.ErrorHandler, value string, astring string) error {
 	if value == "" || value == astring {
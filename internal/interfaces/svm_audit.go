@@ -0,0 +1,146 @@
+package interfaces
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// SvmAuditGetDataModelONTAP describes the GET record data model using go types for mapping.
+type SvmAuditGetDataModelONTAP struct {
+	SVM     SvmDataModelONTAP       `mapstructure:"svm"`
+	Enabled bool                    `mapstructure:"enabled"`
+	LogPath string                  `mapstructure:"log_path"`
+	Log     SvmAuditLogDataModel    `mapstructure:"log"`
+	Events  SvmAuditEventsDataModel `mapstructure:"events"`
+}
+
+// SvmAuditLogDataModel describes the format, retention and rotation of the audit logs.
+type SvmAuditLogDataModel struct {
+	Format    string                        `mapstructure:"format,omitempty"`
+	Retention SvmAuditLogRetentionDataModel `mapstructure:"retention"`
+	Rotation  SvmAuditLogRotationDataModel  `mapstructure:"rotation"`
+}
+
+// SvmAuditLogRetentionDataModel describes how many rotated logs are kept.
+type SvmAuditLogRetentionDataModel struct {
+	Count int64 `mapstructure:"count,omitempty"`
+}
+
+// SvmAuditLogRotationDataModel describes when the audit logs are rotated.
+type SvmAuditLogRotationDataModel struct {
+	Size     int64                              `mapstructure:"size,omitempty"`
+	Schedule *SvmAuditRotationScheduleDataModel `mapstructure:"schedule,omitempty"`
+}
+
+// SvmAuditRotationScheduleDataModel describes a time based rotation schedule.
+type SvmAuditRotationScheduleDataModel struct {
+	Minutes  []int64 `mapstructure:"minutes,omitempty"`
+	Hours    []int64 `mapstructure:"hours,omitempty"`
+	Weekdays []int64 `mapstructure:"weekdays,omitempty"`
+	Days     []int64 `mapstructure:"days,omitempty"`
+	Months   []int64 `mapstructure:"months,omitempty"`
+}
+
+// SvmAuditEventsDataModel describes the events that are audited.
+type SvmAuditEventsDataModel struct {
+	FileOperations      bool `mapstructure:"file_operations"`
+	CifsLogonLogoff     bool `mapstructure:"cifs_logon_logoff"`
+	AuditPolicyChange   bool `mapstructure:"audit_policy_change"`
+	FileShare           bool `mapstructure:"file_share"`
+	UserAccount         bool `mapstructure:"user_account"`
+	SecurityGroup       bool `mapstructure:"security_group"`
+	AuthorizationPolicy bool `mapstructure:"authorization_policy"`
+	CapStaging          bool `mapstructure:"cap_staging"`
+}
+
+// SvmAuditResourceBodyDataModelONTAP describes the POST body data model using go types for mapping.
+type SvmAuditResourceBodyDataModelONTAP struct {
+	SVM     svm                      `mapstructure:"svm"`
+	Enabled bool                     `mapstructure:"enabled"`
+	LogPath string                   `mapstructure:"log_path"`
+	Log     *SvmAuditLogDataModel    `mapstructure:"log,omitempty"`
+	Events  *SvmAuditEventsDataModel `mapstructure:"events,omitempty"`
+}
+
+// SvmAuditUpdateBodyDataModelONTAP describes the PATCH body data model using go types for mapping.
+type SvmAuditUpdateBodyDataModelONTAP struct {
+	Enabled *bool                    `mapstructure:"enabled,omitempty"`
+	LogPath string                   `mapstructure:"log_path,omitempty"`
+	Log     *SvmAuditLogDataModel    `mapstructure:"log,omitempty"`
+	Events  *SvmAuditEventsDataModel `mapstructure:"events,omitempty"`
+}
+
+// GetSvmAuditBySVMName to get the audit configuration of an SVM
+func GetSvmAuditBySVMName(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmName string) (*SvmAuditGetDataModelONTAP, error) {
+	api := "protocols/audit"
+	query := r.NewQuery()
+	query.Set("svm.name", svmName)
+	query.Fields([]string{"svm.name", "svm.uuid", "enabled", "log_path", "log", "events"})
+	statusCode, response, err := r.GetNilOrOneRecord(api, query, nil)
+	if err == nil && response == nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading svm_audit info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var dataONTAP SvmAuditGetDataModelONTAP
+	if err := mapstructure.Decode(response, &dataONTAP); err != nil {
+		return nil, errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api),
+			fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Read svm_audit: %#v", dataONTAP))
+	return &dataONTAP, nil
+}
+
+// CreateSvmAudit to create the audit configuration of an SVM
+func CreateSvmAudit(errorHandler *utils.ErrorHandler, r restclient.RestClient, body SvmAuditResourceBodyDataModelONTAP) error {
+	api := "protocols/audit"
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding svm_audit body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallCreateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error creating svm_audit", svmAuditErrorDetail("POST", api, err, statusCode))
+	}
+	return nil
+}
+
+// UpdateSvmAudit to update the audit configuration of an SVM
+func UpdateSvmAudit(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, body SvmAuditUpdateBodyDataModelONTAP) error {
+	api := "protocols/audit/" + svmUUID
+	var bodyMap map[string]interface{}
+	if err := mapstructure.Decode(body, &bodyMap); err != nil {
+		return errorHandler.MakeAndReportError("error encoding svm_audit body", fmt.Sprintf("error on encoding %s body: %s, body: %#v", api, err, body))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, bodyMap)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating svm_audit", svmAuditErrorDetail("PATCH", api, err, statusCode))
+	}
+	return nil
+}
+
+// DeleteSvmAudit to delete the audit configuration of an SVM
+func DeleteSvmAudit(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string) error {
+	api := "protocols/audit/" + svmUUID
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting svm_audit", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+	return nil
+}
+
+// svmAuditErrorDetail keeps the ONTAP validation message and points at the usual cause when it is about the log path
+func svmAuditErrorDetail(method string, api string, err error, statusCode int) string {
+	detail := fmt.Sprintf("error on %s %s: %s, statusCode %d", method, api, err, statusCode)
+	if strings.Contains(strings.ToLower(err.Error()), "path") {
+		detail += ". The audit log destination must be an existing directory in the SVM namespace, on a mounted volume, before auditing can be configured"
+	}
+	return detail
+}
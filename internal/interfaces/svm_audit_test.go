@@ -0,0 +1,106 @@
+package interfaces
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/mitchellh/mapstructure"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+var basicSvmAudit = SvmAuditGetDataModelONTAP{
+	SVM:     SvmDataModelONTAP{Name: "svm1", UUID: "1234"},
+	Enabled: true,
+	LogPath: "/audit_log",
+	Log: SvmAuditLogDataModel{
+		Format:    "evtx",
+		Retention: SvmAuditLogRetentionDataModel{Count: 5},
+		Rotation:  SvmAuditLogRotationDataModel{Size: 104857600},
+	},
+	Events: SvmAuditEventsDataModel{FileOperations: true, CifsLogonLogoff: true},
+}
+
+func TestGetSvmAuditBySVMName(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+
+	var recordInterface map[string]any
+	err := mapstructure.Decode(basicSvmAudit, &recordInterface)
+	if err != nil {
+		panic(err)
+	}
+	var badRecordInterface map[string]any
+	err = mapstructure.Decode(map[string]any{"events": map[string]any{"file_operations": "yes"}}, &badRecordInterface)
+	if err != nil {
+		panic(err)
+	}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	oneRecord := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{recordInterface}}
+	decodeError := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{badRecordInterface}}
+	responses := map[string][]restclient.MockResponse{
+		"test_no_records": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/audit", StatusCode: 200, Response: noRecords, Err: nil},
+		},
+		"test_one_record": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/audit", StatusCode: 200, Response: oneRecord, Err: nil},
+		},
+		"test_decode_error": {
+			{ExpectedMethod: "GET", ExpectedURL: "protocols/audit", StatusCode: 200, Response: decodeError, Err: nil},
+		},
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		want      *SvmAuditGetDataModelONTAP
+		wantErr   bool
+	}{
+		{name: "test_no_records", responses: responses["test_no_records"], want: nil, wantErr: false},
+		{name: "test_one_record", responses: responses["test_one_record"], want: &basicSvmAudit, wantErr: false},
+		{name: "test_decode_error", responses: responses["test_decode_error"], want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			got, err := GetSvmAuditBySVMName(errorHandler, *r, "svm1")
+			if err != nil {
+				fmt.Printf("err: %s\n", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetSvmAuditBySVMName() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetSvmAuditBySVMName() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSvmAuditErrorDetail(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantHint bool
+	}{
+		{name: "test_path_error", err: fmt.Errorf("REST reported error: The path \"/audit_log\" does not exist"), wantHint: true},
+		{name: "test_other_error", err: fmt.Errorf("REST reported error: entry already exists"), wantHint: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := svmAuditErrorDetail("POST", "protocols/audit", tt.err, 400)
+			if !strings.Contains(got, tt.err.Error()) {
+				t.Errorf("svmAuditErrorDetail() = %v, does not contain the ONTAP error", got)
+			}
+			if strings.Contains(got, "must be an existing directory") != tt.wantHint {
+				t.Errorf("svmAuditErrorDetail() = %v, wantHint %v", got, tt.wantHint)
+			}
+		})
+	}
+}
@@ -0,0 +1,293 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// interclusterInterfaceRecord returns an ONTAP interface named ic1 on node1
+func interclusterInterfaceRecord(servicePolicy string, address string) map[string]any {
+	return map[string]any{
+		"name":           "ic1",
+		"uuid":           "2c5e6b1a-0d3f-11ef-9a40-005056b3f0a1",
+		"ip":             map[string]any{"address": address, "netmask": "24"},
+		"location":       map[string]any{"home_node": map[string]any{"name": "node1"}, "home_port": map[string]any{"name": "e0c"}},
+		"service_policy": map[string]any{"name": servicePolicy},
+	}
+}
+
+func TestEnsureInterclusterInterfaces(t *testing.T) {
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	existing := func(servicePolicy string, address string) restclient.RestResponse {
+		return restclient.RestResponse{NumRecords: 1, Records: []map[string]any{interclusterInterfaceRecord(servicePolicy, address)}}
+	}
+
+	tests := []struct {
+		name        string
+		responses   []restclient.MockResponse
+		wantCreated bool
+		wantErr     bool
+	}{
+		{
+			name: "test_create_missing_interface",
+			responses: []restclient.MockResponse{
+				{ExpectedMethod: "GET", ExpectedURL: "network/ip/interfaces", StatusCode: 200, Response: noRecords, Err: nil},
+				{ExpectedMethod: "POST", ExpectedURL: "network/ip/interfaces", StatusCode: 201, Response: existing(interclusterServicePolicy, "10.0.0.10"), Err: nil},
+			},
+			wantCreated: true,
+		},
+		{
+			name: "test_reuse_matching_interface",
+			responses: []restclient.MockResponse{
+				{ExpectedMethod: "GET", ExpectedURL: "network/ip/interfaces", StatusCode: 200, Response: existing(interclusterServicePolicy, "10.0.0.10"), Err: nil},
+			},
+			wantCreated: false,
+		},
+		{
+			name: "test_reject_other_service_policy",
+			responses: []restclient.MockResponse{
+				{ExpectedMethod: "GET", ExpectedURL: "network/ip/interfaces", StatusCode: 200, Response: existing("default-data-files", "10.0.0.10"), Err: nil},
+			},
+			wantErr: true,
+		},
+		{
+			name: "test_reject_other_address",
+			responses: []restclient.MockResponse{
+				{ExpectedMethod: "GET", ExpectedURL: "network/ip/interfaces", StatusCode: 200, Response: existing(interclusterServicePolicy, "10.0.0.99"), Err: nil},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+			r, err := restclient.NewRecordingMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			configured := []InterclusterInterfaceResourceModel{{
+				Name:     types.StringValue("ic1"),
+				HomeNode: types.StringValue("node1"),
+				HomePort: types.StringValue("e0c"),
+				Address:  types.StringValue("10.0.0.10"),
+				Netmask:  types.Int64Value(24),
+				Created:  types.BoolUnknown(),
+			}}
+			addresses, err := ensureInterclusterInterfaces(errorHandler, *r, "Default", configured)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ensureInterclusterInterfaces() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(addresses, []string{"10.0.0.10"}) {
+				t.Errorf("ensureInterclusterInterfaces() addresses = %v", addresses)
+			}
+			if configured[0].Created.ValueBool() != tt.wantCreated {
+				t.Errorf("ensureInterclusterInterfaces() created = %v, want %v", configured[0].Created, tt.wantCreated)
+			}
+			if len(r.MockRequests()) != len(tt.responses) {
+				t.Errorf("ensureInterclusterInterfaces() sent %d requests, want %d", len(r.MockRequests()), len(tt.responses))
+			}
+		})
+	}
+}
+
+func TestCheckInterclusterNodes(t *testing.T) {
+	nodes := restclient.RestResponse{NumRecords: 2, Records: []map[string]any{{"name": "node1"}, {"name": "node2"}}}
+	existing := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{interclusterInterfaceRecord(interclusterServicePolicy, "10.0.0.10")}}
+	responses := func() []restclient.MockResponse {
+		return []restclient.MockResponse{
+			{ExpectedMethod: "GET", ExpectedURL: "cluster/nodes", StatusCode: 200, Response: nodes, Err: nil},
+			{ExpectedMethod: "GET", ExpectedURL: "network/ip/interfaces", StatusCode: 200, Response: existing, Err: nil},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		configured []InterclusterInterfaceResourceModel
+		subnet     string
+		wantErr    bool
+	}{
+		{name: "test_missing_node_without_subnet", wantErr: true},
+		{name: "test_missing_node_with_subnet", subnet: "ic_subnet"},
+		{name: "test_missing_node_configured", configured: []InterclusterInterfaceResourceModel{{Name: types.StringValue("ic2"), HomeNode: types.StringValue("node2")}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+			r, err := restclient.NewRecordingMockedRestClient(responses())
+			if err != nil {
+				panic(err)
+			}
+			missing, addresses, err := checkInterclusterNodes(errorHandler, *r, "cluster4", "Default", tt.configured, tt.subnet)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("checkInterclusterNodes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(missing, []string{"node2"}) || !reflect.DeepEqual(addresses, []string{"10.0.0.10"}) {
+				t.Errorf("checkInterclusterNodes() = %v, %v, want [node2], [10.0.0.10]", missing, addresses)
+			}
+		})
+	}
+}
+
+func TestCreateNodeInterclusterInterfaces(t *testing.T) {
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	subnet := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{{"name": "ic_subnet", "broadcast_domain": map[string]any{"name": "Default"}}}}
+	created := func(node string, address string) restclient.RestResponse {
+		return restclient.RestResponse{NumRecords: 1, Records: []map[string]any{{
+			"name":           node + "_intercluster",
+			"ip":             map[string]any{"address": address, "netmask": "24"},
+			"location":       map[string]any{"home_node": map[string]any{"name": node}, "home_port": map[string]any{"name": "e0d"}},
+			"service_policy": map[string]any{"name": interclusterServicePolicy},
+		}}}
+	}
+
+	tests := []struct {
+		name          string
+		responses     []restclient.MockResponse
+		wantCreated   []string
+		wantAddresses []string
+		wantErr       bool
+	}{
+		{
+			name: "test_create_on_each_node",
+			responses: []restclient.MockResponse{
+				{ExpectedMethod: "GET", ExpectedURL: "network/ip/subnets", StatusCode: 200, Response: subnet, Err: nil},
+				{ExpectedMethod: "POST", ExpectedURL: "network/ip/interfaces", StatusCode: 201, Response: created("node1", "10.0.0.21"), Err: nil},
+				{ExpectedMethod: "GET", ExpectedURL: "network/ip/interfaces", StatusCode: 200, Response: created("node1", "10.0.0.21"), Err: nil},
+				{ExpectedMethod: "POST", ExpectedURL: "network/ip/interfaces", StatusCode: 201, Response: created("node2", "10.0.0.22"), Err: nil},
+				{ExpectedMethod: "GET", ExpectedURL: "network/ip/interfaces", StatusCode: 200, Response: created("node2", "10.0.0.22"), Err: nil},
+			},
+			wantCreated:   []string{"node1_intercluster", "node2_intercluster"},
+			wantAddresses: []string{"10.0.0.21", "10.0.0.22"},
+		},
+		{
+			// the interface created on node1 is returned so that it is deleted
+			name: "test_create_error_returns_created",
+			responses: []restclient.MockResponse{
+				{ExpectedMethod: "GET", ExpectedURL: "network/ip/subnets", StatusCode: 200, Response: subnet, Err: nil},
+				{ExpectedMethod: "POST", ExpectedURL: "network/ip/interfaces", StatusCode: 201, Response: created("node1", "10.0.0.21"), Err: nil},
+				{ExpectedMethod: "GET", ExpectedURL: "network/ip/interfaces", StatusCode: 200, Response: created("node1", "10.0.0.21"), Err: nil},
+				{ExpectedMethod: "POST", ExpectedURL: "network/ip/interfaces", StatusCode: 400, Response: noRecords, Err: fmt.Errorf("no address available")},
+			},
+			wantCreated: []string{"node1_intercluster"},
+			wantErr:     true,
+		},
+		{
+			name: "test_subnet_not_found",
+			responses: []restclient.MockResponse{
+				{ExpectedMethod: "GET", ExpectedURL: "network/ip/subnets", StatusCode: 200, Response: noRecords, Err: nil},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+			r, err := restclient.NewRecordingMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			ipInterfaces, addresses, err := createNodeInterclusterInterfaces(errorHandler, *r, "Default", "ic_subnet", []string{"node1", "node2"})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("createNodeInterclusterInterfaces() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var names []string
+			for _, ipInterface := range ipInterfaces {
+				if !ipInterface.Created.ValueBool() {
+					t.Errorf("createNodeInterclusterInterfaces() %s created = false", ipInterface.Name.ValueString())
+				}
+				names = append(names, ipInterface.Name.ValueString())
+			}
+			if !reflect.DeepEqual(names, tt.wantCreated) {
+				t.Errorf("createNodeInterclusterInterfaces() created %v, want %v", names, tt.wantCreated)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(addresses, tt.wantAddresses) {
+				t.Errorf("createNodeInterclusterInterfaces() addresses = %v, want %v", addresses, tt.wantAddresses)
+			}
+			for _, request := range r.MockRequests() {
+				if request.Method == "POST" && request.Body["subnet"].(map[string]interface{})["name"] != "ic_subnet" {
+					t.Errorf("createNodeInterclusterInterfaces() subnet = %v, want ic_subnet", request.Body["subnet"])
+				}
+			}
+		})
+	}
+}
+
+func TestRollbackClusterPeering(t *testing.T) {
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	existing := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{interclusterInterfaceRecord(interclusterServicePolicy, "10.0.0.10")}}
+	deletePeer := restclient.MockResponse{ExpectedMethod: "DELETE", ExpectedURL: "cluster/peers/local-uuid", StatusCode: 200, Response: noRecords, Err: nil}
+	deletePeerFailed := restclient.MockResponse{ExpectedMethod: "DELETE", ExpectedURL: "cluster/peers/local-uuid", StatusCode: 400, Response: noRecords, Err: fmt.Errorf("peer is in use")}
+	getInterface := restclient.MockResponse{ExpectedMethod: "GET", ExpectedURL: "network/ip/interfaces", StatusCode: 200, Response: existing, Err: nil}
+	deleteInterface := restclient.MockResponse{ExpectedMethod: "DELETE", ExpectedURL: "network/ip/interfaces/2c5e6b1a-0d3f-11ef-9a40-005056b3f0a1", StatusCode: 200, Response: noRecords, Err: nil}
+
+	tests := []struct {
+		name          string
+		responses     []restclient.MockResponse
+		peerResponses []restclient.MockResponse
+		wantID        types.String
+		wantErr       bool
+	}{
+		{
+			// the peer cluster failed to accept the cluster peer, only created interfaces are deleted
+			name:          "test_rollback",
+			responses:     []restclient.MockResponse{deletePeer, getInterface, deleteInterface},
+			peerResponses: []restclient.MockResponse{getInterface, deleteInterface},
+			wantID:        types.StringNull(),
+		},
+		{
+			// the cluster peer is kept in data so that it is saved and deleted with the tainted resource
+			name:          "test_rollback_failure_keeps_peer",
+			responses:     []restclient.MockResponse{deletePeerFailed, getInterface, deleteInterface},
+			peerResponses: []restclient.MockResponse{getInterface, deleteInterface},
+			wantID:        types.StringValue("local-uuid"),
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := diag.Diagnostics{}
+			errorHandler := utils.NewErrorHandler(context.Background(), &diags)
+			r, err := restclient.NewRecordingMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			peer, err := restclient.NewRecordingMockedRestClient(tt.peerResponses)
+			if err != nil {
+				panic(err)
+			}
+			data := &ClusterPeeringResourceModel{ID: types.StringValue("local-uuid"), PeerID: types.StringUnknown()}
+			localInterfaces := []InterclusterInterfaceResourceModel{{Name: types.StringValue("ic1"), Created: types.BoolValue(true)}}
+			peerInterfaces := []InterclusterInterfaceResourceModel{
+				{Name: types.StringValue("ic1"), Created: types.BoolValue(false)},
+				{Name: types.StringValue("ic2"), Created: types.BoolValue(true)},
+			}
+			err = rollbackClusterPeering(errorHandler, *r, *peer, data, localInterfaces, peerInterfaces)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("rollbackClusterPeering() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(r.MockRequests()) != len(tt.responses) || len(peer.MockRequests()) != len(tt.peerResponses) {
+				t.Errorf("rollbackClusterPeering() sent %d and %d requests, want %d and %d", len(r.MockRequests()), len(peer.MockRequests()), len(tt.responses), len(tt.peerResponses))
+			}
+			if !data.ID.Equal(tt.wantID) {
+				t.Errorf("rollbackClusterPeering() ID = %v, want %v", data.ID, tt.wantID)
+			}
+			// the create error is the only one reported
+			if diags.HasError() {
+				t.Errorf("rollbackClusterPeering() reported %v", diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,722 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ClusterPeeringResource{}
+
+const (
+	clusterPeeringTimeout      = 120 * time.Second
+	clusterPeeringPollInterval = 5 * time.Second
+	interclusterServicePolicy  = "default-intercluster"
+)
+
+// NewClusterPeeringResource is a helper function to simplify the provider implementation.
+func NewClusterPeeringResource() resource.Resource {
+	return &ClusterPeeringResource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "cluster_peering",
+		},
+	}
+}
+
+// ClusterPeeringResource defines the resource implementation.
+type ClusterPeeringResource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// ClusterPeeringResourceModel describes the resource data model.
+type ClusterPeeringResourceModel struct {
+	CxProfileName              types.String   `tfsdk:"cx_profile_name"`
+	PeerCxProfileName          types.String   `tfsdk:"peer_cx_profile_name"`
+	Ipspace                    types.String   `tfsdk:"ipspace"`
+	PeerIpspace                types.String   `tfsdk:"peer_ipspace"`
+	InterclusterInterfaces     types.List     `tfsdk:"intercluster_interfaces"`
+	PeerInterclusterInterfaces types.List     `tfsdk:"peer_intercluster_interfaces"`
+	InterclusterSubnet         types.String   `tfsdk:"intercluster_subnet"`
+	PeerInterclusterSubnet     types.String   `tfsdk:"peer_intercluster_subnet"`
+	PeerApplications           []types.String `tfsdk:"peer_applications"`
+	Name                       types.String   `tfsdk:"name"`
+	State                      types.String   `tfsdk:"state"`
+	PeerID                     types.String   `tfsdk:"peer_id"`
+	ID                         types.String   `tfsdk:"id"`
+}
+
+// InterclusterInterfaceResourceModel describes an intercluster interface of one of the clusters.
+type InterclusterInterfaceResourceModel struct {
+	Name     types.String `tfsdk:"name"`
+	HomeNode types.String `tfsdk:"home_node"`
+	HomePort types.String `tfsdk:"home_port"`
+	Address  types.String `tfsdk:"address"`
+	Netmask  types.Int64  `tfsdk:"netmask"`
+	Created  types.Bool   `tfsdk:"created"`
+}
+
+// interclusterInterfaceType is the object type of an intercluster interface in the state
+var interclusterInterfaceType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"name":      types.StringType,
+	"home_node": types.StringType,
+	"home_port": types.StringType,
+	"address":   types.StringType,
+	"netmask":   types.Int64Type,
+	"created":   types.BoolType,
+}}
+
+// Metadata returns the resource type name.
+func (r *ClusterPeeringResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.Name
+}
+
+// interclusterInterfacesSchema describes the intercluster interfaces of one of the clusters, they conflict with subnetAttribute
+func interclusterInterfacesSchema(description string, subnetAttribute string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+			listvalidator.ConflictsWith(path.MatchRoot(subnetAttribute)),
+		},
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.UseStateForUnknown(),
+			listplanmodifier.RequiresReplace(),
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					MarkdownDescription: "Interface name. An existing interface with this name is used as is when it has the default-intercluster service policy and the same address, otherwise creating the resource fails",
+					Required:            true,
+				},
+				"home_node": schema.StringAttribute{
+					MarkdownDescription: "Home node of the interface",
+					Required:            true,
+				},
+				"home_port": schema.StringAttribute{
+					MarkdownDescription: "Home port of the interface",
+					Required:            true,
+				},
+				"address": schema.StringAttribute{
+					MarkdownDescription: "IP address of the interface",
+					Required:            true,
+				},
+				"netmask": schema.Int64Attribute{
+					MarkdownDescription: "Netmask length of the interface",
+					Required:            true,
+					Validators: []validator.Int64{
+						int64validator.Between(1, 128),
+					},
+				},
+				"created": schema.BoolAttribute{
+					MarkdownDescription: "Whether the interface was created by this resource. Only created interfaces are deleted with the resource",
+					Computed:            true,
+					PlanModifiers: []planmodifier.Bool{
+						boolplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+		},
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *ClusterPeeringResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ClusterPeering resource",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name of the local cluster",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"peer_cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name of the peer cluster",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ipspace": schema.StringAttribute{
+				MarkdownDescription: "IPspace of the intercluster interfaces and the cluster peer on the local cluster",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("Default"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"peer_ipspace": schema.StringAttribute{
+				MarkdownDescription: "IPspace of the intercluster interfaces and the cluster peer on the peer cluster",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("Default"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"intercluster_interfaces": interclusterInterfacesSchema("Intercluster interfaces of the local cluster. Every node needs an intercluster interface, either listed here or already existing. "+
+				"When not set, the interfaces created on the nodes from intercluster_subnet", "intercluster_subnet"),
+			"peer_intercluster_interfaces": interclusterInterfacesSchema("Intercluster interfaces of the peer cluster. Every node needs an intercluster interface, either listed here or already existing. "+
+				"When not set, the interfaces created on the nodes from peer_intercluster_subnet", "peer_intercluster_subnet"),
+			"intercluster_subnet": schema.StringAttribute{
+				MarkdownDescription: "Subnet of the local cluster ipspace. When intercluster_interfaces is not set, an intercluster interface is created with an address from this subnet on each node that has none",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"peer_intercluster_subnet": schema.StringAttribute{
+				MarkdownDescription: "Subnet of the peer cluster ipspace. When peer_intercluster_interfaces is not set, an intercluster interface is created with an address from this subnet on each node that has none",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"peer_applications": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Peering applications, for instance snapmirror or flexcache",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the peer cluster",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "Availability of the peer cluster, available once the clusters can communicate",
+				Computed:            true,
+			},
+			"peer_id": schema.StringAttribute{
+				MarkdownDescription: "Cluster peer UUID on the peer cluster",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Cluster peer UUID on the local cluster",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ClusterPeeringResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ClusterPeeringResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ClusterPeeringResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if data.ID.IsNull() {
+		// a failed create saved the interfaces without a cluster peer, keep them for Delete
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+	restInfo, err := interfaces.GetClusterPeer(errorHandler, *client, data.ID.ValueString())
+	if err != nil {
+		// error reporting done inside GetClusterPeer
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", "No Cluster Peer found")
+		return
+	}
+	data.Name = types.StringValue(restInfo.Name)
+	data.State = types.StringValue(restInfo.Status.State)
+
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create creates the intercluster interfaces on both clusters, peers the clusters and waits for the peer to be available
+func (r *ClusterPeeringResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ClusterPeeringResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+	peerClient, err := connection.GetRestClient(errorHandler, r.config, data.PeerCxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var configured, peerConfigured []InterclusterInterfaceResourceModel
+	if !data.InterclusterInterfaces.IsUnknown() {
+		resp.Diagnostics.Append(data.InterclusterInterfaces.ElementsAs(ctx, &configured, false)...)
+	}
+	if !data.PeerInterclusterInterfaces.IsUnknown() {
+		resp.Diagnostics.Append(data.PeerInterclusterInterfaces.ElementsAs(ctx, &peerConfigured, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// check both clusters before creating anything
+	missing, existingAddresses, err := checkInterclusterNodes(errorHandler, *client, data.CxProfileName.ValueString(), data.Ipspace.ValueString(), configured, data.InterclusterSubnet.ValueString())
+	if err != nil {
+		return
+	}
+	peerMissing, peerExistingAddresses, err := checkInterclusterNodes(errorHandler, *peerClient, data.PeerCxProfileName.ValueString(), data.PeerIpspace.ValueString(), peerConfigured, data.PeerInterclusterSubnet.ValueString())
+	if err != nil {
+		return
+	}
+
+	// from here on, an error deletes what was created. What cannot be deleted is saved so that Terraform taints the resource and Delete removes it
+	var localInterfaces, peerInterfaces []InterclusterInterfaceResourceModel
+	abort := func() {
+		if err := rollbackClusterPeering(errorHandler, *client, *peerClient, data, localInterfaces, peerInterfaces); err != nil {
+			savePartialClusterPeering(ctx, resp, data, localInterfaces, peerInterfaces)
+		}
+	}
+	localInterfaces, addresses, err := setupInterclusterInterfaces(errorHandler, *client, data.Ipspace.ValueString(), data.InterclusterSubnet.ValueString(), configured, missing, existingAddresses)
+	if err != nil {
+		abort()
+		return
+	}
+	peerInterfaces, peerAddresses, err := setupInterclusterInterfaces(errorHandler, *peerClient, data.PeerIpspace.ValueString(), data.PeerInterclusterSubnet.ValueString(), peerConfigured, peerMissing, peerExistingAddresses)
+	if err != nil {
+		abort()
+		return
+	}
+
+	var applications []string
+	for _, application := range data.PeerApplications {
+		applications = append(applications, application.ValueString())
+	}
+	var body interfaces.ClusterPeersResourceBodyDataModelONTAP
+	body.Remote.IPAddress = peerAddresses
+	body.PeerApplications = applications
+	body.Authentication.GeneratePassphrase = true
+	body.Ipspace = &interfaces.ClusterPeerIpspace{Name: data.Ipspace.ValueString()}
+	resource, err := interfaces.CreateClusterPeers(errorHandler, *client, body)
+	if err != nil {
+		abort()
+		return
+	}
+	data.ID = types.StringValue(resource.UUID)
+
+	var bodyPeer interfaces.ClusterPeersResourceBodyDataModelONTAP
+	bodyPeer.Remote.IPAddress = addresses
+	bodyPeer.PeerApplications = applications
+	bodyPeer.Authentication.Passphrase = resource.Authentication.Passphrase
+	bodyPeer.Ipspace = &interfaces.ClusterPeerIpspace{Name: data.PeerIpspace.ValueString()}
+	resourcePeer, err := interfaces.CreateClusterPeers(errorHandler, *peerClient, bodyPeer)
+	if err != nil {
+		abort()
+		return
+	}
+	data.PeerID = types.StringValue(resourcePeer.UUID)
+
+	restInfo, err := waitForClusterPeerAvailable(errorHandler, *client, data.ID.ValueString())
+	if err != nil {
+		abort()
+		return
+	}
+	data.Name = types.StringValue(restInfo.Name)
+	data.State = types.StringValue(restInfo.Status.State)
+	resp.Diagnostics.Append(setInterclusterInterfaces(ctx, data, localInterfaces, peerInterfaces)...)
+
+	tflog.Trace(ctx, fmt.Sprintf("created a resource, UUID=%s", data.ID))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only saves the plan, every configurable attribute requires replacing the resource.
+func (r *ClusterPeeringResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *ClusterPeeringResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the cluster peer on both clusters, then the intercluster interfaces created by the resource.
+func (r *ClusterPeeringResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ClusterPeeringResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+	peerClient, err := connection.GetRestClient(errorHandler, r.config, data.PeerCxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	// the ID is null when a failed create only saved the interfaces
+	if !data.ID.IsNull() {
+		if err := interfaces.DeleteClusterPeers(errorHandler, *client, data.ID.ValueString()); err != nil {
+			return
+		}
+	}
+	if !data.PeerID.IsNull() {
+		if err := interfaces.DeleteClusterPeers(errorHandler, *peerClient, data.PeerID.ValueString()); err != nil {
+			return
+		}
+	}
+
+	var localInterfaces, peerInterfaces []InterclusterInterfaceResourceModel
+	resp.Diagnostics.Append(data.InterclusterInterfaces.ElementsAs(ctx, &localInterfaces, false)...)
+	resp.Diagnostics.Append(data.PeerInterclusterInterfaces.ElementsAs(ctx, &peerInterfaces, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := deleteInterclusterInterfaces(errorHandler, *client, localInterfaces); err != nil {
+		return
+	}
+	if err := deleteInterclusterInterfaces(errorHandler, *peerClient, peerInterfaces); err != nil {
+		return
+	}
+}
+
+// setInterclusterInterfaces sets the interfaces of both clusters in data.
+// A cluster without interfaces gets an empty list rather than null, so that the unset attribute keeps its state and does not replace the resource.
+func setInterclusterInterfaces(ctx context.Context, data *ClusterPeeringResourceModel, localInterfaces []InterclusterInterfaceResourceModel, peerInterfaces []InterclusterInterfaceResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, cluster := range []struct {
+		value      *types.List
+		interfaces []InterclusterInterfaceResourceModel
+	}{{&data.InterclusterInterfaces, localInterfaces}, {&data.PeerInterclusterInterfaces, peerInterfaces}} {
+		if cluster.interfaces == nil {
+			cluster.interfaces = []InterclusterInterfaceResourceModel{}
+		}
+		for index := range cluster.interfaces {
+			if cluster.interfaces[index].Created.IsUnknown() {
+				cluster.interfaces[index].Created = types.BoolValue(false)
+			}
+		}
+		value, valueDiags := types.ListValueFrom(ctx, interclusterInterfaceType, cluster.interfaces)
+		diags.Append(valueDiags...)
+		*cluster.value = value
+	}
+	return diags
+}
+
+// savePartialClusterPeering saves the interfaces and cluster peers left after a failed create, the unknown computed values are set to null or false
+func savePartialClusterPeering(ctx context.Context, resp *resource.CreateResponse, data *ClusterPeeringResourceModel, localInterfaces []InterclusterInterfaceResourceModel, peerInterfaces []InterclusterInterfaceResourceModel) {
+	resp.Diagnostics.Append(setInterclusterInterfaces(ctx, data, localInterfaces, peerInterfaces)...)
+	for _, value := range []*types.String{&data.Name, &data.State, &data.PeerID, &data.ID} {
+		if value.IsUnknown() {
+			*value = types.StringNull()
+		}
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// rollbackClusterPeering deletes the cluster peers and the interfaces created before a failed create.
+// The original error is already reported, a rollback failure is only logged and returned, and data keeps the cluster peers that are left.
+func rollbackClusterPeering(errorHandler *utils.ErrorHandler, client restclient.RestClient, peerClient restclient.RestClient, data *ClusterPeeringResourceModel, localInterfaces []InterclusterInterfaceResourceModel, peerInterfaces []InterclusterInterfaceResourceModel) error {
+	bestEffort := utils.NewErrorHandler(errorHandler.Ctx, &diag.Diagnostics{})
+	var failed error
+	for _, peer := range []struct {
+		client restclient.RestClient
+		id     *types.String
+	}{{client, &data.ID}, {peerClient, &data.PeerID}} {
+		if peer.id.IsNull() || peer.id.IsUnknown() {
+			continue
+		}
+		if err := interfaces.DeleteClusterPeers(bestEffort, peer.client, peer.id.ValueString()); err != nil {
+			failed = err
+			continue
+		}
+		*peer.id = types.StringNull()
+	}
+	if err := deleteInterclusterInterfaces(bestEffort, client, localInterfaces); err != nil {
+		failed = err
+	}
+	if err := deleteInterclusterInterfaces(bestEffort, peerClient, peerInterfaces); err != nil {
+		failed = err
+	}
+	if failed != nil {
+		tflog.Warn(errorHandler.Ctx, fmt.Sprintf("failed to delete what was created for cluster_peering, saving it in the state: %s", failed))
+	}
+	return failed
+}
+
+// checkInterclusterNodes returns the nodes without an intercluster interface in ipspace, and the addresses of the existing intercluster interfaces.
+// It reports an error when one of these nodes has no configured interface and no subnet is set to create one.
+func checkInterclusterNodes(errorHandler *utils.ErrorHandler, client restclient.RestClient, cxProfileName string, ipspace string, configured []InterclusterInterfaceResourceModel, subnet string) ([]string, []string, error) {
+	nodes, err := interfaces.GetClusterNodes(errorHandler, client)
+	if err != nil {
+		return nil, nil, err
+	}
+	existing, err := interfaces.GetListIPInterfaces(errorHandler, client, &interfaces.IPInterfaceDataSourceFilterModel{Scope: "cluster", IPspace: ipspace}, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	covered := make(map[string]bool)
+	var addresses []string
+	for _, ipInterface := range existing {
+		if ipInterface.ServicePolicy.Name == interclusterServicePolicy {
+			covered[ipInterface.Location.HomeNode.Name] = true
+			addresses = append(addresses, ipInterface.IP.Address)
+		}
+	}
+	var missing []string
+	for _, node := range nodes {
+		if !covered[node.Name] {
+			missing = append(missing, node.Name)
+		}
+	}
+	for _, ipInterface := range configured {
+		covered[ipInterface.HomeNode.ValueString()] = true
+	}
+	for _, node := range missing {
+		if !covered[node] && subnet == "" {
+			return nil, nil, errorHandler.MakeAndReportError("missing intercluster interface",
+				fmt.Sprintf("node %s of connection profile %s has no intercluster interface in ipspace %s, add one for it or set the subnet to create one", node, cxProfileName, ipspace))
+		}
+	}
+	return missing, addresses, nil
+}
+
+// setupInterclusterInterfaces ensures the configured interfaces exist, or creates an interface with an address from subnet on each node in missing when none is configured.
+// It returns the interfaces to save, including the ones created before an error, and the addresses to peer with.
+func setupInterclusterInterfaces(errorHandler *utils.ErrorHandler, client restclient.RestClient, ipspace string, subnet string, configured []InterclusterInterfaceResourceModel, missing []string, existingAddresses []string) ([]InterclusterInterfaceResourceModel, []string, error) {
+	if len(configured) > 0 {
+		addresses, err := ensureInterclusterInterfaces(errorHandler, client, ipspace, configured)
+		return configured, addresses, err
+	}
+	created, addresses, err := createNodeInterclusterInterfaces(errorHandler, client, ipspace, subnet, missing)
+	return created, append(existingAddresses, addresses...), err
+}
+
+// ensureInterclusterInterfaces creates the interfaces that do not exist yet and returns the addresses of all of them
+func ensureInterclusterInterfaces(errorHandler *utils.ErrorHandler, client restclient.RestClient, ipspace string, configured []InterclusterInterfaceResourceModel) ([]string, error) {
+	var addresses []string
+	for index, ipInterface := range configured {
+		restInfo, err := interfaces.GetListIPInterfaces(errorHandler, client, &interfaces.IPInterfaceDataSourceFilterModel{Name: ipInterface.Name.ValueString(), Scope: "cluster", IPspace: ipspace}, nil)
+		if err != nil {
+			return nil, err
+		}
+		if len(restInfo) > 0 {
+			if restInfo[0].ServicePolicy.Name != interclusterServicePolicy || !net.ParseIP(restInfo[0].IP.Address).Equal(net.ParseIP(ipInterface.Address.ValueString())) {
+				return nil, errorHandler.MakeAndReportError("existing interface does not match",
+					fmt.Sprintf("interface %s already exists with service policy %q and address %s, expected service policy %q and address %s",
+						restInfo[0].Name, restInfo[0].ServicePolicy.Name, restInfo[0].IP.Address, interclusterServicePolicy, ipInterface.Address.ValueString()))
+			}
+			tflog.Debug(errorHandler.Ctx, fmt.Sprintf("intercluster interface %s already exists, keeping it", restInfo[0].Name))
+			configured[index].Created = types.BoolValue(false)
+			addresses = append(addresses, restInfo[0].IP.Address)
+			continue
+		}
+		body := interfaces.IPInterfaceResourceBodyDataModelONTAP{
+			Name:    ipInterface.Name.ValueString(),
+			Scope:   "cluster",
+			IPspace: &interfaces.IPInterfaceIPspace{Name: ipspace},
+			IP: &interfaces.IPInterfaceResourceIP{
+				Address: ipInterface.Address.ValueString(),
+				Netmask: ipInterface.Netmask.ValueInt64(),
+			},
+			Location: &interfaces.IPInterfaceResourceBodyLocation{
+				HomeNode: &interfaces.IPInterfaceResourceHomeNode{Name: ipInterface.HomeNode.ValueString()},
+				HomePort: &interfaces.IPInterfaceResourceHomePort{
+					Name: ipInterface.HomePort.ValueString(),
+					Node: interfaces.IPInterfaceResourceHomeNode{Name: ipInterface.HomeNode.ValueString()},
+				},
+			},
+			ServicePolicy: &interfaces.IPInterfaceServicePolicy{Name: interclusterServicePolicy},
+		}
+		if _, err := interfaces.CreateIPInterface(errorHandler, client, body); err != nil {
+			return nil, err
+		}
+		configured[index].Created = types.BoolValue(true)
+		addresses = append(addresses, ipInterface.Address.ValueString())
+	}
+	return addresses, nil
+}
+
+// createNodeInterclusterInterfaces creates an interface named <node>_intercluster on each node, with an address from subnet and a home port in the subnet broadcast domain.
+// It returns the created interfaces, including the ones created before an error, and their addresses.
+func createNodeInterclusterInterfaces(errorHandler *utils.ErrorHandler, client restclient.RestClient, ipspace string, subnet string, nodes []string) ([]InterclusterInterfaceResourceModel, []string, error) {
+	if len(nodes) == 0 {
+		return nil, nil, nil
+	}
+	subnetInfo, err := interfaces.GetNetworkIPSubnetByName(errorHandler, client, subnet, ipspace)
+	if err != nil {
+		return nil, nil, err
+	}
+	if subnetInfo == nil {
+		return nil, nil, errorHandler.MakeAndReportError("error creating intercluster interfaces", fmt.Sprintf("subnet %s not found in ipspace %s", subnet, ipspace))
+	}
+	var created []InterclusterInterfaceResourceModel
+	var addresses []string
+	for _, node := range nodes {
+		name := node + "_intercluster"
+		body := interfaces.IPInterfaceResourceBodyDataModelONTAP{
+			Name:    name,
+			Scope:   "cluster",
+			IPspace: &interfaces.IPInterfaceIPspace{Name: ipspace},
+			Subnet:  &interfaces.IPInterfaceSubnet{Name: subnet},
+			Location: &interfaces.IPInterfaceResourceBodyLocation{
+				HomeNode:        &interfaces.IPInterfaceResourceHomeNode{Name: node},
+				BroadcastDomain: &interfaces.IPInterfaceBroadcastDomain{Name: subnetInfo.BroadcastDomain.Name},
+			},
+			ServicePolicy: &interfaces.IPInterfaceServicePolicy{Name: interclusterServicePolicy},
+		}
+		if _, err := interfaces.CreateIPInterface(errorHandler, client, body); err != nil {
+			return created, nil, err
+		}
+		// the address and home port are chosen by ONTAP
+		ipInterface := InterclusterInterfaceResourceModel{
+			Name:     types.StringValue(name),
+			HomeNode: types.StringValue(node),
+			HomePort: types.StringNull(),
+			Address:  types.StringNull(),
+			Netmask:  types.Int64Null(),
+			Created:  types.BoolValue(true),
+		}
+		created = append(created, ipInterface)
+		restInfo, err := interfaces.GetListIPInterfaces(errorHandler, client, &interfaces.IPInterfaceDataSourceFilterModel{Name: name, Scope: "cluster", IPspace: ipspace}, nil)
+		if err != nil {
+			return created, nil, err
+		}
+		if len(restInfo) == 0 {
+			return created, nil, errorHandler.MakeAndReportError("error creating intercluster interfaces", fmt.Sprintf("interface %s not found after it was created", name))
+		}
+		ipInterface.HomePort = types.StringValue(restInfo[0].Location.HomePort.Name)
+		ipInterface.Address = types.StringValue(restInfo[0].IP.Address)
+		if netmask, err := strconv.ParseInt(restInfo[0].IP.Netmask, 10, 64); err == nil {
+			ipInterface.Netmask = types.Int64Value(netmask)
+		}
+		created[len(created)-1] = ipInterface
+		addresses = append(addresses, restInfo[0].IP.Address)
+	}
+	return created, addresses, nil
+}
+
+// deleteInterclusterInterfaces deletes the interfaces created by the resource
+func deleteInterclusterInterfaces(errorHandler *utils.ErrorHandler, client restclient.RestClient, configured []InterclusterInterfaceResourceModel) error {
+	for _, ipInterface := range configured {
+		if !ipInterface.Created.ValueBool() {
+			continue
+		}
+		restInfo, err := interfaces.GetListIPInterfaces(errorHandler, client, &interfaces.IPInterfaceDataSourceFilterModel{Name: ipInterface.Name.ValueString(), Scope: "cluster"}, nil)
+		if err != nil {
+			return err
+		}
+		for _, record := range restInfo {
+			if err := interfaces.DeleteIPInterface(errorHandler, client, record.UUID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// waitForClusterPeerAvailable waits until the clusters can communicate
+func waitForClusterPeerAvailable(errorHandler *utils.ErrorHandler, client restclient.RestClient, uuid string) (*interfaces.ClusterPeerGetDataModelONTAP, error) {
+	var state string
+	for timeRemaining := clusterPeeringTimeout; timeRemaining > 0; timeRemaining -= clusterPeeringPollInterval {
+		restInfo, err := interfaces.GetClusterPeer(errorHandler, client, uuid)
+		if err != nil {
+			return nil, err
+		}
+		if restInfo != nil {
+			if restInfo.Status.State == "available" {
+				return restInfo, nil
+			}
+			state = restInfo.Status.State
+		}
+		time.Sleep(clusterPeeringPollInterval)
+	}
+	return nil, errorHandler.MakeAndReportError("error creating cluster_peering",
+		fmt.Sprintf("cluster peer %s is not available after %s, state is %q", uuid, clusterPeeringTimeout, state))
+}
@@ -0,0 +1,86 @@
+package cluster_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	ntest "github.com/netapp/terraform-provider-netapp-ontap/internal/provider"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccClusterPeeringResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { ntest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ntest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create the interfaces and the peer, and wait until it is available
+			{
+				Config: testAccClusterPeeringResourceConfig("acc_test_cluster2-01"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_cluster_peering.example", "name", "acc_test_cluster2"),
+					resource.TestCheckResourceAttr("netapp-ontap_cluster_peering.example", "state", "available"),
+					resource.TestCheckResourceAttr("netapp-ontap_cluster_peering.example", "intercluster_interfaces.0.created", "true"),
+					resource.TestCheckResourceAttrSet("netapp-ontap_cluster_peering.example", "peer_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccClusterPeeringResourceConfig(peerNode string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	host2 := os.Getenv("TF_ACC_NETAPP_HOST2")
+	password2 := os.Getenv("TF_ACC_NETAPP_PASS2")
+	if host == "" || host2 == "" || admin == "" || password == "" || password2 == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_HOST2, TF_ACC_NETAPP_USER, TF_ACC_NETAPP_PASS and TF_ACC_NETAPP_PASS2 must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+    {
+      name = "cluster3"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_cluster_peering" "example" {
+  cx_profile_name = "cluster4"
+  peer_cx_profile_name = "cluster3"
+  intercluster_interfaces = [
+    {
+      name = "acc_ic_1"
+      home_node = "ontap_cluster_1-01"
+      home_port = "e0c"
+      address = "10.193.180.120"
+      netmask = 20
+    },
+  ]
+  peer_intercluster_interfaces = [
+    {
+      name = "acc_ic_2"
+      home_node = "%s"
+      home_port = "e0c"
+      address = "10.193.176.190"
+      netmask = 20
+    },
+  ]
+  peer_applications = ["snapmirror"]
+}
+`, host, admin, password, host2, admin, password2, peerNode)
+}
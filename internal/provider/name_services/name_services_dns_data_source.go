@@ -113,7 +113,7 @@ func (d *NameServicesDNSDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
-	restInfo, err := interfaces.GetNameServicesDNS(errorHandler, *client, data.SVMName.ValueString())
+	restInfo, err := interfaces.GetNameServicesDNS(errorHandler, *client, data.SVMName.ValueString(), nil)
 	if err != nil {
 		// error reporting done inside GetNameServicesDNS
 		return
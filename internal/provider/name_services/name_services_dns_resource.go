@@ -7,15 +7,20 @@ import (
 
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
 )
 
@@ -39,12 +44,29 @@ type NameServicesDNSResource struct {
 
 // NameServicesDNSResourceModel describes the resource data model.
 type NameServicesDNSResourceModel struct {
-	CxProfileName        types.String   `tfsdk:"cx_profile_name"`
-	SVMName              types.String   `tfsdk:"svm_name"`
-	ID                   types.String   `tfsdk:"id"`
-	SkipConfigValidation types.Bool     `tfsdk:"skip_config_validation"`
-	Domains              []types.String `tfsdk:"dns_domains"`
-	NameServers          []types.String `tfsdk:"name_servers"`
+	CxProfileName        types.String     `tfsdk:"cx_profile_name"`
+	SVMName              types.String     `tfsdk:"svm_name"`
+	ID                   types.String     `tfsdk:"id"`
+	SkipConfigValidation types.Bool       `tfsdk:"skip_config_validation"`
+	Domains              []types.String   `tfsdk:"dns_domains"`
+	NameServers          []types.String   `tfsdk:"name_servers"`
+	DynamicDNS           *DynamicDNSModel `tfsdk:"dynamic_dns"`
+	Nsswitch             *NsswitchModel   `tfsdk:"nsswitch"`
+}
+
+// DynamicDNSModel describes the DNS dynamic update settings.
+type DynamicDNSModel struct {
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	UseSecure  types.Bool   `tfsdk:"use_secure"`
+	Fqdn       types.String `tfsdk:"fqdn"`
+	TimeToLive types.String `tfsdk:"time_to_live"`
+}
+
+// NsswitchModel describes the name service switch of the svm.
+type NsswitchModel struct {
+	Hosts  []types.String `tfsdk:"hosts"`
+	Passwd []types.String `tfsdk:"passwd"`
+	Group  []types.String `tfsdk:"group"`
 }
 
 // Metadata returns the resource type name.
@@ -64,8 +86,11 @@ func (r *NameServicesDNSResource) Schema(ctx context.Context, req resource.Schem
 				Required:            true,
 			},
 			"svm_name": schema.StringAttribute{
-				MarkdownDescription: "IPInterface svm name",
+				MarkdownDescription: "IPInterface svm name. Changing it replaces the DNS configuration",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"id": schema.StringAttribute{
 				MarkdownDescription: "UUID of svm",
@@ -90,11 +115,63 @@ func (r *NameServicesDNSResource) Schema(ctx context.Context, req resource.Schem
 					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"dynamic_dns": schema.SingleNestedAttribute{
+				MarkdownDescription: "DNS dynamic update settings of the svm. When not set, they are not managed (9.9)",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether the svm registers its data interfaces addresses with the name servers",
+						Required:            true,
+					},
+					"use_secure": schema.BoolAttribute{
+						MarkdownDescription: "Whether the updates are secured with Kerberos, which requires the svm to be joined to Active Directory",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"fqdn": schema.StringAttribute{
+						MarkdownDescription: "Fully qualified domain name used for the updates, defaults to the svm name in the first domain",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"time_to_live": schema.StringAttribute{
+						MarkdownDescription: "Time to live of the registered records, as an ISO-8601 duration such as 'PT1H'",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"nsswitch": schema.SingleNestedAttribute{
+				MarkdownDescription: "Name service switch of the svm, the sources of each database are looked up in order. Only the databases that are set are managed",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"hosts":  nsswitchDatabaseAttribute("hosts"),
+					"passwd": nsswitchDatabaseAttribute("passwd"),
+					"group":  nsswitchDatabaseAttribute("group"),
+				},
+			},
 		},
 	}
 }
 
-// create a function that add 2 numbers togehter
+func nsswitchDatabaseAttribute(database string) schema.ListAttribute {
+	return schema.ListAttribute{
+		ElementType:         types.StringType,
+		MarkdownDescription: fmt.Sprintf("Ordered sources of the %s database, from `files`, `dns`, `nis` and `ldap`", database),
+		Optional:            true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+			listvalidator.UniqueValues(),
+			listvalidator.ValueStringsAre(stringvalidator.OneOf("files", "dns", "nis", "ldap")),
+		},
+	}
+}
 
 // Configure adds the provider configured client to the resource.
 func (r *NameServicesDNSResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -131,13 +208,8 @@ func (r *NameServicesDNSResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	restInfo, err := interfaces.GetNameServicesDNS(errorHandler, *client, data.SVMName.ValueString())
+	restInfo, err := r.readDNS(errorHandler, *client, data)
 	if err != nil {
-		// error reporting done inside GetNameServicesDNS
-		return
-	}
-	if restInfo == nil {
-		errorHandler.MakeAndReportError("No DNS found", fmt.Sprintf("NO DNS on svm %s found.", data.SVMName.ValueString()))
 		return
 	}
 
@@ -158,6 +230,27 @@ func (r *NameServicesDNSResource) Read(ctx context.Context, req resource.ReadReq
 		}
 	}
 
+	if data.DynamicDNS != nil && restInfo.DynamicDNS != nil {
+		data.DynamicDNS = flattenDynamicDNS(restInfo.DynamicDNS)
+	}
+
+	if data.Nsswitch != nil {
+		nsswitch, err := interfaces.GetSvmNsswitch(errorHandler, *client, restInfo.SVM.UUID)
+		if err != nil {
+			return
+		}
+		// only the databases set in the configuration are managed
+		if data.Nsswitch.Hosts != nil {
+			data.Nsswitch.Hosts = stringValues(nsswitch.Hosts)
+		}
+		if data.Nsswitch.Passwd != nil {
+			data.Nsswitch.Passwd = stringValues(nsswitch.Passwd)
+		}
+		if data.Nsswitch.Group != nil {
+			data.Nsswitch.Group = stringValues(nsswitch.Group)
+		}
+	}
+
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
 	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
@@ -205,6 +298,38 @@ func (r *NameServicesDNSResource) Create(ctx context.Context, req resource.Creat
 	}
 	data.ID = types.StringValue(dns.SVM.UUID)
 
+	// save the DNS configuration before the dynamic DNS and nsswitch updates, so that when one of them fails
+	// Terraform taints the resource and replaces it, instead of failing on the existing DNS configuration
+	created := *data
+	created.DynamicDNS = nil
+	created.Nsswitch = nil
+	resp.Diagnostics.Append(resp.State.Set(ctx, &created)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.DynamicDNS != nil {
+		err = interfaces.UpdateNameServicesDNS(errorHandler, *client, interfaces.NameServicesDNSUpdateBodyDataModelONTAP{DynamicDNS: dynamicDNSBody(data.DynamicDNS)}, dns.SVM.UUID)
+		if err != nil {
+			return
+		}
+		// read back the fqdn and time to live set by ONTAP
+		restInfo, err := r.readDNS(errorHandler, *client, *data)
+		if err != nil {
+			return
+		}
+		if restInfo.DynamicDNS != nil {
+			data.DynamicDNS = flattenDynamicDNS(restInfo.DynamicDNS)
+		}
+	}
+
+	if data.Nsswitch != nil {
+		err = interfaces.UpdateSvmNsswitch(errorHandler, *client, nsswitchBody(data.Nsswitch), dns.SVM.UUID)
+		if err != nil {
+			return
+		}
+	}
+
 	tflog.Trace(ctx, "created a resource")
 
 	// Save data into Terraform state
@@ -213,22 +338,75 @@ func (r *NameServicesDNSResource) Create(ctx context.Context, req resource.Creat
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *NameServicesDNSResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data *NameServicesDNSResourceModel
+	var plan, state *NameServicesDNSResourceModel
 
 	// Read Terraform plan data into the model
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
-	// License updates are not supported
-	err := errorHandler.MakeAndReportError("Update not supported for dns", "Update not supported for dns")
+	client, err := connection.GetRestClient(errorHandler, r.config, plan.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, plan.SVMName.ValueString())
 	if err != nil {
+		// error reporting done inside GetSvmByName
 		return
 	}
+
+	var body interfaces.NameServicesDNSUpdateBodyDataModelONTAP
+	changed := false
+	if !equalStringSets(plan.Domains, state.Domains) {
+		domains := stringsFromValues(plan.Domains)
+		body.Domains = &domains
+		changed = true
+	}
+	if !equalStringSets(plan.NameServers, state.NameServers) {
+		servers := stringsFromValues(plan.NameServers)
+		body.Servers = &servers
+		changed = true
+	}
+	if changed {
+		body.SkipConfigValidation = plan.SkipConfigValidation.ValueBoolPointer()
+	}
+	// removing dynamic_dns from the configuration leaves the settings as they are
+	if plan.DynamicDNS != nil && (state.DynamicDNS == nil || !plan.DynamicDNS.equal(*state.DynamicDNS)) {
+		body.DynamicDNS = dynamicDNSBody(plan.DynamicDNS)
+		changed = true
+	}
+	if changed {
+		err = interfaces.UpdateNameServicesDNS(errorHandler, *client, body, svm.UUID)
+		if err != nil {
+			return
+		}
+	}
+	if body.DynamicDNS != nil {
+		// read back the fqdn and time to live set by ONTAP
+		restInfo, err := r.readDNS(errorHandler, *client, *plan)
+		if err != nil {
+			return
+		}
+		if restInfo.DynamicDNS != nil {
+			plan.DynamicDNS = flattenDynamicDNS(restInfo.DynamicDNS)
+		}
+	}
+
+	if plan.Nsswitch != nil && (state.Nsswitch == nil || !plan.Nsswitch.equal(*state.Nsswitch)) {
+		err = interfaces.UpdateSvmNsswitch(errorHandler, *client, nsswitchBody(plan.Nsswitch), svm.UUID)
+		if err != nil {
+			return
+		}
+	}
+
+	plan.ID = types.StringValue(svm.UUID)
+
 	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -276,3 +454,113 @@ func (r *NameServicesDNSResource) ImportState(ctx context.Context, req resource.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[1])...)
 }
+
+// readDNS reads the DNS configuration of the svm, including dynamic_dns when it is managed
+func (r *NameServicesDNSResource) readDNS(errorHandler *utils.ErrorHandler, client restclient.RestClient, data NameServicesDNSResourceModel) (*interfaces.NameServicesDNSGetDataModelONTAP, error) {
+	var restInfo *interfaces.NameServicesDNSGetDataModelONTAP
+	var err error
+	if data.DynamicDNS != nil {
+		var cluster *interfaces.ClusterGetDataModelONTAP
+		cluster, err = interfaces.GetCluster(errorHandler, client)
+		if err != nil {
+			// error reporting done inside GetCluster
+			return nil, err
+		}
+		restInfo, err = interfaces.GetNameServicesDNS(errorHandler, client, data.SVMName.ValueString(), &cluster.Version)
+	} else {
+		restInfo, err = interfaces.GetNameServicesDNS(errorHandler, client, data.SVMName.ValueString(), nil)
+	}
+	if err != nil {
+		// error reporting done inside GetNameServicesDNS
+		return nil, err
+	}
+	if restInfo == nil {
+		return nil, errorHandler.MakeAndReportError("No DNS found", fmt.Sprintf("NO DNS on svm %s found.", data.SVMName.ValueString()))
+	}
+	return restInfo, nil
+}
+
+func (m DynamicDNSModel) equal(other DynamicDNSModel) bool {
+	return m.Enabled.Equal(other.Enabled) && m.UseSecure.Equal(other.UseSecure) && m.Fqdn.Equal(other.Fqdn) && m.TimeToLive.Equal(other.TimeToLive)
+}
+
+func (m NsswitchModel) equal(other NsswitchModel) bool {
+	return equalStringValues(m.Hosts, other.Hosts) && equalStringValues(m.Passwd, other.Passwd) && equalStringValues(m.Group, other.Group)
+}
+
+func dynamicDNSBody(data *DynamicDNSModel) *interfaces.NameServicesDynamicDNS {
+	body := &interfaces.NameServicesDynamicDNS{
+		Enabled:   data.Enabled.ValueBool(),
+		UseSecure: data.UseSecure.ValueBool(),
+	}
+	// fqdn and time_to_live are left to ONTAP when not set
+	if !data.Fqdn.IsUnknown() {
+		body.Fqdn = data.Fqdn.ValueString()
+	}
+	if !data.TimeToLive.IsUnknown() {
+		body.TimeToLive = data.TimeToLive.ValueString()
+	}
+	return body
+}
+
+func flattenDynamicDNS(data *interfaces.NameServicesDynamicDNS) *DynamicDNSModel {
+	return &DynamicDNSModel{
+		Enabled:    types.BoolValue(data.Enabled),
+		UseSecure:  types.BoolValue(data.UseSecure),
+		Fqdn:       types.StringValue(data.Fqdn),
+		TimeToLive: types.StringValue(data.TimeToLive),
+	}
+}
+
+func nsswitchBody(data *NsswitchModel) interfaces.SvmNsswitch {
+	return interfaces.SvmNsswitch{
+		Hosts:  stringsFromValues(data.Hosts),
+		Passwd: stringsFromValues(data.Passwd),
+		Group:  stringsFromValues(data.Group),
+	}
+}
+
+func stringsFromValues(values []types.String) []string {
+	var result []string
+	for _, v := range values {
+		result = append(result, v.ValueString())
+	}
+	return result
+}
+
+func stringValues(values []string) []types.String {
+	result := make([]types.String, len(values))
+	for index, v := range values {
+		result[index] = types.StringValue(v)
+	}
+	return result
+}
+
+func equalStringSets(a []types.String, b []types.String) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	set := make(map[string]bool, len(a))
+	for _, v := range a {
+		set[v.ValueString()] = true
+	}
+	for _, v := range b {
+		if !set[v.ValueString()] {
+			return false
+		}
+	}
+	return true
+}
+
+// equalStringValues compares two lists in order, the order of the nsswitch sources matters
+func equalStringValues(a []types.String, b []types.String) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for index := range a {
+		if !a[index].Equal(b[index]) {
+			return false
+		}
+	}
+	return true
+}
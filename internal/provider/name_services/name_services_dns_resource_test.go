@@ -28,6 +28,17 @@ func TestAccNameServicesDNSResource(t *testing.T) {
 					resource.TestCheckResourceAttr("netapp-ontap_dns.dns", "svm_name", "svm5"),
 				),
 			},
+			// Update servers, dynamic DNS and the name service switch in place
+			{
+				Config: testAccNameServicesDNSResourceUpdateConfig("svm5"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_dns.dns", "name_servers.#", "1"),
+					resource.TestCheckResourceAttr("netapp-ontap_dns.dns", "dynamic_dns.enabled", "true"),
+					resource.TestCheckResourceAttr("netapp-ontap_dns.dns", "dynamic_dns.time_to_live", "PT2H"),
+					resource.TestCheckResourceAttr("netapp-ontap_dns.dns", "nsswitch.hosts.0", "files"),
+					resource.TestCheckResourceAttr("netapp-ontap_dns.dns", "nsswitch.hosts.1", "dns"),
+				),
+			},
 			// Test importing a resource
 			{
 				ResourceName:  "netapp-ontap_dns.dns",
@@ -73,3 +84,43 @@ resource "netapp-ontap_dns" "dns" {
 }
 `, host, admin, password, svmName)
 }
+
+func testAccNameServicesDNSResourceUpdateConfig(svmName string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_dns" "dns" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  svm_name = "%s"
+  name_servers = ["1.1.1.1"]
+  dns_domains = ["foo.bar.com", "boo.bar.com"]
+  skip_config_validation = true
+  dynamic_dns = {
+    enabled = true
+    time_to_live = "PT2H"
+  }
+  nsswitch = {
+    hosts = ["files", "dns"]
+    passwd = ["files"]
+  }
+}
+`, host, admin, password, svmName)
+}
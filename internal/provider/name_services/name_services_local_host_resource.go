@@ -0,0 +1,300 @@
+package name_services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &NameServicesLocalHostResource{}
+var _ resource.ResourceWithImportState = &NameServicesLocalHostResource{}
+
+// NewNameServicesLocalHostResource is a helper function to simplify the provider implementation.
+func NewNameServicesLocalHostResource() resource.Resource {
+	return &NameServicesLocalHostResource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "name_services_local_host",
+		},
+	}
+}
+
+// NameServicesLocalHostResource defines the resource implementation.
+type NameServicesLocalHostResource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// NameServicesLocalHostResourceModel describes the resource data model.
+type NameServicesLocalHostResourceModel struct {
+	CxProfileName types.String   `tfsdk:"cx_profile_name"`
+	SVMName       types.String   `tfsdk:"svm_name"`
+	Address       types.String   `tfsdk:"address"`
+	Hostname      types.String   `tfsdk:"hostname"`
+	Aliases       []types.String `tfsdk:"aliases"`
+	ID            types.String   `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *NameServicesLocalHostResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.Name
+}
+
+// Schema defines the schema for the resource.
+func (r *NameServicesLocalHostResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "NameServicesLocalHost resource",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the SVM",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"address": schema.StringAttribute{
+				MarkdownDescription: "IPv4 or IPv6 address of the host",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Canonical name of the host",
+				Required:            true,
+			},
+			"aliases": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Alternate names of the host",
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Local host identifier, svm_name/address",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *NameServicesLocalHostResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *NameServicesLocalHostResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NameServicesLocalHostResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetNameServicesLocalHost(errorHandler, *client, data.Address.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetNameServicesLocalHost
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No local host %s found on svm %s", data.Address.ValueString(), data.SVMName.ValueString()))
+		return
+	}
+
+	data.Address = types.StringValue(restInfo.Address)
+	data.SVMName = types.StringValue(restInfo.Owner.Name)
+	data.Hostname = types.StringValue(restInfo.Hostname)
+	// keep aliases unset when there are none, so that an empty configuration does not show a change
+	if len(restInfo.Aliases) > 0 || len(data.Aliases) > 0 {
+		data.Aliases = make([]types.String, len(restInfo.Aliases))
+		for index, alias := range restInfo.Aliases {
+			data.Aliases[index] = types.StringValue(alias)
+		}
+	}
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", restInfo.Owner.Name, restInfo.Address))
+
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a resource and retrieve UUID
+func (r *NameServicesLocalHostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *NameServicesLocalHostResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var body interfaces.NameServicesLocalHostResourceBodyDataModelONTAP
+	body.Address = data.Address.ValueString()
+	body.Owner.Name = data.SVMName.ValueString()
+	body.Hostname = data.Hostname.ValueString()
+	for _, alias := range data.Aliases {
+		body.Aliases = append(body.Aliases, alias.ValueString())
+	}
+
+	err = interfaces.CreateNameServicesLocalHost(errorHandler, *client, body)
+	if err != nil {
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.SVMName.ValueString(), data.Address.ValueString()))
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *NameServicesLocalHostResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *NameServicesLocalHostResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, plan.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetNameServicesLocalHost(errorHandler, *client, state.Address.ValueString(), state.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No local host %s found on svm %s", state.Address.ValueString(), state.SVMName.ValueString()))
+		return
+	}
+
+	var body interfaces.NameServicesLocalHostUpdateBodyDataModelONTAP
+	if !plan.Hostname.Equal(state.Hostname) {
+		body.Hostname = plan.Hostname.ValueStringPointer()
+	}
+	aliases := []string{}
+	for _, alias := range plan.Aliases {
+		aliases = append(aliases, alias.ValueString())
+	}
+	body.Aliases = &aliases
+
+	err = interfaces.UpdateNameServicesLocalHost(errorHandler, *client, body, restInfo.Owner.UUID, state.Address.ValueString())
+	if err != nil {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *NameServicesLocalHostResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *NameServicesLocalHostResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetNameServicesLocalHost(errorHandler, *client, data.Address.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	if restInfo == nil {
+		// already gone
+		return
+	}
+
+	err = interfaces.DeleteNameServicesLocalHost(errorHandler, *client, restInfo.Owner.UUID, data.Address.ValueString())
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *NameServicesLocalHostResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: address,svm_name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("address"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
+}
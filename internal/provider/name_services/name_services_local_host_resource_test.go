@@ -0,0 +1,84 @@
+package name_services_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	ntest "github.com/netapp/terraform-provider-netapp-ontap/internal/provider"
+)
+
+func TestAccNameServicesLocalHostResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { ntest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ntest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccNameServicesLocalHostResourceConfig("host1.example.com", `["host1"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_name_services_local_host.example", "hostname", "host1.example.com"),
+					resource.TestCheckResourceAttr("netapp-ontap_name_services_local_host.example", "aliases.0", "host1"),
+					resource.TestCheckResourceAttr("netapp-ontap_name_services_local_host.example", "id", "svm5/10.10.10.10"),
+				),
+			},
+			// Update the hostname and remove the aliases in place
+			{
+				Config: testAccNameServicesLocalHostResourceConfig("host2.example.com", "null"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_name_services_local_host.example", "hostname", "host2.example.com"),
+					resource.TestCheckNoResourceAttr("netapp-ontap_name_services_local_host.example", "aliases"),
+				),
+			},
+			// Test importing a resource
+			{
+				ResourceName:  "netapp-ontap_name_services_local_host.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s,%s", "10.10.10.10", "svm5", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_name_services_local_host.example", "hostname", "host2.example.com"),
+				),
+			},
+			// Import with a bad id
+			{
+				ResourceName:  "netapp-ontap_name_services_local_host.example",
+				ImportState:   true,
+				ImportStateId: "10.10.10.10,cluster4",
+				ExpectError:   regexp.MustCompile("Unexpected Import Identifier"),
+			},
+		},
+	})
+}
+
+func testAccNameServicesLocalHostResourceConfig(hostname string, aliases string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_name_services_local_host" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "svm5"
+  address = "10.10.10.10"
+  hostname = "%s"
+  aliases = %s
+}
+`, host, admin, password, hostname, aliases)
+}
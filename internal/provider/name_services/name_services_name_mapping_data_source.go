@@ -0,0 +1,151 @@
+package name_services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &NameServicesNameMappingDataSource{}
+
+// NewNameServicesNameMappingDataSource is a helper function to simplify the provider implementation.
+func NewNameServicesNameMappingDataSource() datasource.DataSource {
+	return &NameServicesNameMappingDataSource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "name_services_name_mapping",
+		},
+	}
+}
+
+// NameServicesNameMappingDataSource defines the data source implementation.
+type NameServicesNameMappingDataSource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// NameServicesNameMappingDataSourceModel describes the data source data model.
+type NameServicesNameMappingDataSourceModel struct {
+	CxProfileName types.String `tfsdk:"cx_profile_name"`
+	SVMName       types.String `tfsdk:"svm_name"`
+	Direction     types.String `tfsdk:"direction"`
+	Index         types.Int64  `tfsdk:"index"`
+	Pattern       types.String `tfsdk:"pattern"`
+	Replacement   types.String `tfsdk:"replacement"`
+	ClientMatch   types.String `tfsdk:"client_match"`
+}
+
+// Metadata returns the data source type name.
+func (d *NameServicesNameMappingDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.Name
+}
+
+// Schema defines the schema for the data source.
+func (d *NameServicesNameMappingDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "NameServicesNameMapping data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the SVM",
+				Required:            true,
+			},
+			"direction": schema.StringAttribute{
+				MarkdownDescription: "Direction of the name mapping",
+				Required:            true,
+			},
+			"index": schema.Int64Attribute{
+				MarkdownDescription: "Position of the name mapping in the list of mappings for the direction",
+				Required:            true,
+			},
+			"pattern": schema.StringAttribute{
+				MarkdownDescription: "Pattern used to match the name while searching for a name that can be used as a replacement",
+				Computed:            true,
+			},
+			"replacement": schema.StringAttribute{
+				MarkdownDescription: "Replacement pattern for the matching name",
+				Computed:            true,
+			},
+			"client_match": schema.StringAttribute{
+				MarkdownDescription: "Client workstation IP address, subnet or hostname to match",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *NameServicesNameMappingDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *NameServicesNameMappingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NameServicesNameMappingDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetNameServicesNameMapping(errorHandler, *client, data.SVMName.ValueString(), data.Direction.ValueString(), data.Index.ValueInt64())
+	if err != nil {
+		// error reporting done inside GetNameServicesNameMapping
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No %s name mapping found at index %d on svm %s", data.Direction.ValueString(), data.Index.ValueInt64(), data.SVMName.ValueString()))
+		return
+	}
+
+	data = flattenNameServicesNameMapping(data.CxProfileName, *restInfo)
+
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func flattenNameServicesNameMapping(cxProfileName types.String, record interfaces.NameServicesNameMappingGetDataModelONTAP) NameServicesNameMappingDataSourceModel {
+	return NameServicesNameMappingDataSourceModel{
+		CxProfileName: cxProfileName,
+		SVMName:       types.StringValue(record.SVM.Name),
+		Direction:     types.StringValue(record.Direction),
+		Index:         types.Int64Value(record.Index),
+		Pattern:       types.StringValue(record.Pattern),
+		Replacement:   types.StringValue(record.Replacement),
+		ClientMatch:   types.StringValue(record.ClientMatch),
+	}
+}
@@ -0,0 +1,359 @@
+package name_services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &NameServicesNameMappingResource{}
+var _ resource.ResourceWithImportState = &NameServicesNameMappingResource{}
+
+// NewNameServicesNameMappingResource is a helper function to simplify the provider implementation.
+func NewNameServicesNameMappingResource() resource.Resource {
+	return &NameServicesNameMappingResource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "name_services_name_mapping",
+		},
+	}
+}
+
+// NameServicesNameMappingResource defines the resource implementation.
+type NameServicesNameMappingResource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// NameServicesNameMappingResourceModel describes the resource data model.
+type NameServicesNameMappingResourceModel struct {
+	CxProfileName types.String `tfsdk:"cx_profile_name"`
+	SVMName       types.String `tfsdk:"svm_name"`
+	Direction     types.String `tfsdk:"direction"`
+	Index         types.Int64  `tfsdk:"index"`
+	Pattern       types.String `tfsdk:"pattern"`
+	Replacement   types.String `tfsdk:"replacement"`
+	ClientMatch   types.String `tfsdk:"client_match"`
+	ID            types.String `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *NameServicesNameMappingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.Name
+}
+
+// Schema defines the schema for the resource.
+func (r *NameServicesNameMappingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "NameServicesNameMapping resource",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the SVM",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"direction": schema.StringAttribute{
+				MarkdownDescription: "Direction of the name mapping",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("win_unix", "unix_win", "krb_unix", "s3_unix", "s3_win"),
+				},
+			},
+			"index": schema.Int64Attribute{
+				MarkdownDescription: "Position of the name mapping in the list of mappings for the direction, mappings are evaluated in index order. Changing it moves the mapping and ONTAP shifts the mappings in between",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 1024),
+				},
+			},
+			"pattern": schema.StringAttribute{
+				MarkdownDescription: "Pattern used to match the name while searching for a name that can be used as a replacement",
+				Required:            true,
+			},
+			"replacement": schema.StringAttribute{
+				MarkdownDescription: "Replacement pattern for the matching name",
+				Required:            true,
+			},
+			"client_match": schema.StringAttribute{
+				MarkdownDescription: "Client workstation IP address, subnet or hostname to match",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Name mapping identifier, svm_name/direction/index",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *NameServicesNameMappingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *NameServicesNameMappingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NameServicesNameMappingResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := getNameMapping(errorHandler, *client, &data)
+	if err != nil {
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No %s name mapping found at index %d on svm %s", data.Direction.ValueString(), data.Index.ValueInt64(), data.SVMName.ValueString()))
+		return
+	}
+
+	data.SVMName = types.StringValue(restInfo.SVM.Name)
+	data.Direction = types.StringValue(restInfo.Direction)
+	data.Index = types.Int64Value(restInfo.Index)
+	data.Pattern = types.StringValue(restInfo.Pattern)
+	data.Replacement = types.StringValue(restInfo.Replacement)
+	data.ClientMatch = types.StringValue(restInfo.ClientMatch)
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s/%d", restInfo.SVM.Name, restInfo.Direction, restInfo.Index))
+
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a resource and retrieve UUID
+func (r *NameServicesNameMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *NameServicesNameMappingResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var body interfaces.NameServicesNameMappingResourceBodyDataModelONTAP
+	body.SVM.Name = data.SVMName.ValueString()
+	body.Direction = data.Direction.ValueString()
+	body.Index = data.Index.ValueInt64()
+	body.Pattern = data.Pattern.ValueString()
+	body.Replacement = data.Replacement.ValueString()
+	body.ClientMatch = data.ClientMatch.ValueString()
+
+	err = interfaces.CreateNameServicesNameMapping(errorHandler, *client, body)
+	if err != nil {
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s/%d", data.SVMName.ValueString(), data.Direction.ValueString(), data.Index.ValueInt64()))
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *NameServicesNameMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *NameServicesNameMappingResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, plan.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	// a sibling mapping updated earlier in the same apply may have shifted this one
+	restInfo, err := getNameMapping(errorHandler, *client, state)
+	if err != nil {
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No %s name mapping found at index %d on svm %s", state.Direction.ValueString(), state.Index.ValueInt64(), state.SVMName.ValueString()))
+		return
+	}
+
+	// move first, the other attributes are then updated at the new index
+	if restInfo.Index != plan.Index.ValueInt64() {
+		err = interfaces.UpdateNameServicesNameMappingIndex(errorHandler, *client, restInfo.SVM.UUID, restInfo.Direction, restInfo.Index, plan.Index.ValueInt64())
+		if err != nil {
+			return
+		}
+	}
+
+	if !plan.Pattern.Equal(state.Pattern) || !plan.Replacement.Equal(state.Replacement) || !plan.ClientMatch.Equal(state.ClientMatch) {
+		var body interfaces.NameServicesNameMappingUpdateBodyDataModelONTAP
+		body.Pattern = plan.Pattern.ValueString()
+		body.Replacement = plan.Replacement.ValueString()
+		body.ClientMatch = plan.ClientMatch.ValueString()
+		err = interfaces.UpdateNameServicesNameMapping(errorHandler, *client, body, restInfo.SVM.UUID, plan.Direction.ValueString(), plan.Index.ValueInt64())
+		if err != nil {
+			return
+		}
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s/%d", plan.SVMName.ValueString(), plan.Direction.ValueString(), plan.Index.ValueInt64()))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *NameServicesNameMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *NameServicesNameMappingResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	// the mapping may have been shifted by a sibling mapping deleted in the same apply
+	restInfo, err := getNameMapping(errorHandler, *client, data)
+	if err != nil {
+		return
+	}
+	if restInfo == nil {
+		// already gone
+		return
+	}
+
+	err = interfaces.DeleteNameServicesNameMapping(errorHandler, *client, restInfo.SVM.UUID, restInfo.Direction, restInfo.Index)
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *NameServicesNameMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: index,direction,svm_name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+	index, err := strconv.ParseInt(idParts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected a number for index. Got: %q", idParts[0]),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("index"), index)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("direction"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[3])...)
+}
+
+// getNameMapping reads the mapping at the index in state. ONTAP shifts the indexes when a mapping is created, moved or deleted
+// in the same direction, so when the record at that index is a different mapping, the mapping is looked up by its content.
+func getNameMapping(errorHandler *utils.ErrorHandler, client restclient.RestClient, data *NameServicesNameMappingResourceModel) (*interfaces.NameServicesNameMappingGetDataModelONTAP, error) {
+	restInfo, err := interfaces.GetNameServicesNameMapping(errorHandler, client, data.SVMName.ValueString(), data.Direction.ValueString(), data.Index.ValueInt64())
+	if err != nil {
+		return nil, err
+	}
+	// nothing to compare against on import
+	if data.Pattern.IsNull() || (restInfo != nil && nameMappingMatches(restInfo, data)) {
+		return restInfo, nil
+	}
+	mappings, err := interfaces.GetNameServicesNameMappings(errorHandler, client, &interfaces.NameServicesNameMappingDataSourceFilterModel{
+		SVMName:   data.SVMName.ValueString(),
+		Direction: data.Direction.ValueString(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, mapping := range mappings {
+		if nameMappingMatches(&mapping, data) {
+			tflog.Debug(errorHandler.Ctx, fmt.Sprintf("name mapping %d was shifted to index %d", data.Index.ValueInt64(), mapping.Index))
+			return &mapping, nil
+		}
+	}
+	// the mapping was modified out of band, report the record at the index as drift
+	return restInfo, nil
+}
+
+func nameMappingMatches(mapping *interfaces.NameServicesNameMappingGetDataModelONTAP, data *NameServicesNameMappingResourceModel) bool {
+	return mapping.Pattern == data.Pattern.ValueString() && mapping.Replacement == data.Replacement.ValueString() && mapping.ClientMatch == data.ClientMatch.ValueString()
+}
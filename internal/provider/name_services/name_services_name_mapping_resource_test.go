@@ -0,0 +1,87 @@
+package name_services_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	ntest "github.com/netapp/terraform-provider-netapp-ontap/internal/provider"
+)
+
+func TestAccNameServicesNameMappingResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { ntest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ntest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read
+			{
+				Config: testAccNameServicesNameMappingResourceConfig(1, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_name_services_name_mapping.first", "index", "1"),
+					resource.TestCheckResourceAttr("netapp-ontap_name_services_name_mapping.second", "index", "2"),
+					resource.TestCheckResourceAttr("netapp-ontap_name_services_name_mapping.second", "replacement", "nobody"),
+				),
+			},
+			// Swap the priorities in place
+			{
+				Config: testAccNameServicesNameMappingResourceConfig(2, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_name_services_name_mapping.first", "index", "2"),
+					resource.TestCheckResourceAttr("netapp-ontap_name_services_name_mapping.second", "index", "1"),
+				),
+			},
+			// Import and read
+			{
+				ResourceName:  "netapp-ontap_name_services_name_mapping.second",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s,%s,%s", "1", "win_unix", "svm0", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_name_services_name_mapping.second", "replacement", "nobody"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNameServicesNameMappingResourceConfig(firstIndex int, secondIndex int) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_name_services_name_mapping" "first" {
+  cx_profile_name = "cluster4"
+  svm_name = "svm0"
+  direction = "win_unix"
+  index = %d
+  pattern = "ACCTEST\\\\(.+)"
+  replacement = "\\1"
+}
+
+resource "netapp-ontap_name_services_name_mapping" "second" {
+  cx_profile_name = "cluster4"
+  svm_name = "svm0"
+  direction = "win_unix"
+  index = %d
+  pattern = "ACCTEST\\\\guest"
+  replacement = "nobody"
+  depends_on = [netapp-ontap_name_services_name_mapping.first]
+}
+`, host, admin, password, firstIndex, secondIndex)
+}
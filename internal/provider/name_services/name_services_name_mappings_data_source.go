@@ -0,0 +1,172 @@
+package name_services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &NameServicesNameMappingsDataSource{}
+
+// NewNameServicesNameMappingsDataSource is a helper function to simplify the provider implementation.
+func NewNameServicesNameMappingsDataSource() datasource.DataSource {
+	return &NameServicesNameMappingsDataSource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "name_services_name_mappings",
+		},
+	}
+}
+
+// NameServicesNameMappingsDataSource defines the data source implementation.
+type NameServicesNameMappingsDataSource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// NameServicesNameMappingsDataSourceModel describes the data source data model.
+type NameServicesNameMappingsDataSourceModel struct {
+	CxProfileName            types.String                                   `tfsdk:"cx_profile_name"`
+	NameServicesNameMappings []NameServicesNameMappingDataSourceModel       `tfsdk:"name_services_name_mappings"`
+	Filter                   *NameServicesNameMappingsDataSourceFilterModel `tfsdk:"filter"`
+}
+
+// NameServicesNameMappingsDataSourceFilterModel describes the data source data model for queries.
+type NameServicesNameMappingsDataSourceFilterModel struct {
+	SVMName   types.String `tfsdk:"svm_name"`
+	Direction types.String `tfsdk:"direction"`
+}
+
+// Metadata returns the data source type name.
+func (d *NameServicesNameMappingsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.Name
+}
+
+// Schema defines the schema for the data source.
+func (d *NameServicesNameMappingsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "NameServicesNameMappings data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"svm_name": schema.StringAttribute{
+						MarkdownDescription: "Name of the SVM",
+						Optional:            true,
+					},
+					"direction": schema.StringAttribute{
+						MarkdownDescription: "Direction of the name mapping",
+						Optional:            true,
+					},
+				},
+				Optional: true,
+			},
+			"name_services_name_mappings": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cx_profile_name": schema.StringAttribute{
+							MarkdownDescription: "Connection profile name",
+							Required:            true,
+						},
+						"svm_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the SVM",
+							Required:            true,
+						},
+						"direction": schema.StringAttribute{
+							MarkdownDescription: "Direction of the name mapping",
+							Required:            true,
+						},
+						"index": schema.Int64Attribute{
+							MarkdownDescription: "Position of the name mapping in the list of mappings for the direction",
+							Required:            true,
+						},
+						"pattern": schema.StringAttribute{
+							MarkdownDescription: "Pattern used to match the name while searching for a name that can be used as a replacement",
+							Computed:            true,
+						},
+						"replacement": schema.StringAttribute{
+							MarkdownDescription: "Replacement pattern for the matching name",
+							Computed:            true,
+						},
+						"client_match": schema.StringAttribute{
+							MarkdownDescription: "Client workstation IP address, subnet or hostname to match",
+							Computed:            true,
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *NameServicesNameMappingsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *NameServicesNameMappingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NameServicesNameMappingsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var filter *interfaces.NameServicesNameMappingDataSourceFilterModel = nil
+	if data.Filter != nil {
+		filter = &interfaces.NameServicesNameMappingDataSourceFilterModel{
+			SVMName:   data.Filter.SVMName.ValueString(),
+			Direction: data.Filter.Direction.ValueString(),
+		}
+	}
+	restInfo, err := interfaces.GetNameServicesNameMappings(errorHandler, *client, filter)
+	if err != nil {
+		// error reporting done inside GetNameServicesNameMappings
+		return
+	}
+
+	data.NameServicesNameMappings = make([]NameServicesNameMappingDataSourceModel, len(restInfo))
+	for index, record := range restInfo {
+		data.NameServicesNameMappings[index] = flattenNameServicesNameMapping(data.CxProfileName, record)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,328 @@
+package name_services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &NameServicesUnixGroupResource{}
+var _ resource.ResourceWithImportState = &NameServicesUnixGroupResource{}
+
+// NewNameServicesUnixGroupResource is a helper function to simplify the provider implementation.
+func NewNameServicesUnixGroupResource() resource.Resource {
+	return &NameServicesUnixGroupResource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "name_services_unix_group",
+		},
+	}
+}
+
+// NameServicesUnixGroupResource defines the resource implementation.
+type NameServicesUnixGroupResource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// NameServicesUnixGroupResourceModel describes the resource data model.
+type NameServicesUnixGroupResourceModel struct {
+	CxProfileName types.String   `tfsdk:"cx_profile_name"`
+	SVMName       types.String   `tfsdk:"svm_name"`
+	Name          types.String   `tfsdk:"name"`
+	GroupID       types.Int64    `tfsdk:"group_id"`
+	Users         []types.String `tfsdk:"users"`
+	ID            types.String   `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *NameServicesUnixGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.Name
+}
+
+// Schema defines the schema for the resource.
+func (r *NameServicesUnixGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "NameServicesUnixGroup resource",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the SVM",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "UNIX group name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group_id": schema.Int64Attribute{
+				MarkdownDescription: "UNIX group ID",
+				Required:            true,
+			},
+			"users": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Names of the UNIX users that are members of the group. Membership is not managed when omitted",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "UNIX group identifier, svm_name/name",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *NameServicesUnixGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *NameServicesUnixGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NameServicesUnixGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetNameServicesUnixGroupByName(errorHandler, *client, data.Name.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetNameServicesUnixGroupByName
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No UNIX group %s found on svm %s", data.Name.ValueString(), data.SVMName.ValueString()))
+		return
+	}
+
+	data.Name = types.StringValue(restInfo.Name)
+	data.SVMName = types.StringValue(restInfo.SVM.Name)
+	data.GroupID = types.Int64Value(restInfo.ID)
+	// membership is only refreshed when it is managed, members added out of band then show as drift
+	if data.Users != nil {
+		data.Users = make([]types.String, len(restInfo.Users))
+		for i, user := range restInfo.Users {
+			data.Users[i] = types.StringValue(user.Name)
+		}
+	}
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", restInfo.SVM.Name, restInfo.Name))
+
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a resource and retrieve UUID
+func (r *NameServicesUnixGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *NameServicesUnixGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var body interfaces.NameServicesUnixGroupResourceBodyDataModelONTAP
+	body.Name = data.Name.ValueString()
+	body.SVM.Name = data.SVMName.ValueString()
+	body.ID = data.GroupID.ValueInt64()
+
+	err = interfaces.CreateNameServicesUnixGroup(errorHandler, *client, body)
+	if err != nil {
+		return
+	}
+
+	if len(data.Users) > 0 {
+		restInfo, err := interfaces.GetNameServicesUnixGroupByName(errorHandler, *client, data.Name.ValueString(), data.SVMName.ValueString())
+		if err != nil {
+			return
+		}
+		if restInfo == nil {
+			errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No UNIX group %s found on svm %s after create", data.Name.ValueString(), data.SVMName.ValueString()))
+			return
+		}
+		for _, user := range data.Users {
+			err = interfaces.AddNameServicesUnixGroupUser(errorHandler, *client, restInfo.SVM.UUID, data.Name.ValueString(), user.ValueString())
+			if err != nil {
+				return
+			}
+		}
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.SVMName.ValueString(), data.Name.ValueString()))
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *NameServicesUnixGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *NameServicesUnixGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, plan.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetNameServicesUnixGroupByName(errorHandler, *client, state.Name.ValueString(), state.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No UNIX group %s found on svm %s", state.Name.ValueString(), state.SVMName.ValueString()))
+		return
+	}
+
+	if !plan.GroupID.Equal(state.GroupID) {
+		body := interfaces.NameServicesUnixGroupUpdateBodyDataModelONTAP{ID: plan.GroupID.ValueInt64()}
+		err = interfaces.UpdateNameServicesUnixGroup(errorHandler, *client, body, restInfo.SVM.UUID, state.Name.ValueString())
+		if err != nil {
+			return
+		}
+	}
+
+	// membership is left alone when users is omitted
+	if plan.Users != nil {
+		current := make(map[string]bool)
+		for _, user := range restInfo.Users {
+			current[user.Name] = true
+		}
+		planUsers := make(map[string]bool)
+		for _, user := range plan.Users {
+			planUsers[user.ValueString()] = true
+			if !current[user.ValueString()] {
+				err = interfaces.AddNameServicesUnixGroupUser(errorHandler, *client, restInfo.SVM.UUID, state.Name.ValueString(), user.ValueString())
+				if err != nil {
+					return
+				}
+			}
+		}
+		for name := range current {
+			if !planUsers[name] {
+				err = interfaces.RemoveNameServicesUnixGroupUser(errorHandler, *client, restInfo.SVM.UUID, state.Name.ValueString(), name)
+				if err != nil {
+					return
+				}
+			}
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *NameServicesUnixGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *NameServicesUnixGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetNameServicesUnixGroupByName(errorHandler, *client, data.Name.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	if restInfo == nil {
+		// already gone
+		return
+	}
+
+	err = interfaces.DeleteNameServicesUnixGroup(errorHandler, *client, restInfo.SVM.UUID, data.Name.ValueString())
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *NameServicesUnixGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: name,svm_name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
+}
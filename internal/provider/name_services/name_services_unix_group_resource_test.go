@@ -0,0 +1,95 @@
+package name_services_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	ntest "github.com/netapp/terraform-provider-netapp-ontap/internal/provider"
+)
+
+func TestAccNameServicesUnixGroupResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { ntest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ntest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read
+			{
+				Config: testAccNameServicesUnixGroupResourceConfig(2001, `"acc_test_user1"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_name_services_unix_user.user1", "user_id", "2001"),
+					resource.TestCheckResourceAttr("netapp-ontap_name_services_unix_group.example", "group_id", "2001"),
+					resource.TestCheckResourceAttr("netapp-ontap_name_services_unix_group.example", "users.#", "1"),
+				),
+			},
+			// Update group id and membership in place
+			{
+				Config: testAccNameServicesUnixGroupResourceConfig(2002, `"acc_test_user2"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_name_services_unix_group.example", "group_id", "2002"),
+					resource.TestCheckResourceAttr("netapp-ontap_name_services_unix_group.example", "users.#", "1"),
+					resource.TestCheckTypeSetElemAttr("netapp-ontap_name_services_unix_group.example", "users.*", "acc_test_user2"),
+				),
+			},
+			// Import and read
+			{
+				ResourceName:  "netapp-ontap_name_services_unix_user.user1",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s,%s", "acc_test_user1", "svm0", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_name_services_unix_user.user1", "primary_gid", "2001"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNameServicesUnixGroupResourceConfig(groupID int, users string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_name_services_unix_user" "user1" {
+  cx_profile_name = "cluster4"
+  svm_name = "svm0"
+  name = "acc_test_user1"
+  user_id = 2001
+  primary_gid = 2001
+  full_name = "acceptance test user 1"
+}
+
+resource "netapp-ontap_name_services_unix_user" "user2" {
+  cx_profile_name = "cluster4"
+  svm_name = "svm0"
+  name = "acc_test_user2"
+  user_id = 2002
+  primary_gid = 2001
+}
+
+resource "netapp-ontap_name_services_unix_group" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "svm0"
+  name = "acc_test_group"
+  group_id = %d
+  users = [%s]
+  depends_on = [netapp-ontap_name_services_unix_user.user1, netapp-ontap_name_services_unix_user.user2]
+}
+`, host, admin, password, groupID, users)
+}
@@ -0,0 +1,165 @@
+package name_services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &NameServicesUnixGroupsDataSource{}
+
+// NewNameServicesUnixGroupsDataSource is a helper function to simplify the provider implementation.
+func NewNameServicesUnixGroupsDataSource() datasource.DataSource {
+	return &NameServicesUnixGroupsDataSource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "name_services_unix_groups",
+		},
+	}
+}
+
+// NameServicesUnixGroupsDataSource defines the data source implementation.
+type NameServicesUnixGroupsDataSource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// NameServicesUnixGroupsDataSourceModel describes the data source data model.
+type NameServicesUnixGroupsDataSourceModel struct {
+	CxProfileName          types.String                                 `tfsdk:"cx_profile_name"`
+	NameServicesUnixGroups []NameServicesUnixGroupDataSourceModel       `tfsdk:"name_services_unix_groups"`
+	Filter                 *NameServicesUnixGroupsDataSourceFilterModel `tfsdk:"filter"`
+}
+
+// NameServicesUnixGroupsDataSourceFilterModel describes the data source data model for queries.
+type NameServicesUnixGroupsDataSourceFilterModel struct {
+	Name    types.String `tfsdk:"name"`
+	SVMName types.String `tfsdk:"svm_name"`
+}
+
+// Metadata returns the data source type name.
+func (d *NameServicesUnixGroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.Name
+}
+
+// Schema defines the schema for the data source.
+func (d *NameServicesUnixGroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "NameServicesUnixGroups data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "UNIX group name",
+						Optional:            true,
+					},
+					"svm_name": schema.StringAttribute{
+						MarkdownDescription: "Name of the SVM",
+						Optional:            true,
+					},
+				},
+				Optional: true,
+			},
+			"name_services_unix_groups": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cx_profile_name": schema.StringAttribute{
+							MarkdownDescription: "Connection profile name",
+							Required:            true,
+						},
+						"svm_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the SVM",
+							Required:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "UNIX group name",
+							Required:            true,
+						},
+						"group_id": schema.Int64Attribute{
+							MarkdownDescription: "UNIX group ID",
+							Computed:            true,
+						},
+						"users": schema.SetAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "Names of the UNIX users that are members of the group",
+							Computed:            true,
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *NameServicesUnixGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *NameServicesUnixGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NameServicesUnixGroupsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var filter *interfaces.NameServicesUnixGroupDataSourceFilterModel = nil
+	if data.Filter != nil {
+		filter = &interfaces.NameServicesUnixGroupDataSourceFilterModel{
+			Name:    data.Filter.Name.ValueString(),
+			SVMName: data.Filter.SVMName.ValueString(),
+		}
+	}
+	restInfo, err := interfaces.GetNameServicesUnixGroups(errorHandler, *client, filter)
+	if err != nil {
+		// error reporting done inside GetNameServicesUnixGroups
+		return
+	}
+
+	data.NameServicesUnixGroups = make([]NameServicesUnixGroupDataSourceModel, len(restInfo))
+	for index, record := range restInfo {
+		data.NameServicesUnixGroups[index] = flattenNameServicesUnixGroup(data.CxProfileName, record)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
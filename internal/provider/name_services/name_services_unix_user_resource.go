@@ -0,0 +1,297 @@
+package name_services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &NameServicesUnixUserResource{}
+var _ resource.ResourceWithImportState = &NameServicesUnixUserResource{}
+
+// NewNameServicesUnixUserResource is a helper function to simplify the provider implementation.
+func NewNameServicesUnixUserResource() resource.Resource {
+	return &NameServicesUnixUserResource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "name_services_unix_user",
+		},
+	}
+}
+
+// NameServicesUnixUserResource defines the resource implementation.
+type NameServicesUnixUserResource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// NameServicesUnixUserResourceModel describes the resource data model.
+type NameServicesUnixUserResourceModel struct {
+	CxProfileName types.String `tfsdk:"cx_profile_name"`
+	SVMName       types.String `tfsdk:"svm_name"`
+	Name          types.String `tfsdk:"name"`
+	UserID        types.Int64  `tfsdk:"user_id"`
+	PrimaryGID    types.Int64  `tfsdk:"primary_gid"`
+	FullName      types.String `tfsdk:"full_name"`
+	ID            types.String `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *NameServicesUnixUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.Name
+}
+
+// Schema defines the schema for the resource.
+func (r *NameServicesUnixUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "NameServicesUnixUser resource",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the SVM",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "UNIX user name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.Int64Attribute{
+				MarkdownDescription: "UNIX user ID",
+				Required:            true,
+			},
+			"primary_gid": schema.Int64Attribute{
+				MarkdownDescription: "Primary group ID of the UNIX user",
+				Required:            true,
+			},
+			"full_name": schema.StringAttribute{
+				MarkdownDescription: "Full name of the UNIX user",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "UNIX user identifier, svm_name/name",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *NameServicesUnixUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *NameServicesUnixUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NameServicesUnixUserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetNameServicesUnixUserByName(errorHandler, *client, data.Name.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetNameServicesUnixUserByName
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No UNIX user %s found on svm %s", data.Name.ValueString(), data.SVMName.ValueString()))
+		return
+	}
+
+	data.Name = types.StringValue(restInfo.Name)
+	data.SVMName = types.StringValue(restInfo.SVM.Name)
+	data.UserID = types.Int64Value(restInfo.ID)
+	data.PrimaryGID = types.Int64Value(restInfo.PrimaryGID)
+	data.FullName = types.StringValue(restInfo.FullName)
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", restInfo.SVM.Name, restInfo.Name))
+
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a resource and retrieve UUID
+func (r *NameServicesUnixUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *NameServicesUnixUserResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var body interfaces.NameServicesUnixUserResourceBodyDataModelONTAP
+	body.Name = data.Name.ValueString()
+	body.SVM.Name = data.SVMName.ValueString()
+	body.ID = data.UserID.ValueInt64()
+	body.PrimaryGID = data.PrimaryGID.ValueInt64()
+	body.FullName = data.FullName.ValueString()
+
+	err = interfaces.CreateNameServicesUnixUser(errorHandler, *client, body)
+	if err != nil {
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.SVMName.ValueString(), data.Name.ValueString()))
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *NameServicesUnixUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *NameServicesUnixUserResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, plan.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetNameServicesUnixUserByName(errorHandler, *client, state.Name.ValueString(), state.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No UNIX user %s found on svm %s", state.Name.ValueString(), state.SVMName.ValueString()))
+		return
+	}
+
+	var body interfaces.NameServicesUnixUserUpdateBodyDataModelONTAP
+	if !plan.UserID.Equal(state.UserID) {
+		body.ID = plan.UserID.ValueInt64Pointer()
+	}
+	if !plan.PrimaryGID.Equal(state.PrimaryGID) {
+		body.PrimaryGID = plan.PrimaryGID.ValueInt64Pointer()
+	}
+	if !plan.FullName.Equal(state.FullName) {
+		body.FullName = plan.FullName.ValueStringPointer()
+	}
+
+	err = interfaces.UpdateNameServicesUnixUser(errorHandler, *client, body, restInfo.SVM.UUID, state.Name.ValueString())
+	if err != nil {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *NameServicesUnixUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *NameServicesUnixUserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetNameServicesUnixUserByName(errorHandler, *client, data.Name.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	if restInfo == nil {
+		// already gone
+		return
+	}
+
+	err = interfaces.DeleteNameServicesUnixUser(errorHandler, *client, restInfo.SVM.UUID, data.Name.ValueString())
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *NameServicesUnixUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: name,svm_name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
+}
@@ -0,0 +1,342 @@
+package networking
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &BroadcastDomainResource{}
+var _ resource.ResourceWithImportState = &BroadcastDomainResource{}
+
+// NewBroadcastDomainResource is a helper function to simplify the provider implementation.
+func NewBroadcastDomainResource() resource.Resource {
+	return &BroadcastDomainResource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "network_broadcast_domain",
+		},
+	}
+}
+
+// BroadcastDomainResource defines the resource implementation.
+type BroadcastDomainResource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// BroadcastDomainResourceModel describes the resource data model.
+type BroadcastDomainResourceModel struct {
+	CxProfileName types.String   `tfsdk:"cx_profile_name"`
+	Name          types.String   `tfsdk:"name"`
+	IPspace       types.String   `tfsdk:"ipspace"`
+	MTU           types.Int64    `tfsdk:"mtu"`
+	Ports         []types.String `tfsdk:"ports"`
+	UUID          types.String   `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *BroadcastDomainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.Name
+}
+
+// Schema defines the schema for the resource.
+func (r *BroadcastDomainResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "BroadcastDomain resource",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Broadcast domain name, renamed in place",
+				Required:            true,
+			},
+			"ipspace": schema.StringAttribute{
+				MarkdownDescription: "IPspace the broadcast domain belongs to",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("Default"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mtu": schema.Int64Attribute{
+				MarkdownDescription: "Maximum transmission unit of the ports in the broadcast domain, in bytes",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(68, 9000),
+				},
+			},
+			"ports": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Ports in the broadcast domain, in the node:port form, for instance ontap_cluster_1-01:e0c or ontap_cluster_1-01:a0a-100",
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(regexp.MustCompile(`^[^:]+:[^:]+$`), "must be in the node:port form")),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Broadcast domain UUID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *BroadcastDomainResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *BroadcastDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BroadcastDomainResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetNetworkBroadcastDomainByName(errorHandler, *client, data.Name.ValueString(), data.IPspace.ValueString())
+	if err != nil {
+		// error reporting done inside GetNetworkBroadcastDomainByName
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No broadcast domain %s found in ipspace %s", data.Name.ValueString(), data.IPspace.ValueString()))
+		return
+	}
+
+	data.Name = types.StringValue(restInfo.Name)
+	data.IPspace = types.StringValue(restInfo.IPspace.Name)
+	data.MTU = types.Int64Value(restInfo.MTU)
+	data.UUID = types.StringValue(restInfo.UUID)
+	// ports are only tracked when they are managed by this resource
+	if data.Ports != nil {
+		data.Ports = portReferencesToTypes(restInfo.Ports)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a resource and retrieve UUID
+func (r *BroadcastDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *BroadcastDomainResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	body := interfaces.NetworkBroadcastDomainResourceBodyDataModelONTAP{
+		Name:    data.Name.ValueString(),
+		IPspace: &interfaces.NetworkIPspaceResourceBodyDataModelONTAP{Name: data.IPspace.ValueString()},
+		MTU:     data.MTU.ValueInt64(),
+	}
+	broadcastDomain, err := interfaces.CreateNetworkBroadcastDomain(errorHandler, *client, body)
+	if err != nil {
+		return
+	}
+	data.UUID = types.StringValue(broadcastDomain.UUID)
+
+	// ONTAP does not take ports on create, each port joins the broadcast domain on its own
+	for _, port := range data.Ports {
+		if err := addPortToBroadcastDomain(errorHandler, *client, port.ValueString(), data.Name.ValueString(), data.IPspace.ValueString()); err != nil {
+			// save the broadcast domain so that it can be fixed or destroyed
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created a resource, UUID=%s", data.UUID))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *BroadcastDomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *BroadcastDomainResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, plan.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var body interfaces.NetworkBroadcastDomainResourceBodyDataModelONTAP
+	if !plan.Name.Equal(state.Name) {
+		body.Name = plan.Name.ValueString()
+	}
+	if !plan.MTU.Equal(state.MTU) {
+		body.MTU = plan.MTU.ValueInt64()
+	}
+	if body.Name != "" || body.MTU != 0 {
+		err = interfaces.UpdateNetworkBroadcastDomain(errorHandler, *client, state.UUID.ValueString(), body)
+		if err != nil {
+			return
+		}
+	}
+
+	current := make(map[string]bool, len(state.Ports))
+	for _, port := range state.Ports {
+		current[port.ValueString()] = true
+	}
+	planned := make(map[string]bool, len(plan.Ports))
+	for _, port := range plan.Ports {
+		planned[port.ValueString()] = true
+		if !current[port.ValueString()] {
+			if err := addPortToBroadcastDomain(errorHandler, *client, port.ValueString(), plan.Name.ValueString(), plan.IPspace.ValueString()); err != nil {
+				return
+			}
+		}
+	}
+	// ONTAP has no way to take a port out of its broadcast domain other than adding it to another one
+	for port := range current {
+		if !planned[port] {
+			tflog.Warn(ctx, fmt.Sprintf("port %s is no longer managed by broadcast domain %s, add it to another broadcast domain to move it", port, plan.Name.ValueString()))
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *BroadcastDomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *BroadcastDomainResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if data.UUID.IsNull() {
+		errorHandler.MakeAndReportError("UUID is null", "network_broadcast_domain UUID is null")
+		return
+	}
+
+	err = interfaces.DeleteNetworkBroadcastDomain(errorHandler, *client, data.UUID.ValueString())
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *BroadcastDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, fmt.Sprintf("import req a network broadcast domain resource: %#v", req))
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: name,ipspace,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ipspace"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
+}
+
+// addPortToBroadcastDomain moves a node:port into a broadcast domain
+func addPortToBroadcastDomain(errorHandler *utils.ErrorHandler, r restclient.RestClient, nodePort string, name string, ipspace string) error {
+	nodeName, portName, _ := strings.Cut(nodePort, ":")
+	port, err := interfaces.GetNetworkEthernetPortByName(errorHandler, r, nodeName, portName)
+	if err != nil {
+		return err
+	}
+	if port == nil {
+		return errorHandler.MakeAndReportError("error adding port to broadcast domain", fmt.Sprintf("port %s not found on node %s", portName, nodeName))
+	}
+	body := interfaces.NetworkEthernetPortResourceBodyDataModelONTAP{
+		BroadcastDomain: &interfaces.NetworkEthernetPortBroadcastDomain{
+			Name:    name,
+			IPspace: interfaces.NetworkIPspaceResourceBodyDataModelONTAP{Name: ipspace},
+		},
+	}
+	return interfaces.UpdateNetworkEthernetPort(errorHandler, r, port.UUID, body)
+}
+
+// portReferencesToTypes returns ports in the node:port form
+func portReferencesToTypes(ports []interfaces.NetworkPortReference) []types.String {
+	result := make([]types.String, 0, len(ports))
+	for _, port := range ports {
+		result = append(result, types.StringValue(port.Node.Name+":"+port.Name))
+	}
+	return result
+}
@@ -0,0 +1,94 @@
+package networking_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	ntest "github.com/netapp/terraform-provider-netapp-ontap/internal/provider"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetworkBroadcastDomainResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { ntest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ntest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create a broadcast domain with a VLAN port in a new ipspace
+			{
+				Config: testAccNetworkBroadcastDomainResourceConfig(1500),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_network_broadcast_domain.example", "name", "acc_bd"),
+					resource.TestCheckResourceAttr("netapp-ontap_network_broadcast_domain.example", "ipspace", "acc_bd_ipspace"),
+					resource.TestCheckResourceAttr("netapp-ontap_network_broadcast_domain.example", "mtu", "1500"),
+					resource.TestCheckResourceAttr("netapp-ontap_network_ethernet_port.vlan", "name", "e0c-3001"),
+					resource.TestCheckResourceAttr("netapp-ontap_network_ethernet_port.vlan", "broadcast_domain", "acc_bd"),
+				),
+			},
+			// Update the MTU in place, the port follows its broadcast domain
+			{
+				Config: testAccNetworkBroadcastDomainResourceConfig(1400),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_network_broadcast_domain.example", "mtu", "1400"),
+				),
+			},
+			// Test importing a resource
+			{
+				ResourceName:  "netapp-ontap_network_broadcast_domain.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s,%s", "acc_bd", "acc_bd_ipspace", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_network_broadcast_domain.example", "name", "acc_bd"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNetworkBroadcastDomainResourceConfig(mtu int) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_network_ipspace" "example" {
+  cx_profile_name = "cluster4"
+  name = "acc_bd_ipspace"
+}
+
+resource "netapp-ontap_network_broadcast_domain" "example" {
+  cx_profile_name = "cluster4"
+  name = "acc_bd"
+  ipspace = netapp-ontap_network_ipspace.example.name
+  mtu = %d
+}
+
+resource "netapp-ontap_network_ethernet_port" "vlan" {
+  cx_profile_name = "cluster4"
+  node = "ontap_cluster_1-01"
+  type = "vlan"
+  vlan = {
+    tag = 3001
+    base_port = "e0c"
+  }
+  broadcast_domain = netapp-ontap_network_broadcast_domain.example.name
+  ipspace = netapp-ontap_network_ipspace.example.name
+}
+`, host, admin, password, mtu)
+}
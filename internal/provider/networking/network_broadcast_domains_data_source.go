@@ -0,0 +1,176 @@
+package networking
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &BroadcastDomainsDataSource{}
+
+// NewBroadcastDomainsDataSource is a helper function to simplify the provider implementation.
+func NewBroadcastDomainsDataSource() datasource.DataSource {
+	return &BroadcastDomainsDataSource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "network_broadcast_domains",
+		},
+	}
+}
+
+// BroadcastDomainsDataSource defines the data source implementation.
+type BroadcastDomainsDataSource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// BroadcastDomainsDataSourceModel describes the data source data model.
+type BroadcastDomainsDataSourceModel struct {
+	CxProfileName    types.String                          `tfsdk:"cx_profile_name"`
+	BroadcastDomains []BroadcastDomainDataSourceModel      `tfsdk:"broadcast_domains"`
+	Filter           *BroadcastDomainDataSourceFilterModel `tfsdk:"filter"`
+}
+
+// BroadcastDomainDataSourceFilterModel describes the data source data model for queries.
+type BroadcastDomainDataSourceFilterModel struct {
+	Name    types.String `tfsdk:"name"`
+	IPspace types.String `tfsdk:"ipspace"`
+}
+
+// Metadata returns the data source type name.
+func (d *BroadcastDomainsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.Name
+}
+
+// Schema defines the schema for the data source.
+func (d *BroadcastDomainsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "BroadcastDomains data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Broadcast domain name, wildcards are supported",
+						Optional:            true,
+					},
+					"ipspace": schema.StringAttribute{
+						MarkdownDescription: "IPspace name",
+						Optional:            true,
+					},
+				},
+				Optional: true,
+			},
+			"broadcast_domains": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cx_profile_name": schema.StringAttribute{
+							MarkdownDescription: "Connection profile name",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Broadcast domain name",
+							Computed:            true,
+						},
+						"ipspace": schema.StringAttribute{
+							MarkdownDescription: "IPspace the broadcast domain belongs to",
+							Computed:            true,
+						},
+						"mtu": schema.Int64Attribute{
+							MarkdownDescription: "Maximum transmission unit of the ports in the broadcast domain, in bytes",
+							Computed:            true,
+						},
+						"ports": schema.SetAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "Ports in the broadcast domain, in the node:port form",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Broadcast domain UUID",
+							Computed:            true,
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *BroadcastDomainsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *BroadcastDomainsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BroadcastDomainsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var filter *interfaces.NetworkBroadcastDomainDataSourceFilterModel = nil
+	if data.Filter != nil {
+		filter = &interfaces.NetworkBroadcastDomainDataSourceFilterModel{
+			Name:        data.Filter.Name.ValueString(),
+			IPspaceName: data.Filter.IPspace.ValueString(),
+		}
+	}
+	restInfo, err := interfaces.GetNetworkBroadcastDomains(errorHandler, *client, filter)
+	if err != nil {
+		// error reporting done inside GetNetworkBroadcastDomains
+		return
+	}
+
+	data.BroadcastDomains = make([]BroadcastDomainDataSourceModel, len(restInfo))
+	for index, record := range restInfo {
+		data.BroadcastDomains[index] = BroadcastDomainDataSourceModel{
+			CxProfileName: data.CxProfileName,
+			Name:          types.StringValue(record.Name),
+			IPspace:       types.StringValue(record.IPspace.Name),
+			MTU:           types.Int64Value(record.MTU),
+			Ports:         portReferencesToTypes(record.Ports),
+			UUID:          types.StringValue(record.UUID),
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,501 @@
+package networking
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &EthernetPortResource{}
+var _ resource.ResourceWithImportState = &EthernetPortResource{}
+
+// NewEthernetPortResource is a helper function to simplify the provider implementation.
+func NewEthernetPortResource() resource.Resource {
+	return &EthernetPortResource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "network_ethernet_port",
+		},
+	}
+}
+
+// EthernetPortResource defines the resource implementation.
+type EthernetPortResource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// EthernetPortResourceModel describes the resource data model.
+type EthernetPortResourceModel struct {
+	CxProfileName   types.String           `tfsdk:"cx_profile_name"`
+	Node            types.String           `tfsdk:"node"`
+	Type            types.String           `tfsdk:"type"`
+	Vlan            *EthernetPortVlanModel `tfsdk:"vlan"`
+	Lag             *EthernetPortLagModel  `tfsdk:"lag"`
+	BroadcastDomain types.String           `tfsdk:"broadcast_domain"`
+	IPspace         types.String           `tfsdk:"ipspace"`
+	Enabled         types.Bool             `tfsdk:"enabled"`
+	Name            types.String           `tfsdk:"name"`
+	MTU             types.Int64            `tfsdk:"mtu"`
+	UUID            types.String           `tfsdk:"id"`
+}
+
+// EthernetPortVlanModel describes the VLAN tag and the port it is created on.
+type EthernetPortVlanModel struct {
+	Tag      types.Int64  `tfsdk:"tag"`
+	BasePort types.String `tfsdk:"base_port"`
+}
+
+// EthernetPortLagModel describes the link aggregation group.
+type EthernetPortLagModel struct {
+	Mode               types.String   `tfsdk:"mode"`
+	DistributionPolicy types.String   `tfsdk:"distribution_policy"`
+	MemberPorts        []types.String `tfsdk:"member_ports"`
+}
+
+// Metadata returns the resource type name.
+func (r *EthernetPortResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.Name
+}
+
+// Schema defines the schema for the resource.
+func (r *EthernetPortResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "EthernetPort resource, to create VLAN and link aggregation group ports",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Node the port is created on",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the port, vlan or lag",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("vlan", "lag"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vlan": schema.SingleNestedAttribute{
+				MarkdownDescription: "VLAN settings, required when type is vlan",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"tag": schema.Int64Attribute{
+						MarkdownDescription: "VLAN tag",
+						Required:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 4094),
+						},
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.RequiresReplace(),
+						},
+					},
+					"base_port": schema.StringAttribute{
+						MarkdownDescription: "Physical or lag port the VLAN is created on, for instance e0c or a0a",
+						Required:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+				},
+			},
+			"lag": schema.SingleNestedAttribute{
+				MarkdownDescription: "Link aggregation group settings, required when type is lag",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"mode": schema.StringAttribute{
+						MarkdownDescription: "Policy for mapping ports to the group",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("singlemode", "multimode", "multimode_lacp"),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"distribution_policy": schema.StringAttribute{
+						MarkdownDescription: "Policy for mapping flows to ports",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("port", "ip", "mac", "sequential"),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"member_ports": schema.SetAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "Physical ports of the node in the group, for instance e0c and e0d",
+						Required:            true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+						},
+					},
+				},
+			},
+			"broadcast_domain": schema.StringAttribute{
+				MarkdownDescription: "Broadcast domain of the port",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("ipspace")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ipspace": schema.StringAttribute{
+				MarkdownDescription: "IPspace of the broadcast domain",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("broadcast_domain")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the port is administratively up",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Port name assigned by ONTAP, for instance e0c-100 or a0a",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"mtu": schema.Int64Attribute{
+				MarkdownDescription: "Maximum transmission unit of the port, set by its broadcast domain",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Port UUID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *EthernetPortResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *EthernetPortResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data EthernetPortResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var restInfo *interfaces.NetworkEthernetPortGetDataModelONTAP
+	if data.UUID.IsNull() {
+		restInfo, err = interfaces.GetNetworkEthernetPortByName(errorHandler, *client, data.Node.ValueString(), data.Name.ValueString())
+	} else {
+		restInfo, err = interfaces.GetNetworkEthernetPort(errorHandler, *client, data.UUID.ValueString())
+	}
+	if err != nil {
+		// error reporting done inside GetNetworkEthernetPort
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No port %s found on node %s", data.Name.ValueString(), data.Node.ValueString()))
+		return
+	}
+	flattenEthernetPort(&data, restInfo)
+
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a resource and retrieve UUID
+func (r *EthernetPortResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *EthernetPortResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	node := map[string]string{"name": data.Node.ValueString()}
+	body := interfaces.NetworkEthernetPortResourceBodyDataModelONTAP{
+		Type:    data.Type.ValueString(),
+		Node:    node,
+		Enabled: data.Enabled.ValueBoolPointer(),
+	}
+	switch data.Type.ValueString() {
+	case "vlan":
+		if data.Vlan == nil || data.Lag != nil {
+			errorHandler.MakeAndReportError("error creating network_ethernet_port", "vlan is required and lag is not allowed when type is vlan")
+			return
+		}
+		body.Vlan = &interfaces.NetworkEthernetPortVlanBody{
+			Tag:      data.Vlan.Tag.ValueInt64(),
+			BasePort: interfaces.NetworkPortReferenceBody{Name: data.Vlan.BasePort.ValueString(), Node: node},
+		}
+	case "lag":
+		if data.Lag == nil || data.Vlan != nil {
+			errorHandler.MakeAndReportError("error creating network_ethernet_port", "lag is required and vlan is not allowed when type is lag")
+			return
+		}
+		body.Lag = &interfaces.NetworkEthernetPortLagBody{
+			Mode:               data.Lag.Mode.ValueString(),
+			DistributionPolicy: data.Lag.DistributionPolicy.ValueString(),
+			MemberPorts:        expandLagMemberPorts(data.Lag.MemberPorts, node),
+		}
+	}
+	if !data.BroadcastDomain.IsUnknown() && !data.BroadcastDomain.IsNull() {
+		body.BroadcastDomain = &interfaces.NetworkEthernetPortBroadcastDomain{
+			Name:    data.BroadcastDomain.ValueString(),
+			IPspace: interfaces.NetworkIPspaceResourceBodyDataModelONTAP{Name: data.IPspace.ValueString()},
+		}
+	}
+
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	port, err := interfaces.CreateNetworkEthernetPort(errorHandler, *client, body)
+	if err != nil {
+		return
+	}
+	data.UUID = types.StringValue(port.UUID)
+
+	// read back the name assigned by ONTAP and the MTU inherited from the broadcast domain
+	restInfo, err := interfaces.GetNetworkEthernetPort(errorHandler, *client, data.UUID.ValueString())
+	if err != nil {
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No port found with UUID %s after create", data.UUID.ValueString()))
+		return
+	}
+	flattenEthernetPort(data, restInfo)
+
+	tflog.Trace(ctx, fmt.Sprintf("created a resource, UUID=%s", data.UUID))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *EthernetPortResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *EthernetPortResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, plan.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var body interfaces.NetworkEthernetPortResourceBodyDataModelONTAP
+	modified := false
+	if !plan.Enabled.Equal(state.Enabled) {
+		body.Enabled = plan.Enabled.ValueBoolPointer()
+		modified = true
+	}
+	if !plan.BroadcastDomain.IsUnknown() && (!plan.BroadcastDomain.Equal(state.BroadcastDomain) || !plan.IPspace.Equal(state.IPspace)) {
+		body.BroadcastDomain = &interfaces.NetworkEthernetPortBroadcastDomain{
+			Name:    plan.BroadcastDomain.ValueString(),
+			IPspace: interfaces.NetworkIPspaceResourceBodyDataModelONTAP{Name: plan.IPspace.ValueString()},
+		}
+		modified = true
+	}
+	if plan.Lag != nil && state.Lag != nil && !stringSetsEqual(plan.Lag.MemberPorts, state.Lag.MemberPorts) {
+		body.Lag = &interfaces.NetworkEthernetPortLagBody{
+			MemberPorts: expandLagMemberPorts(plan.Lag.MemberPorts, map[string]string{"name": plan.Node.ValueString()}),
+		}
+		modified = true
+	}
+	if modified {
+		err = interfaces.UpdateNetworkEthernetPort(errorHandler, *client, state.UUID.ValueString(), body)
+		if err != nil {
+			return
+		}
+	}
+
+	restInfo, err := interfaces.GetNetworkEthernetPort(errorHandler, *client, state.UUID.ValueString())
+	if err != nil {
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No port found with UUID %s after update", state.UUID.ValueString()))
+		return
+	}
+	flattenEthernetPort(plan, restInfo)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *EthernetPortResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *EthernetPortResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if data.UUID.IsNull() {
+		errorHandler.MakeAndReportError("UUID is null", "network_ethernet_port UUID is null")
+		return
+	}
+
+	err = interfaces.DeleteNetworkEthernetPort(errorHandler, *client, data.UUID.ValueString())
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *EthernetPortResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, fmt.Sprintf("import req a network ethernet port resource: %#v", req))
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: node,name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("node"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
+}
+
+// flattenEthernetPort sets the state from the ONTAP record
+func flattenEthernetPort(data *EthernetPortResourceModel, restInfo *interfaces.NetworkEthernetPortGetDataModelONTAP) {
+	data.Name = types.StringValue(restInfo.Name)
+	data.UUID = types.StringValue(restInfo.UUID)
+	data.Node = types.StringValue(restInfo.Node.Name)
+	data.Type = types.StringValue(restInfo.Type)
+	data.Enabled = types.BoolValue(restInfo.Enabled)
+	data.MTU = types.Int64Value(restInfo.MTU)
+	data.BroadcastDomain = types.StringValue(restInfo.BroadcastDomain.Name)
+	data.IPspace = types.StringValue(restInfo.BroadcastDomain.IPspace.Name)
+	switch restInfo.Type {
+	case "vlan":
+		data.Vlan = &EthernetPortVlanModel{
+			Tag:      types.Int64Value(restInfo.Vlan.Tag),
+			BasePort: types.StringValue(restInfo.Vlan.BasePort.Name),
+		}
+	case "lag":
+		memberPorts := make([]types.String, 0, len(restInfo.Lag.MemberPorts))
+		for _, port := range restInfo.Lag.MemberPorts {
+			memberPorts = append(memberPorts, types.StringValue(port.Name))
+		}
+		data.Lag = &EthernetPortLagModel{
+			Mode:               types.StringValue(restInfo.Lag.Mode),
+			DistributionPolicy: types.StringValue(restInfo.Lag.DistributionPolicy),
+			MemberPorts:        memberPorts,
+		}
+	}
+}
+
+// expandLagMemberPorts returns the member ports body, all member ports are on the node of the group
+func expandLagMemberPorts(ports []types.String, node map[string]string) []interfaces.NetworkPortReferenceBody {
+	result := make([]interfaces.NetworkPortReferenceBody, 0, len(ports))
+	for _, port := range ports {
+		result = append(result, interfaces.NetworkPortReferenceBody{Name: port.ValueString(), Node: node})
+	}
+	return result
+}
+
+// stringSetsEqual compares two sets of strings regardless of order
+func stringSetsEqual(first []types.String, second []types.String) bool {
+	if len(first) != len(second) {
+		return false
+	}
+	values := make(map[string]bool, len(first))
+	for _, value := range first {
+		values[value.ValueString()] = true
+	}
+	for _, value := range second {
+		if !values[value.ValueString()] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,233 @@
+package networking
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &EthernetPortsDataSource{}
+
+// NewEthernetPortsDataSource is a helper function to simplify the provider implementation.
+func NewEthernetPortsDataSource() datasource.DataSource {
+	return &EthernetPortsDataSource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "network_ethernet_ports",
+		},
+	}
+}
+
+// EthernetPortsDataSource defines the data source implementation.
+type EthernetPortsDataSource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// EthernetPortsDataSourceModel describes the data source data model.
+type EthernetPortsDataSourceModel struct {
+	CxProfileName types.String                       `tfsdk:"cx_profile_name"`
+	EthernetPorts []EthernetPortDataSourceModel      `tfsdk:"ethernet_ports"`
+	Filter        *EthernetPortDataSourceFilterModel `tfsdk:"filter"`
+}
+
+// EthernetPortDataSourceFilterModel describes the data source data model for queries.
+type EthernetPortDataSourceFilterModel struct {
+	Name            types.String `tfsdk:"name"`
+	Node            types.String `tfsdk:"node"`
+	Type            types.String `tfsdk:"type"`
+	BroadcastDomain types.String `tfsdk:"broadcast_domain"`
+}
+
+// Metadata returns the data source type name.
+func (d *EthernetPortsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.Name
+}
+
+// Schema defines the schema for the data source.
+func (d *EthernetPortsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "EthernetPorts data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Port name, wildcards are supported",
+						Optional:            true,
+					},
+					"node": schema.StringAttribute{
+						MarkdownDescription: "Node name",
+						Optional:            true,
+					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Type of the port, physical, vlan or lag",
+						Optional:            true,
+					},
+					"broadcast_domain": schema.StringAttribute{
+						MarkdownDescription: "Broadcast domain name",
+						Optional:            true,
+					},
+				},
+				Optional: true,
+			},
+			"ethernet_ports": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cx_profile_name": schema.StringAttribute{
+							MarkdownDescription: "Connection profile name",
+							Computed:            true,
+						},
+						"node": schema.StringAttribute{
+							MarkdownDescription: "Node of the port",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Port name",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the port, physical, vlan or lag",
+							Computed:            true,
+						},
+						"broadcast_domain": schema.StringAttribute{
+							MarkdownDescription: "Broadcast domain of the port",
+							Computed:            true,
+						},
+						"ipspace": schema.StringAttribute{
+							MarkdownDescription: "IPspace of the broadcast domain",
+							Computed:            true,
+						},
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the port is administratively up",
+							Computed:            true,
+						},
+						"mtu": schema.Int64Attribute{
+							MarkdownDescription: "Maximum transmission unit of the port, in bytes",
+							Computed:            true,
+						},
+						"speed": schema.Int64Attribute{
+							MarkdownDescription: "Link speed, in Mbps",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "Operational state of the port, up or down",
+							Computed:            true,
+						},
+						"vlan": schema.SingleNestedAttribute{
+							MarkdownDescription: "VLAN settings of a vlan port",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"tag": schema.Int64Attribute{
+									MarkdownDescription: "VLAN tag",
+									Computed:            true,
+								},
+								"base_port": schema.StringAttribute{
+									MarkdownDescription: "Port the VLAN is created on",
+									Computed:            true,
+								},
+							},
+						},
+						"lag": schema.SingleNestedAttribute{
+							MarkdownDescription: "Link aggregation group settings of a lag port",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"mode": schema.StringAttribute{
+									MarkdownDescription: "Policy for mapping ports to the group",
+									Computed:            true,
+								},
+								"distribution_policy": schema.StringAttribute{
+									MarkdownDescription: "Policy for mapping flows to ports",
+									Computed:            true,
+								},
+								"member_ports": schema.SetAttribute{
+									ElementType:         types.StringType,
+									MarkdownDescription: "Ports in the group",
+									Computed:            true,
+								},
+							},
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Port UUID",
+							Computed:            true,
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *EthernetPortsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *EthernetPortsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EthernetPortsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var filter *interfaces.NetworkEthernetPortDataSourceFilterModel = nil
+	if data.Filter != nil {
+		filter = &interfaces.NetworkEthernetPortDataSourceFilterModel{
+			Name:                data.Filter.Name.ValueString(),
+			NodeName:            data.Filter.Node.ValueString(),
+			Type:                data.Filter.Type.ValueString(),
+			BroadcastDomainName: data.Filter.BroadcastDomain.ValueString(),
+		}
+	}
+	restInfo, err := interfaces.GetNetworkEthernetPorts(errorHandler, *client, filter)
+	if err != nil {
+		// error reporting done inside GetNetworkEthernetPorts
+		return
+	}
+
+	data.EthernetPorts = make([]EthernetPortDataSourceModel, len(restInfo))
+	for index := range restInfo {
+		data.EthernetPorts[index] = flattenEthernetPortDataSource(data.CxProfileName, &restInfo[index])
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
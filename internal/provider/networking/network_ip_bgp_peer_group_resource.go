@@ -0,0 +1,355 @@
+package networking
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &IPBgpPeerGroupResource{}
+var _ resource.ResourceWithImportState = &IPBgpPeerGroupResource{}
+
+// NewIPBgpPeerGroupResource is a helper function to simplify the provider implementation.
+func NewIPBgpPeerGroupResource() resource.Resource {
+	return &IPBgpPeerGroupResource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "network_ip_bgp_peer_group",
+		},
+	}
+}
+
+// IPBgpPeerGroupResource defines the resource implementation.
+type IPBgpPeerGroupResource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// IPBgpPeerGroupResourceModel describes the resource data model.
+type IPBgpPeerGroupResourceModel struct {
+	CxProfileName  types.String                     `tfsdk:"cx_profile_name"`
+	Name           types.String                     `tfsdk:"name"`
+	IPspace        types.String                     `tfsdk:"ipspace"`
+	LocalInterface types.String                     `tfsdk:"local_interface"`
+	Peer           *IPBgpPeerGroupPeerResourceModel `tfsdk:"peer"`
+	State          types.String                     `tfsdk:"state"`
+	UUID           types.String                     `tfsdk:"id"`
+}
+
+// IPBgpPeerGroupPeerResourceModel describes the BGP router the peer group connects to.
+type IPBgpPeerGroupPeerResourceModel struct {
+	Address   types.String `tfsdk:"address"`
+	Asn       types.Int64  `tfsdk:"asn"`
+	IsNextHop types.Bool   `tfsdk:"is_next_hop"`
+}
+
+// Metadata returns the resource type name.
+func (r *IPBgpPeerGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.Name
+}
+
+// Schema defines the schema for the resource.
+func (r *IPBgpPeerGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "IP BGP peer group resource",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "BGP peer group name, renamed in place",
+				Required:            true,
+			},
+			"ipspace": schema.StringAttribute{
+				MarkdownDescription: "IPspace the peer group belongs to, defaults to Default. Changing it recreates the peer group",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("Default"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"local_interface": schema.StringAttribute{
+				MarkdownDescription: "Name of the BGP interface the session is established from, an interface of the ipspace with the default-route-announce service. Changing it recreates the peer group",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"peer": schema.SingleNestedAttribute{
+				MarkdownDescription: "BGP router the VIP addresses are announced to",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"address": schema.StringAttribute{
+						MarkdownDescription: "IP address of the router, updated in place",
+						Required:            true,
+					},
+					"asn": schema.Int64Attribute{
+						MarkdownDescription: "Autonomous system number of the router. Changing it recreates the peer group",
+						Required:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 4294967295),
+						},
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.RequiresReplace(),
+						},
+					},
+					"is_next_hop": schema.BoolAttribute{
+						MarkdownDescription: "Whether the router is used as the next hop of the VIP routes. Changing it recreates the peer group",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.RequiresReplace(),
+						},
+					},
+				},
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "State of the BGP session, up or down",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "BGP peer group UUID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *IPBgpPeerGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *IPBgpPeerGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IPBgpPeerGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetNetworkIPBgpPeerGroupByName(errorHandler, *client, data.Name.ValueString(), data.IPspace.ValueString())
+	if err != nil {
+		// error reporting done inside GetNetworkIPBgpPeerGroupByName
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No BGP peer group %s found in ipspace %s", data.Name.ValueString(), data.IPspace.ValueString()))
+		return
+	}
+
+	flattenIPBgpPeerGroup(&data, restInfo)
+
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a resource and retrieve UUID
+func (r *IPBgpPeerGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *IPBgpPeerGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	body := interfaces.NetworkIPBgpPeerGroupResourceBodyDataModelONTAP{
+		Name:    data.Name.ValueString(),
+		IPspace: &interfaces.NetworkIPspaceResourceBodyDataModelONTAP{Name: data.IPspace.ValueString()},
+		Local: &interfaces.NetworkIPBgpPeerGroupLocal{
+			Interface: interfaces.BgpPeerGroupInterface{Name: data.LocalInterface.ValueString()},
+		},
+		Peer: &interfaces.NetworkIPBgpPeerGroupPeer{
+			Address:   data.Peer.Address.ValueString(),
+			Asn:       data.Peer.Asn.ValueInt64(),
+			IsNextHop: data.Peer.IsNextHop.ValueBoolPointer(),
+		},
+	}
+	_, err = interfaces.CreateNetworkIPBgpPeerGroup(errorHandler, *client, body)
+	if err != nil {
+		return
+	}
+
+	// read back the session state
+	restInfo, err := interfaces.GetNetworkIPBgpPeerGroupByName(errorHandler, *client, data.Name.ValueString(), data.IPspace.ValueString())
+	if err != nil {
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No BGP peer group %s found after creation", data.Name.ValueString()))
+		return
+	}
+	data.UUID = types.StringValue(restInfo.UUID)
+	data.State = types.StringValue(restInfo.State)
+
+	tflog.Trace(ctx, fmt.Sprintf("created a resource, UUID=%s", data.UUID))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update renames the peer group or changes the address of the peer.
+func (r *IPBgpPeerGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *IPBgpPeerGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, plan.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var body interfaces.NetworkIPBgpPeerGroupResourceBodyDataModelONTAP
+	if !plan.Name.Equal(state.Name) {
+		body.Name = plan.Name.ValueString()
+	}
+	if !plan.Peer.Address.Equal(state.Peer.Address) {
+		body.Peer = &interfaces.NetworkIPBgpPeerGroupPeer{Address: plan.Peer.Address.ValueString()}
+	}
+	if body.Name != "" || body.Peer != nil {
+		err = interfaces.UpdateNetworkIPBgpPeerGroup(errorHandler, *client, state.UUID.ValueString(), body)
+		if err != nil {
+			return
+		}
+	}
+
+	restInfo, err := interfaces.GetNetworkIPBgpPeerGroupByName(errorHandler, *client, plan.Name.ValueString(), plan.IPspace.ValueString())
+	if err != nil {
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No BGP peer group %s found after update", plan.Name.ValueString()))
+		return
+	}
+	plan.State = types.StringValue(restInfo.State)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *IPBgpPeerGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *IPBgpPeerGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if data.UUID.IsNull() {
+		errorHandler.MakeAndReportError("UUID is null", "network_ip_bgp_peer_group UUID is null")
+		return
+	}
+
+	err = interfaces.DeleteNetworkIPBgpPeerGroup(errorHandler, *client, data.UUID.ValueString())
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *IPBgpPeerGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, fmt.Sprintf("import req a network ip bgp peer group resource: %#v", req))
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: name,ipspace,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ipspace"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
+}
+
+func flattenIPBgpPeerGroup(data *IPBgpPeerGroupResourceModel, restInfo *interfaces.NetworkIPBgpPeerGroupGetDataModelONTAP) {
+	data.Name = types.StringValue(restInfo.Name)
+	data.UUID = types.StringValue(restInfo.UUID)
+	data.IPspace = types.StringValue(restInfo.IPspace.Name)
+	data.LocalInterface = types.StringValue(restInfo.Local.Interface.Name)
+	var peer IPBgpPeerGroupPeerResourceModel
+	peer.Address = types.StringValue(restInfo.Peer.Address)
+	// ONTAP returns IPv6 addresses in their canonical form, keep the configured spelling when it is the same address
+	if data.Peer != nil && sameIPAddress(data.Peer.Address.ValueString(), restInfo.Peer.Address) {
+		peer.Address = data.Peer.Address
+	}
+	peer.Asn = types.Int64Value(restInfo.Peer.Asn)
+	peer.IsNextHop = types.BoolValue(restInfo.Peer.IsNextHop != nil && *restInfo.Peer.IsNextHop)
+	data.Peer = &peer
+	data.State = types.StringValue(restInfo.State)
+}
@@ -0,0 +1,103 @@
+package networking_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	ntest "github.com/netapp/terraform-provider-netapp-ontap/internal/provider"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetworkIPBgpPeerGroupResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { ntest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ntest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read, the session stays down without a router at the peer address
+			{
+				Config: testAccNetworkIPBgpPeerGroupResourceConfig("acc_bgp_group", "10.10.30.1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_network_ip_bgp_peer_group.example", "name", "acc_bgp_group"),
+					resource.TestCheckResourceAttr("netapp-ontap_network_ip_bgp_peer_group.example", "local_interface", "acc_bgp_lif"),
+					resource.TestCheckResourceAttr("netapp-ontap_network_ip_bgp_peer_group.example", "peer.asn", "65501"),
+					resource.TestCheckResourceAttrSet("netapp-ontap_network_ip_bgp_peer_group.example", "id"),
+				),
+			},
+			// Rename and change the peer address in place
+			{
+				Config: testAccNetworkIPBgpPeerGroupResourceConfig("acc_bgp_group_renamed", "10.10.30.2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_network_ip_bgp_peer_group.example", "name", "acc_bgp_group_renamed"),
+					resource.TestCheckResourceAttr("netapp-ontap_network_ip_bgp_peer_group.example", "peer.address", "10.10.30.2"),
+				),
+			},
+			// Test importing a resource
+			{
+				ResourceName:  "netapp-ontap_network_ip_bgp_peer_group.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s,%s", "acc_bgp_group_renamed", "Default", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_network_ip_bgp_peer_group.example", "name", "acc_bgp_group_renamed"),
+				),
+			},
+			// Import with a bad id
+			{
+				ResourceName:  "netapp-ontap_network_ip_bgp_peer_group.example",
+				ImportState:   true,
+				ImportStateId: "acc_bgp_group_renamed,cluster4",
+				ExpectError:   regexp.MustCompile("Unexpected Import Identifier"),
+			},
+		},
+	})
+}
+
+func testAccNetworkIPBgpPeerGroupResourceConfig(name string, peerAddress string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_network_ip_interface" "bgp_lif" {
+  cx_profile_name = "cluster4"
+  name = "acc_bgp_lif"
+  svm_name = "ontap_cluster_1"
+  ip = {
+    address = "10.10.30.10"
+    netmask = 24
+  }
+  location = {
+    home_port = "e0d"
+    home_node = "ontap_cluster_1-01"
+  }
+  service_policy = "default-route-announce"
+}
+
+resource "netapp-ontap_network_ip_bgp_peer_group" "example" {
+  cx_profile_name = "cluster4"
+  name = "%s"
+  local_interface = netapp-ontap_network_ip_interface.bgp_lif.name
+  peer = {
+    address = "%s"
+    asn = 65501
+  }
+}
+`, host, admin, password, name, peerAddress)
+}
@@ -34,12 +34,17 @@ type IPInterfaceDataSource struct {
 
 // IPInterfaceDataSourceModel describes the data source data model.
 type IPInterfaceDataSourceModel struct {
-	CxProfileName types.String             `tfsdk:"cx_profile_name"`
-	Name          types.String             `tfsdk:"name"`
-	SVMName       types.String             `tfsdk:"svm_name"`
-	Scope         types.String             `tfsdk:"scope"`
-	IP            *IPDataSourceModel       `tfsdk:"ip"`
-	Location      *LocationDataSourceModel `tfsdk:"location"`
+	CxProfileName types.String                          `tfsdk:"cx_profile_name"`
+	Name          types.String                          `tfsdk:"name"`
+	SVMName       types.String                          `tfsdk:"svm_name"`
+	Scope         types.String                          `tfsdk:"scope"`
+	ServicePolicy types.String                          `tfsdk:"service_policy"`
+	Enabled       types.Bool                            `tfsdk:"enabled"`
+	Vip           types.Bool                            `tfsdk:"vip"`
+	State         types.String                          `tfsdk:"state"`
+	Statistics    *IPInterfaceStatisticsDataSourceModel `tfsdk:"statistics"`
+	IP            *IPDataSourceModel                    `tfsdk:"ip"`
+	Location      *LocationDataSourceModel              `tfsdk:"location"`
 }
 
 // IPDataSourceModel describes the data source model for IP address and mask.
@@ -48,10 +53,30 @@ type IPDataSourceModel struct {
 	Netmask types.Int64  `tfsdk:"netmask"`
 }
 
-// LocationDataSourceModel describes the data source model for home node/port.
+// IPInterfaceStatisticsDataSourceModel describes the data source model for the throughput counters.
+type IPInterfaceStatisticsDataSourceModel struct {
+	Status        types.String                          `tfsdk:"status"`
+	Timestamp     types.String                          `tfsdk:"timestamp"`
+	ThroughputRaw *IPInterfaceThroughputDataSourceModel `tfsdk:"throughput_raw"`
+}
+
+// IPInterfaceThroughputDataSourceModel describes the data source model for the read, write and total bytes counters.
+type IPInterfaceThroughputDataSourceModel struct {
+	Read  types.Int64 `tfsdk:"read"`
+	Write types.Int64 `tfsdk:"write"`
+	Total types.Int64 `tfsdk:"total"`
+}
+
+// LocationDataSourceModel describes the data source model for home node/port, current node/port and failover.
 type LocationDataSourceModel struct {
-	HomeNode types.String `tfsdk:"home_node"`
-	HomePort types.String `tfsdk:"home_port"`
+	HomeNode        types.String `tfsdk:"home_node"`
+	HomePort        types.String `tfsdk:"home_port"`
+	BroadcastDomain types.String `tfsdk:"broadcast_domain"`
+	Failover        types.String `tfsdk:"failover"`
+	AutoRevert      types.Bool   `tfsdk:"auto_revert"`
+	Node            types.String `tfsdk:"node"`
+	Port            types.String `tfsdk:"port"`
+	IsHome          types.Bool   `tfsdk:"is_home"`
 }
 
 // Metadata returns the data source type name.
@@ -82,6 +107,54 @@ func (d *IPInterfaceDataSource) Schema(ctx context.Context, req datasource.Schem
 				Computed:            true,
 				MarkdownDescription: "IPInterface scope",
 			},
+			"service_policy": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "IPInterface service policy",
+			},
+			"enabled": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the interface is administratively up",
+			},
+			"vip": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the interface is a VIP interface",
+			},
+			"state": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Operational state of the interface, up or down",
+			},
+			"statistics": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"status": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Status of the counters, ok when they are valid",
+					},
+					"timestamp": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Time the counters were collected",
+					},
+					"throughput_raw": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"read": schema.Int64Attribute{
+								Computed:            true,
+								MarkdownDescription: "Bytes received since the counters were reset",
+							},
+							"write": schema.Int64Attribute{
+								Computed:            true,
+								MarkdownDescription: "Bytes sent since the counters were reset",
+							},
+							"total": schema.Int64Attribute{
+								Computed:            true,
+								MarkdownDescription: "Bytes received and sent since the counters were reset",
+							},
+						},
+						Computed:            true,
+						MarkdownDescription: "Throughput counters, in bytes",
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "Throughput counters of the interface, only available with ONTAP 9.8 or higher",
+			},
 			"ip": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					"address": schema.StringAttribute{
@@ -105,6 +178,30 @@ func (d *IPInterfaceDataSource) Schema(ctx context.Context, req datasource.Schem
 						Computed:            true,
 						MarkdownDescription: "IPInterface home port",
 					},
+					"broadcast_domain": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "IPInterface broadcast domain",
+					},
+					"failover": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "IPInterface failover policy",
+					},
+					"auto_revert": schema.BoolAttribute{
+						Computed:            true,
+						MarkdownDescription: "Whether the interface automatically reverts to its home port",
+					},
+					"node": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Node the interface currently runs on",
+					},
+					"port": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Port the interface currently runs on",
+					},
+					"is_home": schema.BoolAttribute{
+						Computed:            true,
+						MarkdownDescription: "Whether the interface currently runs on its home node and port",
+					},
 				},
 				Computed: true,
 			},
@@ -147,7 +244,17 @@ func (d *IPInterfaceDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	restInfo, err := interfaces.GetIPInterfaceByName(errorHandler, *client, data.Name.ValueString(), data.SVMName.ValueString())
+	cluster, err := interfaces.GetCluster(errorHandler, *client)
+	if err != nil {
+		// error reporting done inside GetCluster
+		return
+	}
+	if cluster == nil {
+		errorHandler.MakeAndReportError("No cluster found", "No Cluster found")
+		return
+	}
+
+	restInfo, err := interfaces.GetIPInterfaceByName(errorHandler, *client, data.Name.ValueString(), data.SVMName.ValueString(), &cluster.Version)
 	if err != nil {
 		// error reporting done inside GetIPInterface
 		return
@@ -160,6 +267,11 @@ func (d *IPInterfaceDataSource) Read(ctx context.Context, req datasource.ReadReq
 	data.Name = types.StringValue(restInfo.Name)
 	data.Scope = types.StringValue(restInfo.Scope)
 	data.SVMName = types.StringValue(restInfo.SVM.Name)
+	data.ServicePolicy = types.StringValue(restInfo.ServicePolicy.Name)
+	data.Enabled = types.BoolValue(restInfo.Enabled)
+	data.Vip = types.BoolValue(restInfo.Vip)
+	data.State = types.StringValue(restInfo.State)
+	data.Statistics = flattenIPInterfaceStatistics(restInfo.Statistics)
 	intNetmask, err := strconv.Atoi(restInfo.IP.Netmask)
 	if err != nil {
 		errorHandler.MakeAndReportError("Failed to read ip interface", fmt.Sprintf("Error: failed to convert string value '%s' to int for net mask.", restInfo.IP.Netmask))
@@ -170,8 +282,14 @@ func (d *IPInterfaceDataSource) Read(ctx context.Context, req datasource.ReadReq
 		Netmask: types.Int64Value(int64(intNetmask)),
 	}
 	data.Location = &LocationDataSourceModel{
-		HomeNode: types.StringValue(restInfo.Location.HomeNode.Name),
-		HomePort: types.StringValue(restInfo.Location.HomePort.Name),
+		HomeNode:        types.StringValue(restInfo.Location.HomeNode.Name),
+		HomePort:        types.StringValue(restInfo.Location.HomePort.Name),
+		BroadcastDomain: types.StringValue(restInfo.Location.BroadcastDomain.Name),
+		Failover:        types.StringValue(restInfo.Location.Failover),
+		AutoRevert:      types.BoolValue(restInfo.Location.AutoRevert),
+		Node:            types.StringValue(restInfo.Location.Node.Name),
+		Port:            types.StringValue(restInfo.Location.Port.Name),
+		IsHome:          types.BoolValue(restInfo.Location.IsHome),
 	}
 
 	// Write logs using the tflog package
@@ -181,3 +299,19 @@ func (d *IPInterfaceDataSource) Read(ctx context.Context, req datasource.ReadReq
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// flattenIPInterfaceStatistics returns nil when the counters were not read, before 9.8
+func flattenIPInterfaceStatistics(statistics interfaces.IPInterfaceStatistics) *IPInterfaceStatisticsDataSourceModel {
+	if statistics.Timestamp == "" && statistics.Status == "" {
+		return nil
+	}
+	return &IPInterfaceStatisticsDataSourceModel{
+		Status:    types.StringValue(statistics.Status),
+		Timestamp: types.StringValue(statistics.Timestamp),
+		ThroughputRaw: &IPInterfaceThroughputDataSourceModel{
+			Read:  types.Int64Value(statistics.ThroughputRaw.Read),
+			Write: types.Int64Value(statistics.ThroughputRaw.Write),
+			Total: types.Int64Value(statistics.ThroughputRaw.Total),
+		},
+	}
+}
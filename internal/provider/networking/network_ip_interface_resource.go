@@ -3,25 +3,46 @@ package networking
 import (
 	"context"
 	"fmt"
+	"net"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
 )
 
+// ipInterfaceMoveTimeout is how long to wait for an interface to be up after a migrate or a revert.
+const ipInterfaceMoveTimeout = 120 * time.Second
+
+// ipInterfaceMovePollInterval is how often the interface location and state are checked.
+const ipInterfaceMovePollInterval = 5 * time.Second
+
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &IPInterfaceResource{}
 var _ resource.ResourceWithImportState = &IPInterfaceResource{}
+var _ resource.ResourceWithModifyPlan = &IPInterfaceResource{}
 
 // NewIPInterfaceResource is a helper function to simplify the provider implementation.
 func NewIPInterfaceResource() resource.Resource {
@@ -43,10 +64,16 @@ type IPInterfaceResourceIP struct {
 	Netmask types.Int64  `tfsdk:"netmask"`
 }
 
-// IPInterfaceResourceLocation describes the resource data model for home node/port.
+// IPInterfaceResourceLocation describes the resource data model for home node/port, current node/port and failover.
 type IPInterfaceResourceLocation struct {
-	HomeNode types.String `tfsdk:"home_node"`
-	HomePort types.String `tfsdk:"home_port"`
+	HomeNode        types.String `tfsdk:"home_node"`
+	HomePort        types.String `tfsdk:"home_port"`
+	BroadcastDomain types.String `tfsdk:"broadcast_domain"`
+	Failover        types.String `tfsdk:"failover"`
+	AutoRevert      types.Bool   `tfsdk:"auto_revert"`
+	Node            types.String `tfsdk:"node"`
+	Port            types.String `tfsdk:"port"`
+	IsHome          types.Bool   `tfsdk:"is_home"`
 }
 
 // IPInterfaceResourceModel describes the resource data model.
@@ -54,8 +81,12 @@ type IPInterfaceResourceModel struct {
 	CxProfileName types.String                 `tfsdk:"cx_profile_name"`
 	Name          types.String                 `tfsdk:"name"`
 	SVMName       types.String                 `tfsdk:"svm_name"`
-	IP            *IPInterfaceResourceIP       `tfsdk:"ip"`
+	IP            types.Object                 `tfsdk:"ip"`
+	Subnet        types.String                 `tfsdk:"subnet"`
 	Location      *IPInterfaceResourceLocation `tfsdk:"location"`
+	ServicePolicy types.String                 `tfsdk:"service_policy"`
+	Enabled       types.Bool                   `tfsdk:"enabled"`
+	Vip           types.Bool                   `tfsdk:"vip"`
 	UUID          types.String                 `tfsdk:"id"`
 }
 
@@ -83,22 +114,56 @@ func (r *IPInterfaceResource) Schema(ctx context.Context, req resource.SchemaReq
 			"svm_name": schema.StringAttribute{
 				MarkdownDescription: "IPInterface svm name",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-			// TODO: Make IP optional once subnet is supported
 			"ip": schema.SingleNestedAttribute{
+				MarkdownDescription: "IPInterface IP address and netmask. When subnet is set, they are allocated from the subnet",
 				Attributes: map[string]schema.Attribute{
 					"address": schema.StringAttribute{
-						MarkdownDescription: "IPInterface IP address",
-						Required:            true,
+						MarkdownDescription: "IPInterface IP address, IPv4 or IPv6",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("netmask")),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
 					},
 					"netmask": schema.Int64Attribute{
-						MarkdownDescription: "IPInterface IP netmask",
-						Required:            true,
+						MarkdownDescription: "IPInterface IP netmask length, up to 32 for IPv4 and 128 for IPv6",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 128),
+							int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("address")),
+						},
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
 					},
 				},
-				Required: true,
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Object{
+					objectvalidator.ExactlyOneOf(path.MatchRoot("subnet")),
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subnet": schema.StringAttribute{
+				MarkdownDescription: "Subnet the IP address is allocated from, instead of setting ip. Changing it recreates the interface",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					// the subnet is not read back from ONTAP, so an imported interface keeps its address when the subnet is first set
+					stringplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+						resp.RequiresReplace = !req.StateValue.IsNull()
+					}, "Changing the subnet requires replacing the interface", "Changing the subnet requires replacing the interface"),
+				},
 			},
-			// TODO: Make location fields optionals once other options are supported
 			"location": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					"home_node": schema.StringAttribute{
@@ -106,12 +171,88 @@ func (r *IPInterfaceResource) Schema(ctx context.Context, req resource.SchemaReq
 						Required:            true,
 					},
 					"home_port": schema.StringAttribute{
-						MarkdownDescription: "IPInterface home port",
-						Required:            true,
+						MarkdownDescription: "IPInterface home port. When not set, ONTAP picks a port of home_node in broadcast_domain, or the VIP port of home_node when vip is true",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.String{
+							stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("broadcast_domain"), path.MatchRoot("vip")),
+						},
+					},
+					"broadcast_domain": schema.StringAttribute{
+						MarkdownDescription: "Broadcast domain used to place the interface when home_port is not set",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"failover": schema.StringAttribute{
+						MarkdownDescription: "Failover policy of the interface",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("home_port_only", "default", "home_node_only", "sfo_partners_only", "broadcast_domain_only"),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"auto_revert": schema.BoolAttribute{
+						MarkdownDescription: "Whether the interface automatically reverts to its home port",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"node": schema.StringAttribute{
+						MarkdownDescription: "Node the interface currently runs on. When set with port, the interface is migrated there. When not set, the interface is reverted to its home node",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("port")),
+						},
+					},
+					"port": schema.StringAttribute{
+						MarkdownDescription: "Port the interface currently runs on. When set with node, the interface is migrated there. When not set, the interface is reverted to its home port",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("node")),
+						},
+					},
+					"is_home": schema.BoolAttribute{
+						MarkdownDescription: "Whether the interface currently runs on its home node and port",
+						Computed:            true,
 					},
 				},
 				Required: true,
 			},
+			"service_policy": schema.StringAttribute{
+				MarkdownDescription: "Service policy of the interface, for instance default-data-files or default-intercluster",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the interface is administratively up. When not set, ONTAP enables a new interface and the current value is kept",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vip": schema.BoolAttribute{
+				MarkdownDescription: "Whether the interface is a VIP interface, whose address is announced to the routers of a BGP peer group. Changing it recreates the interface",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
 			"id": schema.StringAttribute{
 				MarkdownDescription: "IPInterface UUID",
 				Computed:            true,
@@ -123,6 +264,31 @@ func (r *IPInterfaceResource) Schema(ctx context.Context, req resource.SchemaReq
 	}
 }
 
+// ModifyPlan plans the interface on its home node and port when location node and port are not set, so that an interface that is not home shows as drift and is reverted.
+func (r *IPInterfaceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan, config *IPInterfaceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || plan == nil || plan.Location == nil || config.Location == nil {
+		return
+	}
+
+	location := plan.Location
+	if config.Location.Node.IsNull() {
+		location.Node = location.HomeNode
+	}
+	if config.Location.Port.IsNull() {
+		location.Port = location.HomePort
+	}
+	if location.Node.IsUnknown() || location.Port.IsUnknown() || location.HomeNode.IsUnknown() || location.HomePort.IsUnknown() {
+		location.IsHome = types.BoolUnknown()
+	} else {
+		location.IsHome = types.BoolValue(location.Node.Equal(location.HomeNode) && location.Port.Equal(location.HomePort))
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("location"), location)...)
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *IPInterfaceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
@@ -160,7 +326,7 @@ func (r *IPInterfaceResource) Read(ctx context.Context, req resource.ReadRequest
 
 	var restInfo *interfaces.IPInterfaceGetDataModelONTAP
 	if data.UUID.IsNull() {
-		restInfo, err = interfaces.GetIPInterfaceByName(errorHandler, *client, data.Name.ValueString(), data.SVMName.ValueString())
+		restInfo, err = interfaces.GetIPInterfaceByName(errorHandler, *client, data.Name.ValueString(), data.SVMName.ValueString(), nil)
 		if err != nil {
 			// error reporting done inside GetIPInterfaceByName
 			return
@@ -176,23 +342,9 @@ func (r *IPInterfaceResource) Read(ctx context.Context, req resource.ReadRequest
 		errorHandler.MakeAndReportError("No Interface found", fmt.Sprintf("NO interface, %s found.", data.Name.ValueString()))
 		return
 	}
-	data.Name = types.StringValue(restInfo.Name)
-	data.UUID = types.StringValue(restInfo.UUID)
-
-	var location IPInterfaceResourceLocation
-	location.HomeNode = types.StringValue(restInfo.Location.HomeNode.Name)
-	location.HomePort = types.StringValue(restInfo.Location.HomePort.Name)
-	data.Location = &location
-
-	var ip IPInterfaceResourceIP
-	ip.Address = types.StringValue(restInfo.IP.Address)
-	intValue, err := strconv.Atoi(restInfo.IP.Netmask)
-	if err != nil {
-		errorHandler.MakeAndReportError("Failed to read ip interface", fmt.Sprintf("Error: failed to convert string value '%s' to int for net mask.", restInfo.IP.Netmask))
+	if err := flattenIPInterface(errorHandler, &data, restInfo); err != nil {
 		return
 	}
-	ip.Netmask = types.Int64Value(int64(intValue))
-	data.IP = &ip
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
 	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
@@ -215,19 +367,25 @@ func (r *IPInterfaceResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	// TODO: check for empty values for optional fields
 	body.Name = data.Name.ValueString()
-	body.SVM.Name = data.SVMName.ValueString()
-	body.IP.Address = data.IP.Address.ValueString()
-	body.IP.Netmask = data.IP.Netmask.ValueInt64()
-	body.Location.HomePort = interfaces.IPInterfaceResourceHomePort{
-		Name: data.Location.HomePort.ValueString(),
-		Node: interfaces.IPInterfaceResourceHomeNode{
-			Name: data.Location.HomeNode.ValueString(),
-		},
+	body.SVM = &interfaces.IPInterfaceSvmName{Name: data.SVMName.ValueString()}
+	if data.Subnet.ValueString() != "" {
+		body.Subnet = &interfaces.IPInterfaceSubnet{Name: data.Subnet.ValueString()}
+	} else {
+		body.IP = expandIPInterfaceIP(ctx, &resp.Diagnostics, data.IP)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	body.Location = expandIPInterfaceLocation(data.Location, nil)
+	if !data.ServicePolicy.IsUnknown() && !data.ServicePolicy.IsNull() {
+		body.ServicePolicy = &interfaces.IPInterfaceServicePolicy{Name: data.ServicePolicy.ValueString()}
+	}
+	if !data.Enabled.IsUnknown() && !data.Enabled.IsNull() {
+		body.Enabled = data.Enabled.ValueBoolPointer()
 	}
-	body.Location.HomeNode = interfaces.IPInterfaceResourceHomeNode{
-		Name: data.Location.HomeNode.ValueString(),
+	if data.Vip.ValueBool() {
+		body.Vip = data.Vip.ValueBoolPointer()
 	}
 
 	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
@@ -243,6 +401,19 @@ func (r *IPInterfaceResource) Create(ctx context.Context, req resource.CreateReq
 
 	data.UUID = types.StringValue(resource.UUID)
 
+	// read back the computed values such as the service policy and failover defaults
+	restInfo, err := interfaces.GetIPInterface(errorHandler, *client, data.UUID.ValueString())
+	if err != nil {
+		return
+	}
+	restInfo, err = moveIPInterface(errorHandler, *client, data, restInfo)
+	if err != nil {
+		return
+	}
+	if err := flattenIPInterface(errorHandler, data, restInfo); err != nil {
+		return
+	}
+
 	tflog.Trace(ctx, fmt.Sprintf("created a resource, UUID=%s", data.UUID))
 
 	// Save data into Terraform state
@@ -251,10 +422,11 @@ func (r *IPInterfaceResource) Create(ctx context.Context, req resource.CreateReq
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *IPInterfaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data *IPInterfaceResourceModel
+	var data, state *IPInterfaceResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	var body interfaces.IPInterfaceResourceBodyDataModelONTAP
 	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
@@ -263,17 +435,20 @@ func (r *IPInterfaceResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	// only send what changed, so that the address or netmask can be modified in place without moving the interface
 	body.Name = data.Name.ValueString()
-	body.IP.Address = data.IP.Address.ValueString()
-	body.IP.Netmask = data.IP.Netmask.ValueInt64()
-	body.Location.HomePort = interfaces.IPInterfaceResourceHomePort{
-		Name: data.Location.HomePort.ValueString(),
-		Node: interfaces.IPInterfaceResourceHomeNode{
-			Name: data.Location.HomeNode.ValueString(),
-		},
+	if !data.IP.IsUnknown() && !data.IP.Equal(state.IP) {
+		body.IP = expandIPInterfaceIP(ctx, &resp.Diagnostics, data.IP)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
-	body.Location.HomeNode = interfaces.IPInterfaceResourceHomeNode{
-		Name: data.Location.HomeNode.ValueString(),
+	body.Location = expandIPInterfaceLocation(data.Location, state.Location)
+	if !data.ServicePolicy.IsUnknown() && !data.ServicePolicy.Equal(state.ServicePolicy) {
+		body.ServicePolicy = &interfaces.IPInterfaceServicePolicy{Name: data.ServicePolicy.ValueString()}
+	}
+	if !data.Enabled.Equal(state.Enabled) {
+		body.Enabled = data.Enabled.ValueBoolPointer()
 	}
 
 	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
@@ -288,6 +463,19 @@ func (r *IPInterfaceResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	restInfo, err := interfaces.GetIPInterface(errorHandler, *client, data.UUID.ValueString())
+	if err != nil {
+		return
+	}
+	// the home location is updated first, so that a revert goes to the new home
+	restInfo, err = moveIPInterface(errorHandler, *client, data, restInfo)
+	if err != nil {
+		return
+	}
+	if err := flattenIPInterface(errorHandler, data, restInfo); err != nil {
+		return
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -337,3 +525,178 @@ func (r *IPInterfaceResource) ImportState(ctx context.Context, req resource.Impo
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[1])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
 }
+
+// expandIPInterfaceLocation builds the location body from the plan, when state is set only the changed fields are returned
+func expandIPInterfaceLocation(plan *IPInterfaceResourceLocation, state *IPInterfaceResourceLocation) *interfaces.IPInterfaceResourceBodyLocation {
+	if state == nil {
+		state = &IPInterfaceResourceLocation{}
+	}
+	var location interfaces.IPInterfaceResourceBodyLocation
+	modified := false
+	homeNodeChanged := !plan.HomeNode.Equal(state.HomeNode)
+	// ONTAP needs the node with the port, so the port is sent again when the node changes
+	if isConfiguredAndChanged(plan.HomePort, state.HomePort) || (homeNodeChanged && isConfiguredAndChanged(plan.HomePort, types.StringNull())) {
+		location.HomePort = &interfaces.IPInterfaceResourceHomePort{
+			Name: plan.HomePort.ValueString(),
+			Node: interfaces.IPInterfaceResourceHomeNode{
+				Name: plan.HomeNode.ValueString(),
+			},
+		}
+		modified = true
+	}
+	if homeNodeChanged {
+		location.HomeNode = &interfaces.IPInterfaceResourceHomeNode{
+			Name: plan.HomeNode.ValueString(),
+		}
+		modified = true
+	}
+	if isConfiguredAndChanged(plan.BroadcastDomain, state.BroadcastDomain) {
+		location.BroadcastDomain = &interfaces.IPInterfaceBroadcastDomain{Name: plan.BroadcastDomain.ValueString()}
+		modified = true
+	}
+	if isConfiguredAndChanged(plan.Failover, state.Failover) {
+		location.Failover = plan.Failover.ValueString()
+		modified = true
+	}
+	if isConfiguredAndChanged(plan.AutoRevert, state.AutoRevert) {
+		location.AutoRevert = plan.AutoRevert.ValueBoolPointer()
+		modified = true
+	}
+	if !modified {
+		return nil
+	}
+	return &location
+}
+
+// moveIPInterface migrates the interface to the planned node and port, or reverts it home, and waits for it to be up there
+func moveIPInterface(errorHandler *utils.ErrorHandler, client restclient.RestClient, data *IPInterfaceResourceModel, restInfo *interfaces.IPInterfaceGetDataModelONTAP) (*interfaces.IPInterfaceGetDataModelONTAP, error) {
+	node := data.Location.Node.ValueString()
+	port := data.Location.Port.ValueString()
+	if data.Location.Node.IsUnknown() || node == "" {
+		node = restInfo.Location.HomeNode.Name
+	}
+	if data.Location.Port.IsUnknown() || port == "" {
+		if node != restInfo.Location.HomeNode.Name {
+			return nil, errorHandler.MakeAndReportError("error moving ip_interface", fmt.Sprintf("a port on node %s is required to migrate interface %s", node, restInfo.Name))
+		}
+		port = restInfo.Location.HomePort.Name
+	}
+	if node == restInfo.Location.Node.Name && port == restInfo.Location.Port.Name {
+		return restInfo, nil
+	}
+
+	body := interfaces.IPInterfaceResourceBodyDataModelONTAP{Name: restInfo.Name}
+	if node == restInfo.Location.HomeNode.Name && port == restInfo.Location.HomePort.Name {
+		isHome := true
+		body.Location = &interfaces.IPInterfaceResourceBodyLocation{IsHome: &isHome}
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("reverting ip_interface %s to %s:%s", restInfo.Name, node, port))
+	} else {
+		body.Location = &interfaces.IPInterfaceResourceBodyLocation{
+			Node: &interfaces.IPInterfaceResourceHomeNode{Name: node},
+			Port: &interfaces.IPInterfaceResourceHomePort{
+				Name: port,
+				Node: interfaces.IPInterfaceResourceHomeNode{Name: node},
+			},
+		}
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("migrating ip_interface %s to %s:%s", restInfo.Name, node, port))
+	}
+	if err := interfaces.UpdateIPInterface(errorHandler, client, body, restInfo.UUID); err != nil {
+		return nil, err
+	}
+	return waitForIPInterfaceLocation(errorHandler, client, restInfo.UUID, node, port, restInfo.Enabled)
+}
+
+// waitForIPInterfaceLocation waits for the interface to run on node and port, and to be up unless it is disabled.
+func waitForIPInterfaceLocation(errorHandler *utils.ErrorHandler, client restclient.RestClient, uuid string, node string, port string, enabled bool) (*interfaces.IPInterfaceGetDataModelONTAP, error) {
+	for timeRemaining := ipInterfaceMoveTimeout; timeRemaining > 0; timeRemaining -= ipInterfaceMovePollInterval {
+		restInfo, err := interfaces.GetIPInterface(errorHandler, client, uuid)
+		if err != nil {
+			return nil, err
+		}
+		if restInfo.Location.Node.Name == node && restInfo.Location.Port.Name == port && (!enabled || restInfo.State == "up") {
+			return restInfo, nil
+		}
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("waiting for ip_interface %s on %s:%s, currently %s on %s:%s", restInfo.Name, node, port, restInfo.State, restInfo.Location.Node.Name, restInfo.Location.Port.Name))
+		time.Sleep(ipInterfaceMovePollInterval)
+	}
+	detail := fmt.Sprintf("ip_interface %s is not up on %s:%s after %s", uuid, node, port, ipInterfaceMoveTimeout)
+	// a port without link is the usual reason for the interface not to come up
+	portInfo, err := interfaces.GetNetworkEthernetPortByName(errorHandler, client, node, port)
+	if err == nil && portInfo != nil {
+		detail += fmt.Sprintf(", port %s is %s", port, portInfo.State)
+	}
+	return nil, errorHandler.MakeAndReportError("error moving ip_interface", detail)
+}
+
+// isConfiguredAndChanged returns true when the planned value is known and differs from the prior state
+func isConfiguredAndChanged(plan attr.Value, state attr.Value) bool {
+	return !plan.IsUnknown() && !plan.IsNull() && !plan.Equal(state)
+}
+
+// flattenIPInterface sets the state from the ONTAP record
+func flattenIPInterface(errorHandler *utils.ErrorHandler, data *IPInterfaceResourceModel, restInfo *interfaces.IPInterfaceGetDataModelONTAP) error {
+	data.Name = types.StringValue(restInfo.Name)
+	data.UUID = types.StringValue(restInfo.UUID)
+	data.ServicePolicy = types.StringValue(restInfo.ServicePolicy.Name)
+	data.Enabled = types.BoolValue(restInfo.Enabled)
+	data.Vip = types.BoolValue(restInfo.Vip)
+
+	var location IPInterfaceResourceLocation
+	location.HomeNode = types.StringValue(restInfo.Location.HomeNode.Name)
+	location.HomePort = types.StringValue(restInfo.Location.HomePort.Name)
+	location.BroadcastDomain = types.StringValue(restInfo.Location.BroadcastDomain.Name)
+	location.Failover = types.StringValue(restInfo.Location.Failover)
+	location.AutoRevert = types.BoolValue(restInfo.Location.AutoRevert)
+	location.Node = types.StringValue(restInfo.Location.Node.Name)
+	location.Port = types.StringValue(restInfo.Location.Port.Name)
+	location.IsHome = types.BoolValue(restInfo.Location.IsHome)
+	data.Location = &location
+
+	var ip IPInterfaceResourceIP
+	ip.Address = types.StringValue(restInfo.IP.Address)
+	// ONTAP returns IPv6 addresses in their canonical form, keep the configured spelling when it is the same address
+	if !data.IP.IsNull() && !data.IP.IsUnknown() {
+		var previous IPInterfaceResourceIP
+		diags := data.IP.As(errorHandler.Ctx, &previous, basetypes.ObjectAsOptions{})
+		if !diags.HasError() && sameIPAddress(previous.Address.ValueString(), restInfo.IP.Address) {
+			ip.Address = previous.Address
+		}
+	}
+	intValue, err := strconv.Atoi(restInfo.IP.Netmask)
+	if err != nil {
+		return errorHandler.MakeAndReportError("Failed to read ip interface", fmt.Sprintf("Error: failed to convert string value '%s' to int for net mask.", restInfo.IP.Netmask))
+	}
+	ip.Netmask = types.Int64Value(int64(intValue))
+	objectValue, diags := types.ObjectValueFrom(errorHandler.Ctx, ipInterfaceIPAttrTypes, ip)
+	if diags.HasError() {
+		return errorHandler.MakeAndReportError("Failed to read ip interface", fmt.Sprintf("Error: failed to set ip: %#v", diags))
+	}
+	data.IP = objectValue
+	return nil
+}
+
+// ipInterfaceIPAttrTypes describes the ip attribute
+var ipInterfaceIPAttrTypes = map[string]attr.Type{
+	"address": types.StringType,
+	"netmask": types.Int64Type,
+}
+
+// expandIPInterfaceIP builds the ip body from the configured address and netmask
+func expandIPInterfaceIP(ctx context.Context, diags *diag.Diagnostics, object types.Object) *interfaces.IPInterfaceResourceIP {
+	var ip IPInterfaceResourceIP
+	diags.Append(object.As(ctx, &ip, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+	return &interfaces.IPInterfaceResourceIP{
+		Address: ip.Address.ValueString(),
+		Netmask: ip.Netmask.ValueInt64(),
+	}
+}
+
+// sameIPAddress returns true when both strings are valid and represent the same IP address
+func sameIPAddress(first string, second string) bool {
+	firstIP := net.ParseIP(first)
+	secondIP := net.ParseIP(second)
+	return firstIP != nil && secondIP != nil && firstIP.Equal(secondIP)
+}
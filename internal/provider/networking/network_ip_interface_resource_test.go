@@ -42,6 +42,34 @@ func TestAccNetworkIpInterfaceResource(t *testing.T) {
 					resource.TestCheckResourceAttr("netapp-ontap_network_ip_interface.example", "ip.address", "10.10.10.20"),
 				),
 			},
+			// Migrate to another port of the home node
+			{
+				Config: testAccNetworkIPInterfaceResourceMigrateConfig("svm0", "10.10.10.20", "ontap_cluster_1-01", "e0c"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_network_ip_interface.example", "location.home_port", "e0d"),
+					resource.TestCheckResourceAttr("netapp-ontap_network_ip_interface.example", "location.port", "e0c"),
+					resource.TestCheckResourceAttr("netapp-ontap_network_ip_interface.example", "location.is_home", "false"),
+				),
+			},
+			// Revert home when node and port are removed
+			{
+				Config: testAccNetworkIPInterfaceResourceConfig("svm0", "10.10.10.20", "ontap_cluster_1-01"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_network_ip_interface.example", "location.port", "e0d"),
+					resource.TestCheckResourceAttr("netapp-ontap_network_ip_interface.example", "location.is_home", "true"),
+				),
+			},
+			// Update the failover policy, service policy and admin status in place
+			{
+				Config: testAccNetworkIPInterfaceResourceOptionsConfig("svm0", "10.10.10.20", "ontap_cluster_1-01"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_network_ip_interface.example", "ip.address", "10.10.10.20"),
+					resource.TestCheckResourceAttr("netapp-ontap_network_ip_interface.example", "location.failover", "home_node_only"),
+					resource.TestCheckResourceAttr("netapp-ontap_network_ip_interface.example", "location.auto_revert", "true"),
+					resource.TestCheckResourceAttr("netapp-ontap_network_ip_interface.example", "service_policy", "default-data-files"),
+					resource.TestCheckResourceAttr("netapp-ontap_network_ip_interface.example", "enabled", "false"),
+				),
+			},
 			// Test importing a resource
 			{
 				ResourceName:  "netapp-ontap_network_ip_interface.example",
@@ -92,3 +120,83 @@ resource "netapp-ontap_network_ip_interface" "example" {
 }
 `, host, admin, password, svmName, address, homeNode)
 }
+
+func testAccNetworkIPInterfaceResourceMigrateConfig(svmName, address, homeNode, port string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_network_ip_interface" "example" {
+	cx_profile_name = "cluster4"
+	name = "test-interface"
+	svm_name = "%s"
+  	ip = {
+    	address = "%s"
+    	netmask = 18
+    }
+  	location = {
+    	home_port = "e0d"
+    	home_node = "%s"
+    	node = "%s"
+    	port = "%s"
+  	}
+}
+`, host, admin, password, svmName, address, homeNode, homeNode, port)
+}
+
+func testAccNetworkIPInterfaceResourceOptionsConfig(svmName, address, homeNode string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_network_ip_interface" "example" {
+	cx_profile_name = "cluster4"
+	name = "test-interface"
+	svm_name = "%s"
+  	ip = {
+    	address = "%s"
+    	netmask = 18
+    }
+  	location = {
+    	home_port = "e0d"
+    	home_node = "%s"
+    	failover = "home_node_only"
+    	auto_revert = true
+  	}
+  	service_policy = "default-data-files"
+  	enabled = false
+}
+`, host, admin, password, svmName, address, homeNode)
+}
@@ -98,6 +98,54 @@ func (d *IPInterfacesDataSource) Schema(ctx context.Context, req datasource.Sche
 							Computed:            true,
 							MarkdownDescription: "IPInterface scope",
 						},
+						"service_policy": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "IPInterface service policy",
+						},
+						"enabled": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the interface is administratively up",
+						},
+						"vip": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the interface is a VIP interface",
+						},
+						"state": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Operational state of the interface, up or down",
+						},
+						"statistics": schema.SingleNestedAttribute{
+							Attributes: map[string]schema.Attribute{
+								"status": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "Status of the counters, ok when they are valid",
+								},
+								"timestamp": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "Time the counters were collected",
+								},
+								"throughput_raw": schema.SingleNestedAttribute{
+									Attributes: map[string]schema.Attribute{
+										"read": schema.Int64Attribute{
+											Computed:            true,
+											MarkdownDescription: "Bytes received since the counters were reset",
+										},
+										"write": schema.Int64Attribute{
+											Computed:            true,
+											MarkdownDescription: "Bytes sent since the counters were reset",
+										},
+										"total": schema.Int64Attribute{
+											Computed:            true,
+											MarkdownDescription: "Bytes received and sent since the counters were reset",
+										},
+									},
+									Computed:            true,
+									MarkdownDescription: "Throughput counters, in bytes",
+								},
+							},
+							Computed:            true,
+							MarkdownDescription: "Throughput counters of the interface, only available with ONTAP 9.8 or higher",
+						},
 						"ip": schema.SingleNestedAttribute{
 							Attributes: map[string]schema.Attribute{
 								"address": schema.StringAttribute{
@@ -121,6 +169,30 @@ func (d *IPInterfacesDataSource) Schema(ctx context.Context, req datasource.Sche
 									Computed:            true,
 									MarkdownDescription: "IPInterface home port",
 								},
+								"broadcast_domain": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "IPInterface broadcast domain",
+								},
+								"failover": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "IPInterface failover policy",
+								},
+								"auto_revert": schema.BoolAttribute{
+									Computed:            true,
+									MarkdownDescription: "Whether the interface automatically reverts to its home port",
+								},
+								"node": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "Node the interface currently runs on",
+								},
+								"port": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "Port the interface currently runs on",
+								},
+								"is_home": schema.BoolAttribute{
+									Computed:            true,
+									MarkdownDescription: "Whether the interface currently runs on its home node and port",
+								},
 							},
 							Computed: true,
 						},
@@ -177,7 +249,17 @@ func (d *IPInterfacesDataSource) Read(ctx context.Context, req datasource.ReadRe
 		}
 	}
 
-	restInfo, err := interfaces.GetListIPInterfaces(errorHandler, *client, filter)
+	cluster, err := interfaces.GetCluster(errorHandler, *client)
+	if err != nil {
+		// error reporting done inside GetCluster
+		return
+	}
+	if cluster == nil {
+		errorHandler.MakeAndReportError("No cluster found", "No Cluster found")
+		return
+	}
+
+	restInfo, err := interfaces.GetListIPInterfaces(errorHandler, *client, filter, &cluster.Version)
 	if err != nil {
 		// error reporting done inside GetIPInterfaces
 		return
@@ -190,6 +272,11 @@ func (d *IPInterfacesDataSource) Read(ctx context.Context, req datasource.ReadRe
 			Name:          types.StringValue(record.Name),
 			Scope:         types.StringValue(record.Scope),
 			SVMName:       types.StringValue(record.SVM.Name),
+			ServicePolicy: types.StringValue(record.ServicePolicy.Name),
+			Enabled:       types.BoolValue(record.Enabled),
+			Vip:           types.BoolValue(record.Vip),
+			State:         types.StringValue(record.State),
+			Statistics:    flattenIPInterfaceStatistics(record.Statistics),
 		}
 		intNetmask, err := strconv.Atoi(record.IP.Netmask)
 		if err != nil {
@@ -201,8 +288,14 @@ func (d *IPInterfacesDataSource) Read(ctx context.Context, req datasource.ReadRe
 			Netmask: types.Int64Value(int64(intNetmask)),
 		}
 		data.IPInterfaces[index].Location = &LocationDataSourceModel{
-			HomeNode: types.StringValue(record.Location.HomeNode.Name),
-			HomePort: types.StringValue(record.Location.HomePort.Name),
+			HomeNode:        types.StringValue(record.Location.HomeNode.Name),
+			HomePort:        types.StringValue(record.Location.HomePort.Name),
+			BroadcastDomain: types.StringValue(record.Location.BroadcastDomain.Name),
+			Failover:        types.StringValue(record.Location.Failover),
+			AutoRevert:      types.BoolValue(record.Location.AutoRevert),
+			Node:            types.StringValue(record.Location.Node.Name),
+			Port:            types.StringValue(record.Location.Port.Name),
+			IsHome:          types.BoolValue(record.Location.IsHome),
 		}
 	}
 
@@ -3,6 +3,7 @@ package networking
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
@@ -28,6 +29,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &IPRouteResource{}
 var _ resource.ResourceWithImportState = &IPRouteResource{}
+var _ resource.ResourceWithModifyPlan = &IPRouteResource{}
 
 // NewIPRouteResource is a helper function to simplify the provider implementation.
 func NewIPRouteResource() resource.Resource {
@@ -71,7 +73,7 @@ func (r *IPRouteResource) Schema(ctx context.Context, req resource.SchemaRequest
 			},
 			"destination": schema.SingleNestedAttribute{
 				Optional:            true,
-				MarkdownDescription: "destination IP address information",
+				MarkdownDescription: "destination IP address information. Changing it replaces the route",
 				Computed:            true,
 				Default: objectdefault.StaticValue(types.ObjectValueMust(
 					map[string]attr.Type{
@@ -101,15 +103,17 @@ func (r *IPRouteResource) Schema(ctx context.Context, req resource.SchemaRequest
 				},
 			},
 			"svm_name": schema.StringAttribute{
-				MarkdownDescription: "IPInterface svm name",
+				MarkdownDescription: "IPInterface svm name. Changing it replaces the route",
 				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"gateway": schema.StringAttribute{
-				MarkdownDescription: "The IP address of the gateway router leading to the destination.",
+				MarkdownDescription: "The IP address of the gateway router leading to the destination. Changing it replaces the route",
 				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"metric": schema.Int64Attribute{
-				MarkdownDescription: "Indicates a preference order between several routes to the same destination.",
+				MarkdownDescription: "Indicates a preference order between several routes to the same destination. Changing it replaces the route",
 				Optional:            true,
 				Computed:            true,
 				Default:             int64default.StaticInt64(20),
@@ -126,6 +130,27 @@ func (r *IPRouteResource) Schema(ctx context.Context, req resource.SchemaRequest
 	}
 }
 
+// ModifyPlan warns when only metric changes, as ONTAP rejects a second route with the same destination and gateway,
+// so the route cannot be replaced with create_before_destroy.
+func (r *IPRouteResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan, state *IPRouteResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || state == nil || plan == nil {
+		return
+	}
+
+	if plan.Metric.IsUnknown() || plan.Metric.Equal(state.Metric) {
+		return
+	}
+	if plan.SVMName.Equal(state.SVMName) && plan.Gateway.Equal(state.Gateway) && reflect.DeepEqual(plan.Destination, state.Destination) {
+		resp.Diagnostics.AddAttributeWarning(path.Root("metric"), "Route replaced to change the metric",
+			"ONTAP cannot modify a route and rejects a second route with the same destination and gateway. "+
+				"The route is deleted before it is created again with the new metric, and the apply fails if the resource uses create_before_destroy.")
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *IPRouteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
@@ -260,7 +285,7 @@ func (r *IPRouteResource) Create(ctx context.Context, req resource.CreateRequest
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-// Update updates the resource and sets the updated Terraform state on success.
+// Update only saves the connection profile, routes cannot be modified so every other attribute requires replacement.
 func (r *IPRouteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data *IPRouteResourceModel
 
@@ -271,8 +296,6 @@ func (r *IPRouteResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	tflog.Error(ctx, "Update not supported by REST API for IP Routes")
-
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
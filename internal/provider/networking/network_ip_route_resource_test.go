@@ -53,6 +53,14 @@ func TestAccNetworkIpRouteResource(t *testing.T) {
 					resource.TestCheckResourceAttr("netapp-ontap_network_ip_route.example", "svm_name", "carchi-test"),
 				),
 			},
+			// Change the gateway, the route is replaced with the new route created first
+			{
+				Config: testAccNetworkIPIRouteResourceCreateBeforeDestroyConfig("ansibleSVM", "10.10.10.2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_network_ip_route.example", "gateway", "10.10.10.2"),
+					resource.TestCheckResourceAttr("netapp-ontap_network_ip_route.example", "destination.address", "10.10.10.254"),
+				),
+			},
 		},
 	})
 }
@@ -119,6 +127,43 @@ resource "netapp-ontap_network_ip_route" "example" {
 `, host, admin, password, svmName, address, netmask)
 }
 
+func testAccNetworkIPIRouteResourceCreateBeforeDestroyConfig(svmName string, gateway string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_network_ip_route" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "%s"
+  gateway = "%s"
+  destination = {
+    address = "10.10.10.254"
+    netmask = 20
+    }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, host, admin, password, svmName, gateway)
+}
+
 func testAccNetworkIPIRouteResourceConfigMissingVars(svmName string) string {
 	host := os.Getenv("TF_ACC_NETAPP_HOST")
 	admin := os.Getenv("TF_ACC_NETAPP_USER")
@@ -0,0 +1,330 @@
+package networking
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &IPServicePolicyResource{}
+var _ resource.ResourceWithImportState = &IPServicePolicyResource{}
+
+// NewIPServicePolicyResource is a helper function to simplify the provider implementation.
+func NewIPServicePolicyResource() resource.Resource {
+	return &IPServicePolicyResource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "network_ip_service_policy",
+		},
+	}
+}
+
+// IPServicePolicyResource defines the resource implementation.
+type IPServicePolicyResource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// IPServicePolicyResourceModel describes the resource data model.
+type IPServicePolicyResourceModel struct {
+	CxProfileName types.String   `tfsdk:"cx_profile_name"`
+	Name          types.String   `tfsdk:"name"`
+	SVMName       types.String   `tfsdk:"svm_name"`
+	IPspace       types.String   `tfsdk:"ipspace"`
+	Scope         types.String   `tfsdk:"scope"`
+	Services      []types.String `tfsdk:"services"`
+	UUID          types.String   `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *IPServicePolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.Name
+}
+
+// Schema defines the schema for the resource.
+func (r *IPServicePolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "IP service policy resource",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Service policy name, renamed in place",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "SVM name, for a SVM scoped policy. Leave unset for a cluster scoped policy",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.Expressions{
+						path.MatchRoot("ipspace"),
+					}...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ipspace": schema.StringAttribute{
+				MarkdownDescription: "IPspace name, for a cluster scoped policy. Defaults to the Default ipspace, or to the ipspace of the SVM",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scope": schema.StringAttribute{
+				MarkdownDescription: "Scope of the policy, svm or cluster",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"services": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Services offered by the LIFs using the policy, such as data_core, data_nfs, data_cifs, data_s3_server, management_https or intercluster_core",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Service policy UUID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *IPServicePolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *IPServicePolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IPServicePolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetNetworkIPServicePolicyByName(errorHandler, *client, data.Name.ValueString(), data.SVMName.ValueString(), data.IPspace.ValueString())
+	if err != nil {
+		// error reporting done inside GetNetworkIPServicePolicyByName
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No service policy %s found", data.Name.ValueString()))
+		return
+	}
+
+	flattenIPServicePolicy(&data, restInfo)
+
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a resource and retrieve UUID
+func (r *IPServicePolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *IPServicePolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	body := interfaces.NetworkIPServicePolicyResourceBodyDataModelONTAP{
+		Name:     data.Name.ValueString(),
+		Services: ipServicePolicyServices(data.Services),
+	}
+	ipspace := ""
+	if data.SVMName.ValueString() != "" {
+		body.Scope = "svm"
+		body.SVM = &interfaces.SvmDataModelONTAP{Name: data.SVMName.ValueString()}
+	} else {
+		body.Scope = "cluster"
+		ipspace = "Default"
+		if data.IPspace.ValueString() != "" {
+			ipspace = data.IPspace.ValueString()
+		}
+		body.IPspace = &interfaces.NetworkIPspaceResourceBodyDataModelONTAP{Name: ipspace}
+	}
+
+	_, err = interfaces.CreateNetworkIPServicePolicy(errorHandler, *client, body)
+	if err != nil {
+		return
+	}
+
+	// the POST response does not report the ipspace of a SVM scoped policy, read it back
+	restInfo, err := interfaces.GetNetworkIPServicePolicyByName(errorHandler, *client, data.Name.ValueString(), data.SVMName.ValueString(), ipspace)
+	if err != nil {
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No service policy %s found after creation", data.Name.ValueString()))
+		return
+	}
+	flattenIPServicePolicy(data, restInfo)
+
+	tflog.Trace(ctx, fmt.Sprintf("created a resource, UUID=%s", data.UUID))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *IPServicePolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *IPServicePolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, plan.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	body := interfaces.NetworkIPServicePolicyResourceBodyDataModelONTAP{
+		Services: ipServicePolicyServices(plan.Services),
+	}
+	if !plan.Name.Equal(state.Name) {
+		body.Name = plan.Name.ValueString()
+	}
+	err = interfaces.UpdateNetworkIPServicePolicy(errorHandler, *client, state.UUID.ValueString(), body)
+	if err != nil {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *IPServicePolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *IPServicePolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if data.UUID.IsNull() {
+		errorHandler.MakeAndReportError("UUID is null", "network_ip_service_policy UUID is null")
+		return
+	}
+
+	err = interfaces.DeleteNetworkIPServicePolicy(errorHandler, *client, data.UUID.ValueString())
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *IPServicePolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, fmt.Sprintf("import req a network ip service policy resource: %#v", req))
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) == 3 && idParts[0] != "" && idParts[1] != "" && idParts[2] != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[1])...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
+		return
+	}
+	if len(idParts) == 4 && idParts[0] != "" && idParts[1] == "" && idParts[2] != "" && idParts[3] != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ipspace"), idParts[2])...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[3])...)
+		return
+	}
+	resp.Diagnostics.AddError(
+		"Unexpected Import Identifier",
+		fmt.Sprintf("Expected import identifier with format: name,svm_name,cx_profile_name for a SVM scoped policy or name,,ipspace,cx_profile_name for a cluster scoped policy. Got: %q", req.ID),
+	)
+}
+
+func flattenIPServicePolicy(data *IPServicePolicyResourceModel, restInfo *interfaces.NetworkIPServicePolicyGetDataModelONTAP) {
+	data.Name = types.StringValue(restInfo.Name)
+	data.UUID = types.StringValue(restInfo.UUID)
+	data.Scope = types.StringValue(restInfo.Scope)
+	data.IPspace = types.StringValue(restInfo.IPspace.Name)
+	if restInfo.SVM.Name != "" {
+		data.SVMName = types.StringValue(restInfo.SVM.Name)
+	}
+	data.Services = make([]types.String, 0, len(restInfo.Services))
+	for _, service := range restInfo.Services {
+		data.Services = append(data.Services, types.StringValue(service))
+	}
+}
+
+func ipServicePolicyServices(services []types.String) []string {
+	result := make([]string, 0, len(services))
+	for _, service := range services {
+		result = append(result, service.ValueString())
+	}
+	return result
+}
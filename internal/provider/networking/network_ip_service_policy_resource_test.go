@@ -0,0 +1,85 @@
+package networking_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	ntest "github.com/netapp/terraform-provider-netapp-ontap/internal/provider"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetworkIPServicePolicyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { ntest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ntest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccNetworkIPServicePolicyResourceConfig("acc_nfs_only", `"data_core", "data_nfs"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_network_ip_service_policy.example", "name", "acc_nfs_only"),
+					resource.TestCheckResourceAttr("netapp-ontap_network_ip_service_policy.example", "scope", "svm"),
+					resource.TestCheckResourceAttr("netapp-ontap_network_ip_service_policy.example", "services.#", "2"),
+					resource.TestCheckResourceAttrSet("netapp-ontap_network_ip_service_policy.example", "id"),
+				),
+			},
+			// Rename and change the services in place
+			{
+				Config: testAccNetworkIPServicePolicyResourceConfig("acc_nfs_cifs", `"data_core", "data_nfs", "data_cifs"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_network_ip_service_policy.example", "name", "acc_nfs_cifs"),
+					resource.TestCheckResourceAttr("netapp-ontap_network_ip_service_policy.example", "services.#", "3"),
+				),
+			},
+			// Test importing a resource
+			{
+				ResourceName:  "netapp-ontap_network_ip_service_policy.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s,%s", "acc_nfs_cifs", "svm0", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_network_ip_service_policy.example", "name", "acc_nfs_cifs"),
+				),
+			},
+			// Import with a bad id
+			{
+				ResourceName:  "netapp-ontap_network_ip_service_policy.example",
+				ImportState:   true,
+				ImportStateId: "acc_nfs_cifs,cluster4",
+				ExpectError:   regexp.MustCompile("Unexpected Import Identifier"),
+			},
+		},
+	})
+}
+
+func testAccNetworkIPServicePolicyResourceConfig(name string, services string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_network_ip_service_policy" "example" {
+  cx_profile_name = "cluster4"
+  name = "%s"
+  svm_name = "svm0"
+  services = [%s]
+}
+`, host, admin, password, name, services)
+}
@@ -0,0 +1,416 @@
+package networking
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &IPSubnetResource{}
+var _ resource.ResourceWithImportState = &IPSubnetResource{}
+
+// NewIPSubnetResource is a helper function to simplify the provider implementation.
+func NewIPSubnetResource() resource.Resource {
+	return &IPSubnetResource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "network_ip_subnet",
+		},
+	}
+}
+
+// IPSubnetResource defines the resource implementation.
+type IPSubnetResource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// IPSubnetResourceModel describes the resource data model.
+type IPSubnetResourceModel struct {
+	CxProfileName   types.String                 `tfsdk:"cx_profile_name"`
+	Name            types.String                 `tfsdk:"name"`
+	IPspace         types.String                 `tfsdk:"ipspace"`
+	BroadcastDomain types.String                 `tfsdk:"broadcast_domain"`
+	Address         types.String                 `tfsdk:"address"`
+	Netmask         types.Int64                  `tfsdk:"netmask"`
+	Gateway         types.String                 `tfsdk:"gateway"`
+	IPRanges        []IPSubnetRangeResourceModel `tfsdk:"ip_ranges"`
+	AvailableCount  types.Int64                  `tfsdk:"available_count"`
+	TotalCount      types.Int64                  `tfsdk:"total_count"`
+	UUID            types.String                 `tfsdk:"id"`
+}
+
+// IPSubnetRangeResourceModel describes a range of addresses of the subnet.
+type IPSubnetRangeResourceModel struct {
+	Start types.String `tfsdk:"start"`
+	End   types.String `tfsdk:"end"`
+}
+
+// Metadata returns the resource type name.
+func (r *IPSubnetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.Name
+}
+
+// Schema defines the schema for the resource.
+func (r *IPSubnetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "IP subnet resource",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Subnet name, renamed in place",
+				Required:            true,
+			},
+			"ipspace": schema.StringAttribute{
+				MarkdownDescription: "IPspace the subnet belongs to, defaults to Default. Changing it recreates the subnet",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("Default"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"broadcast_domain": schema.StringAttribute{
+				MarkdownDescription: "Broadcast domain the subnet belongs to. Changing it recreates the subnet",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"address": schema.StringAttribute{
+				MarkdownDescription: "Network address of the subnet, IPv4 or IPv6. Changing it recreates the subnet",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"netmask": schema.Int64Attribute{
+				MarkdownDescription: "Netmask length of the subnet, up to 32 for IPv4 and 128 for IPv6. Changing it recreates the subnet",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 128),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"gateway": schema.StringAttribute{
+				MarkdownDescription: "Gateway of the subnet. Interfaces created from the subnet get a default route to it",
+				Optional:            true,
+			},
+			"ip_ranges": schema.ListNestedAttribute{
+				MarkdownDescription: "Ranges of addresses that are allocated to interfaces created from the subnet. Removing ip_ranges removes all the ranges",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"start": schema.StringAttribute{
+							MarkdownDescription: "First address of the range",
+							Required:            true,
+						},
+						"end": schema.StringAttribute{
+							MarkdownDescription: "Last address of the range, the same as start for a single address",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"available_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of addresses of the ranges that are not allocated",
+				Computed:            true,
+			},
+			"total_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of addresses in the ranges",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Subnet UUID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *IPSubnetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *IPSubnetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IPSubnetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetNetworkIPSubnetByName(errorHandler, *client, data.Name.ValueString(), data.IPspace.ValueString())
+	if err != nil {
+		// error reporting done inside GetNetworkIPSubnetByName
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No subnet %s found in ipspace %s", data.Name.ValueString(), data.IPspace.ValueString()))
+		return
+	}
+
+	if err := flattenIPSubnet(errorHandler, &data, restInfo); err != nil {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a resource and retrieve UUID
+func (r *IPSubnetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *IPSubnetResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	body := interfaces.NetworkIPSubnetResourceBodyDataModelONTAP{
+		Name:            data.Name.ValueString(),
+		IPspace:         &interfaces.NetworkIPspaceResourceBodyDataModelONTAP{Name: data.IPspace.ValueString()},
+		BroadcastDomain: &interfaces.IPInterfaceBroadcastDomain{Name: data.BroadcastDomain.ValueString()},
+		Subnet: &interfaces.NetworkIPSubnetAddress{
+			Address: data.Address.ValueString(),
+			Netmask: strconv.FormatInt(data.Netmask.ValueInt64(), 10),
+		},
+	}
+	if data.Gateway.ValueString() != "" {
+		body.Gateway = data.Gateway.ValueStringPointer()
+	}
+	if data.IPRanges != nil {
+		body.IPRanges = expandIPSubnetRanges(data.IPRanges)
+	}
+	_, err = interfaces.CreateNetworkIPSubnet(errorHandler, *client, body)
+	if err != nil {
+		return
+	}
+
+	// read back the address counts
+	restInfo, err := interfaces.GetNetworkIPSubnetByName(errorHandler, *client, data.Name.ValueString(), data.IPspace.ValueString())
+	if err != nil {
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No subnet %s found after creation", data.Name.ValueString()))
+		return
+	}
+	data.UUID = types.StringValue(restInfo.UUID)
+	data.AvailableCount = types.Int64Value(restInfo.AvailableCount)
+	data.TotalCount = types.Int64Value(restInfo.TotalCount)
+
+	tflog.Trace(ctx, fmt.Sprintf("created a resource, UUID=%s", data.UUID))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *IPSubnetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *IPSubnetResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, plan.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var body interfaces.NetworkIPSubnetResourceBodyDataModelONTAP
+	if !plan.Name.Equal(state.Name) {
+		body.Name = plan.Name.ValueString()
+	}
+	if !plan.Gateway.Equal(state.Gateway) {
+		gateway := plan.Gateway.ValueString()
+		body.Gateway = &gateway
+	}
+	// removing ip_ranges removes all the ranges
+	if !ipSubnetRangesEqual(plan.IPRanges, state.IPRanges) {
+		body.IPRanges = expandIPSubnetRanges(plan.IPRanges)
+	}
+	err = interfaces.UpdateNetworkIPSubnet(errorHandler, *client, state.UUID.ValueString(), body)
+	if err != nil {
+		return
+	}
+
+	restInfo, err := interfaces.GetNetworkIPSubnetByName(errorHandler, *client, plan.Name.ValueString(), plan.IPspace.ValueString())
+	if err != nil {
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No subnet %s found after update", plan.Name.ValueString()))
+		return
+	}
+	plan.AvailableCount = types.Int64Value(restInfo.AvailableCount)
+	plan.TotalCount = types.Int64Value(restInfo.TotalCount)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *IPSubnetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *IPSubnetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if data.UUID.IsNull() {
+		errorHandler.MakeAndReportError("UUID is null", "network_ip_subnet UUID is null")
+		return
+	}
+
+	err = interfaces.DeleteNetworkIPSubnet(errorHandler, *client, data.UUID.ValueString())
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *IPSubnetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, fmt.Sprintf("import req a network ip subnet resource: %#v", req))
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: name,ipspace,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ipspace"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
+}
+
+func flattenIPSubnet(errorHandler *utils.ErrorHandler, data *IPSubnetResourceModel, restInfo *interfaces.NetworkIPSubnetGetDataModelONTAP) error {
+	data.Name = types.StringValue(restInfo.Name)
+	data.UUID = types.StringValue(restInfo.UUID)
+	data.IPspace = types.StringValue(restInfo.IPspace.Name)
+	data.BroadcastDomain = types.StringValue(restInfo.BroadcastDomain.Name)
+	// ONTAP returns IPv6 addresses in their canonical form, keep the configured spelling when it is the same address
+	if !sameIPAddress(data.Address.ValueString(), restInfo.Subnet.Address) {
+		data.Address = types.StringValue(restInfo.Subnet.Address)
+	}
+	netmask, err := strconv.Atoi(restInfo.Subnet.Netmask)
+	if err != nil {
+		return errorHandler.MakeAndReportError("Failed to read subnet", fmt.Sprintf("Error: failed to convert string value '%s' to int for net mask.", restInfo.Subnet.Netmask))
+	}
+	data.Netmask = types.Int64Value(int64(netmask))
+	if restInfo.Gateway != "" || !data.Gateway.IsNull() {
+		data.Gateway = types.StringValue(restInfo.Gateway)
+	}
+	if data.IPRanges != nil {
+		data.IPRanges = make([]IPSubnetRangeResourceModel, 0, len(restInfo.IPRanges))
+		for _, ipRange := range restInfo.IPRanges {
+			data.IPRanges = append(data.IPRanges, IPSubnetRangeResourceModel{
+				Start: types.StringValue(ipRange.Start),
+				End:   types.StringValue(ipRange.End),
+			})
+		}
+	}
+	data.AvailableCount = types.Int64Value(restInfo.AvailableCount)
+	data.TotalCount = types.Int64Value(restInfo.TotalCount)
+	return nil
+}
+
+func expandIPSubnetRanges(ranges []IPSubnetRangeResourceModel) *[]map[string]string {
+	result := make([]map[string]string, 0, len(ranges))
+	for _, ipRange := range ranges {
+		result = append(result, map[string]string{
+			"start": ipRange.Start.ValueString(),
+			"end":   ipRange.End.ValueString(),
+		})
+	}
+	return &result
+}
+
+func ipSubnetRangesEqual(first []IPSubnetRangeResourceModel, second []IPSubnetRangeResourceModel) bool {
+	if len(first) != len(second) {
+		return false
+	}
+	for index := range first {
+		if !first[index].Start.Equal(second[index].Start) || !first[index].End.Equal(second[index].End) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,93 @@
+package networking_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	ntest "github.com/netapp/terraform-provider-netapp-ontap/internal/provider"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetworkIPSubnetResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { ntest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ntest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccNetworkIPSubnetResourceConfig("acc_subnet", "10.10.20.29"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_network_ip_subnet.example", "name", "acc_subnet"),
+					resource.TestCheckResourceAttr("netapp-ontap_network_ip_subnet.example", "total_count", "10"),
+					resource.TestCheckResourceAttrSet("netapp-ontap_network_ip_subnet.example", "id"),
+				),
+			},
+			// Rename and extend the range in place
+			{
+				Config: testAccNetworkIPSubnetResourceConfig("acc_subnet_renamed", "10.10.20.39"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_network_ip_subnet.example", "name", "acc_subnet_renamed"),
+					resource.TestCheckResourceAttr("netapp-ontap_network_ip_subnet.example", "ip_ranges.0.end", "10.10.20.39"),
+					resource.TestCheckResourceAttr("netapp-ontap_network_ip_subnet.example", "total_count", "20"),
+				),
+			},
+			// Test importing a resource
+			{
+				ResourceName:  "netapp-ontap_network_ip_subnet.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s,%s", "acc_subnet_renamed", "Default", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_network_ip_subnet.example", "name", "acc_subnet_renamed"),
+				),
+			},
+			// Import with a bad id
+			{
+				ResourceName:  "netapp-ontap_network_ip_subnet.example",
+				ImportState:   true,
+				ImportStateId: "acc_subnet_renamed,cluster4",
+				ExpectError:   regexp.MustCompile("Unexpected Import Identifier"),
+			},
+		},
+	})
+}
+
+func testAccNetworkIPSubnetResourceConfig(name string, end string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_network_ip_subnet" "example" {
+  cx_profile_name = "cluster4"
+  name = "%s"
+  broadcast_domain = "Default"
+  address = "10.10.20.0"
+  netmask = 24
+  gateway = "10.10.20.1"
+  ip_ranges = [
+    {
+      start = "10.10.20.20"
+      end = "%s"
+    },
+  ]
+}
+`, host, admin, password, name, end)
+}
@@ -0,0 +1,80 @@
+package networking_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	ntest "github.com/netapp/terraform-provider-netapp-ontap/internal/provider"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetworkIPspaceResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { ntest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ntest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccNetworkIPspaceResourceConfig("acc_ipspace"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_network_ipspace.example", "name", "acc_ipspace"),
+					resource.TestCheckResourceAttrSet("netapp-ontap_network_ipspace.example", "id"),
+				),
+			},
+			// Rename in place
+			{
+				Config: testAccNetworkIPspaceResourceConfig("acc_ipspace_renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_network_ipspace.example", "name", "acc_ipspace_renamed"),
+				),
+			},
+			// Test importing a resource
+			{
+				ResourceName:  "netapp-ontap_network_ipspace.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s", "acc_ipspace_renamed", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_network_ipspace.example", "name", "acc_ipspace_renamed"),
+				),
+			},
+			// Import with a bad id
+			{
+				ResourceName:  "netapp-ontap_network_ipspace.example",
+				ImportState:   true,
+				ImportStateId: "acc_ipspace_renamed",
+				ExpectError:   regexp.MustCompile("Unexpected Import Identifier"),
+			},
+		},
+	})
+}
+
+func testAccNetworkIPspaceResourceConfig(name string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_network_ipspace" "example" {
+  cx_profile_name = "cluster4"
+  name = "%s"
+}
+`, host, admin, password, name)
+}
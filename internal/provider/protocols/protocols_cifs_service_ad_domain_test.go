@@ -0,0 +1,142 @@
+package protocols
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+const cifsServiceAPI = "protocols/cifs/services/f3a2b5c6-8d9e-11ee-b9d1-0242ac120002"
+
+// cifsAdDomain returns an ad_domain joined to fqdn in organizationalUnit with the test credentials
+func cifsAdDomain(fqdn string, organizationalUnit string) *AdDomainResourceModel {
+	return &AdDomainResourceModel{
+		OrganizationalUnit: types.StringValue(organizationalUnit),
+		User:               types.StringValue("administrator"),
+		Password:           types.StringValue("password"),
+		Fqdn:               types.StringValue(fqdn),
+	}
+}
+
+func TestCifsServiceAdDomainChange(t *testing.T) {
+	tests := []struct {
+		name       string
+		plan       *AdDomainResourceModel
+		state      *AdDomainResourceModel
+		wantRejoin bool
+		want       interfaces.AdDomainDataModel
+	}{
+		{
+			name:  "test_unchanged",
+			plan:  cifsAdDomain("example.com", "CN=Computers"),
+			state: cifsAdDomain("EXAMPLE.COM", "CN=Computers"),
+			want:  interfaces.AdDomainDataModel{},
+		},
+		{
+			name:  "test_credentials_only",
+			plan:  &AdDomainResourceModel{OrganizationalUnit: types.StringValue("CN=Computers"), User: types.StringValue("other"), Password: types.StringValue("other"), Fqdn: types.StringValue("example.com")},
+			state: cifsAdDomain("example.com", "CN=Computers"),
+			want:  interfaces.AdDomainDataModel{},
+		},
+		{
+			name:  "test_move_organizational_unit",
+			plan:  cifsAdDomain("example.com", "OU=Servers"),
+			state: cifsAdDomain("example.com", "CN=Computers"),
+			want:  interfaces.AdDomainDataModel{OrganizationalUnit: "OU=Servers", User: "administrator", Password: "password"},
+		},
+		{
+			name:       "test_rejoin",
+			plan:       cifsAdDomain("other.com", "CN=Computers"),
+			state:      cifsAdDomain("example.com", "CN=Computers"),
+			wantRejoin: true,
+			want:       interfaces.AdDomainDataModel{OrganizationalUnit: "CN=Computers", User: "administrator", Password: "password", Fqdn: "other.com"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rejoin, got := cifsServiceAdDomainChange(tt.plan, tt.state)
+			if rejoin != tt.wantRejoin {
+				t.Errorf("cifsServiceAdDomainChange() rejoin = %v, want %v", rejoin, tt.wantRejoin)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("cifsServiceAdDomainChange() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRejoinCifsService(t *testing.T) {
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	disable := restclient.MockResponse{ExpectedMethod: "PATCH", ExpectedURL: cifsServiceAPI, StatusCode: 200, Response: noRecords, Err: nil}
+	rejoined := restclient.MockResponse{ExpectedMethod: "PATCH", ExpectedURL: cifsServiceAPI, StatusCode: 200, Response: noRecords, Err: nil}
+	rejoinFailed := restclient.MockResponse{ExpectedMethod: "PATCH", ExpectedURL: cifsServiceAPI, StatusCode: 400, Response: noRecords, Err: fmt.Errorf("failed to join the domain")}
+	enable := restclient.MockResponse{ExpectedMethod: "PATCH", ExpectedURL: cifsServiceAPI, StatusCode: 200, Response: noRecords, Err: nil}
+	adDomain := interfaces.AdDomainDataModel{User: "administrator", Password: "password", Fqdn: "other.com"}
+
+	tests := []struct {
+		name      string
+		enabled   bool
+		responses []restclient.MockResponse
+		// wantEnabled holds the enabled value sent by each request
+		wantEnabled []bool
+		wantErr     bool
+	}{
+		{
+			name:        "test_rejoin",
+			enabled:     true,
+			responses:   []restclient.MockResponse{disable, rejoined},
+			wantEnabled: []bool{false, false},
+		},
+		{
+			name:        "test_rejoin_error_enables_again",
+			enabled:     true,
+			responses:   []restclient.MockResponse{disable, rejoinFailed, enable},
+			wantEnabled: []bool{false, false, true},
+			wantErr:     true,
+		},
+		{
+			name:        "test_rejoin_error_disabled_server",
+			enabled:     false,
+			responses:   []restclient.MockResponse{disable, rejoinFailed},
+			wantEnabled: []bool{false, false},
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := diag.Diagnostics{}
+			errorHandler := utils.NewErrorHandler(context.Background(), &diags)
+			r, err := restclient.NewRecordingMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = rejoinCifsService(errorHandler, *r, "f3a2b5c6-8d9e-11ee-b9d1-0242ac120002", false, tt.enabled, adDomain)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("rejoinCifsService() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			requests := r.MockRequests()
+			if len(requests) != len(tt.wantEnabled) {
+				t.Fatalf("rejoinCifsService() sent %d requests, want %d", len(requests), len(tt.wantEnabled))
+			}
+			for index, request := range requests {
+				if request.Body["enabled"] != tt.wantEnabled[index] {
+					t.Errorf("rejoinCifsService() request %d enabled = %v, want %v", index, request.Body["enabled"], tt.wantEnabled[index])
+				}
+			}
+			if got := requests[1].Body["ad_domain"].(map[string]interface{})["fqdn"]; got != "other.com" {
+				t.Errorf("rejoinCifsService() rejoin fqdn = %v, want other.com", got)
+			}
+			// only the rejoin error is reported
+			if tt.wantErr && diags.ErrorsCount() != 1 {
+				t.Errorf("rejoinCifsService() reported %d errors, want 1", diags.ErrorsCount())
+			}
+		})
+	}
+}
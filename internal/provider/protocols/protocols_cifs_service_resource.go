@@ -6,6 +6,7 @@ import (
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
@@ -24,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
 )
 
@@ -47,17 +50,18 @@ type CifsServiceResource struct {
 
 // CifsServiceResourceModel describes the resource data model.
 type CifsServiceResourceModel struct {
-	CxProfileName   types.String           `tfsdk:"cx_profile_name"`
-	Name            types.String           `tfsdk:"name"`
-	SVMName         types.String           `tfsdk:"svm_name"`
-	AdDomain        *AdDomainResourceModel `tfsdk:"ad_domain"`
-	Netbios         types.Object           `tfsdk:"netbios"`
-	Security        types.Object           `tfsdk:"security"`
-	Comment         types.String           `tfsdk:"comment"`
-	DefaultUnixUser types.String           `tfsdk:"default_unix_user"`
-	Enabled         types.Bool             `tfsdk:"enabled"`
-	Force           types.Bool             `tfsdk:"force"`
-	ID              types.String           `tfsdk:"id"`
+	CxProfileName    types.String                       `tfsdk:"cx_profile_name"`
+	Name             types.String                       `tfsdk:"name"`
+	SVMName          types.String                       `tfsdk:"svm_name"`
+	AdDomain         *AdDomainResourceModel             `tfsdk:"ad_domain"`
+	PasswordSchedule *CifsPasswordScheduleResourceModel `tfsdk:"password_schedule"`
+	Netbios          types.Object                       `tfsdk:"netbios"`
+	Security         types.Object                       `tfsdk:"security"`
+	Comment          types.String                       `tfsdk:"comment"`
+	DefaultUnixUser  types.String                       `tfsdk:"default_unix_user"`
+	Enabled          types.Bool                         `tfsdk:"enabled"`
+	Force            types.Bool                         `tfsdk:"force"`
+	ID               types.String                       `tfsdk:"id"`
 }
 
 // AdDomainResourceModel describes the ad_domain data model using go types for mapping.
@@ -68,6 +72,15 @@ type AdDomainResourceModel struct {
 	Fqdn               types.String `tfsdk:"fqdn"`
 }
 
+// CifsPasswordScheduleResourceModel describes the machine account password schedule resource model.
+type CifsPasswordScheduleResourceModel struct {
+	ScheduleEnabled          types.Bool   `tfsdk:"schedule_enabled"`
+	ScheduleWeeklyInterval   types.Int64  `tfsdk:"schedule_weekly_interval"`
+	ScheduleRandomizedMinute types.Int64  `tfsdk:"schedule_randomized_minute"`
+	ScheduleDayOfWeek        types.String `tfsdk:"schedule_day_of_week"`
+	ScheduleStartTime        types.String `tfsdk:"schedule_start_time"`
+}
+
 // CifsNetbiosResourceModel describes the netbios resource model using go types for mapping.
 type CifsNetbiosResourceModel struct {
 	Enabled     types.Bool `tfsdk:"enabled"`
@@ -126,20 +139,71 @@ func (r *CifsServiceResource) Schema(ctx context.Context, req resource.SchemaReq
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
 						},
-						MarkdownDescription: "Organizational unit",
+						MarkdownDescription: "Organizational unit. Changing it moves the machine account to the new organizational unit",
 					},
 					"user": schema.StringAttribute{
 						Required:            true,
-						MarkdownDescription: "User account with the access to add the CIFS server to the Active Directory",
+						MarkdownDescription: "User account with the access to add the CIFS server to the Active Directory. Only sent to ONTAP when joining, moving, rejoining or deleting the CIFS server",
 					},
 					"password": schema.StringAttribute{
 						Required:            true,
 						Sensitive:           true,
-						MarkdownDescription: "Account password used to add this CIFS server to the Active Directory",
+						MarkdownDescription: "Account password used to add this CIFS server to the Active Directory. Only sent to ONTAP when joining, moving, rejoining or deleting the CIFS server",
 					},
 					"fqdn": schema.StringAttribute{
 						Required:            true,
-						MarkdownDescription: " Fully qualified domain name of the Windows Active Directory to which this CIFS server belongs",
+						MarkdownDescription: " Fully qualified domain name of the Windows Active Directory to which this CIFS server belongs. Changing it disables the CIFS server and rejoins it to the new domain",
+					},
+				},
+			},
+			"password_schedule": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Schedule to automatically reset the machine account password in the Active Directory (9.10)",
+				Attributes: map[string]schema.Attribute{
+					"schedule_enabled": schema.BoolAttribute{
+						Required:            true,
+						MarkdownDescription: "Specifies whether the machine account password is reset on a schedule",
+					},
+					"schedule_weekly_interval": schema.Int64Attribute{
+						Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.Int64{
+							int64validator.Between(1, 52),
+						},
+						MarkdownDescription: "Number of weeks between password resets",
+					},
+					"schedule_randomized_minute": schema.Int64Attribute{
+						Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.Int64{
+							int64validator.Between(1, 180),
+						},
+						MarkdownDescription: "Minutes of random delay added to the start time",
+					},
+					"schedule_day_of_week": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.String{
+							stringvalidator.OneOf("sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"),
+						},
+						MarkdownDescription: "Day of the week on which the password is reset",
+					},
+					"schedule_start_time": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+						MarkdownDescription: "Start time of the password reset, in HH:MM:SS format",
 					},
 				},
 			},
@@ -476,6 +540,19 @@ func (r *CifsServiceResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 	data.Security = objectValue
 
+	// the password schedule is only refreshed when it is managed by this resource
+	if data.PasswordSchedule != nil {
+		svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+		if err != nil {
+			return
+		}
+		schedule, err := interfaces.GetCifsDomainPasswordSchedule(errorHandler, *client, svm.UUID)
+		if err != nil {
+			return
+		}
+		data.PasswordSchedule = flattenCifsPasswordSchedule(schedule)
+	}
+
 	// Set the ID
 	data.ID = types.StringValue(fmt.Sprintf("%s_%s_%s", data.CxProfileName.ValueString(), data.SVMName.ValueString(), data.Name.ValueString()))
 
@@ -735,6 +812,18 @@ func (r *CifsServiceResource) Create(ctx context.Context, req resource.CreateReq
 	}
 	data.Security = objectValue
 
+	if data.PasswordSchedule != nil {
+		svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+		if err != nil {
+			return
+		}
+		schedule, err := applyCifsPasswordSchedule(errorHandler, *client, svm.UUID, data.PasswordSchedule)
+		if err != nil {
+			return
+		}
+		data.PasswordSchedule = flattenCifsPasswordSchedule(schedule)
+	}
+
 	tflog.Trace(ctx, "created a resource")
 
 	// Save data into Terraform state
@@ -786,18 +875,16 @@ func (r *CifsServiceResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 	body.Enabled = plan.Enabled.ValueBool()
 
-	if !plan.AdDomain.Fqdn.Equal(state.AdDomain.Fqdn) {
-		body.AdDomain.Fqdn = plan.AdDomain.Fqdn.ValueString()
-	}
-	if !plan.AdDomain.User.Equal(state.AdDomain.User) {
-		body.AdDomain.User = plan.AdDomain.User.ValueString()
-	}
-	if !plan.AdDomain.Password.Equal(state.AdDomain.Password) {
-		body.AdDomain.Password = plan.AdDomain.Password.ValueString()
-	}
-
-	if !plan.AdDomain.OrganizationalUnit.Equal(state.AdDomain.OrganizationalUnit) {
-		body.AdDomain.OrganizationalUnit = plan.AdDomain.OrganizationalUnit.ValueString()
+	// The credentials are only used to authenticate the changes below; rotating them alone does not require any change in ONTAP.
+	rejoin, adDomain := cifsServiceAdDomainChange(plan.AdDomain, state.AdDomain)
+	if rejoin {
+		err = rejoinCifsService(errorHandler, *client, svm.UUID, plan.Force.ValueBool(), state.Enabled.ValueBool(), adDomain)
+		if err != nil {
+			return
+		}
+	} else {
+		// moving the machine account to another organizational unit, if any
+		body.AdDomain = adDomain
 	}
 	if !plan.Comment.Equal(state.Comment) {
 		body.Comment = plan.Comment.ValueString()
@@ -887,6 +974,21 @@ func (r *CifsServiceResource) Update(ctx context.Context, req resource.UpdateReq
 	if err != nil {
 		return
 	}
+
+	if plan.PasswordSchedule != nil && (state.PasswordSchedule == nil || !plan.PasswordSchedule.equal(*state.PasswordSchedule)) {
+		schedule, err := applyCifsPasswordSchedule(errorHandler, *client, svm.UUID, plan.PasswordSchedule)
+		if err != nil {
+			return
+		}
+		plan.PasswordSchedule = flattenCifsPasswordSchedule(schedule)
+	}
+	if !strings.EqualFold(plan.AdDomain.Fqdn.ValueString(), state.AdDomain.Fqdn.ValueString()) || !plan.AdDomain.OrganizationalUnit.Equal(state.AdDomain.OrganizationalUnit) {
+		restInfo, err := interfaces.GetCifsServiceByName(errorHandler, *client, plan.Name.ValueString())
+		if err != nil {
+			return
+		}
+		plan.AdDomain.OrganizationalUnit = types.StringValue(restInfo.AdDomain.OrganizationalUnit)
+	}
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -931,6 +1033,91 @@ func (r *CifsServiceResource) Delete(ctx context.Context, req resource.DeleteReq
 
 }
 
+// cifsServiceAdDomainChange returns the ad_domain to send for the changes between the plan and the state.
+// rejoin is true when the fqdn changes, otherwise adDomain moves the machine account when the organizational unit changes and is empty when it does not.
+func cifsServiceAdDomainChange(plan *AdDomainResourceModel, state *AdDomainResourceModel) (bool, interfaces.AdDomainDataModel) {
+	var adDomain interfaces.AdDomainDataModel
+	if !strings.EqualFold(plan.Fqdn.ValueString(), state.Fqdn.ValueString()) {
+		adDomain.Fqdn = plan.Fqdn.ValueString()
+		adDomain.User = plan.User.ValueString()
+		adDomain.Password = plan.Password.ValueString()
+		if !plan.OrganizationalUnit.IsUnknown() {
+			adDomain.OrganizationalUnit = plan.OrganizationalUnit.ValueString()
+		}
+		return true, adDomain
+	}
+	if !plan.OrganizationalUnit.IsUnknown() && !plan.OrganizationalUnit.Equal(state.OrganizationalUnit) {
+		adDomain.OrganizationalUnit = plan.OrganizationalUnit.ValueString()
+		adDomain.User = plan.User.ValueString()
+		adDomain.Password = plan.Password.ValueString()
+	}
+	return false, adDomain
+}
+
+// rejoinCifsService disables the CIFS server and rejoins it to another domain.
+// When the rejoin fails, a server that was enabled is enabled again on a best effort basis.
+func rejoinCifsService(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, force bool, enabled bool, adDomain interfaces.AdDomainDataModel) error {
+	// rejoining a different domain requires the CIFS server to be administratively disabled
+	err := interfaces.UpdateCifsService(errorHandler, r, svmUUID, false, interfaces.CifsServiceResourceBodyDataModelONTAP{Enabled: false})
+	if err != nil {
+		return err
+	}
+	err = interfaces.UpdateCifsService(errorHandler, r, svmUUID, force, interfaces.CifsServiceResourceBodyDataModelONTAP{AdDomain: adDomain})
+	if err != nil {
+		if enabled {
+			// the rejoin error is the one reported, a failure to enable the server again is only logged
+			bestEffort := utils.NewErrorHandler(errorHandler.Ctx, &diag.Diagnostics{})
+			if enableErr := interfaces.UpdateCifsService(bestEffort, r, svmUUID, false, interfaces.CifsServiceResourceBodyDataModelONTAP{Enabled: true}); enableErr != nil {
+				tflog.Warn(errorHandler.Ctx, fmt.Sprintf("failed to enable the CIFS server again after the rejoin failed: %s", enableErr))
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+// equal compares the password schedules attribute by attribute.
+func (m CifsPasswordScheduleResourceModel) equal(other CifsPasswordScheduleResourceModel) bool {
+	return m.ScheduleEnabled.Equal(other.ScheduleEnabled) &&
+		m.ScheduleWeeklyInterval.Equal(other.ScheduleWeeklyInterval) &&
+		m.ScheduleRandomizedMinute.Equal(other.ScheduleRandomizedMinute) &&
+		m.ScheduleDayOfWeek.Equal(other.ScheduleDayOfWeek) &&
+		m.ScheduleStartTime.Equal(other.ScheduleStartTime)
+}
+
+// applyCifsPasswordSchedule sets the machine account password schedule and returns the schedule as reported by ONTAP.
+func applyCifsPasswordSchedule(errorHandler *utils.ErrorHandler, r restclient.RestClient, svmUUID string, data *CifsPasswordScheduleResourceModel) (*interfaces.CifsDomainPasswordScheduleDataModelONTAP, error) {
+	var body interfaces.CifsDomainPasswordScheduleDataModelONTAP
+	body.ScheduleEnabled = data.ScheduleEnabled.ValueBool()
+	if !data.ScheduleWeeklyInterval.IsUnknown() && !data.ScheduleWeeklyInterval.IsNull() {
+		body.ScheduleWeeklyInterval = data.ScheduleWeeklyInterval.ValueInt64()
+	}
+	if !data.ScheduleRandomizedMinute.IsUnknown() && !data.ScheduleRandomizedMinute.IsNull() {
+		body.ScheduleRandomizedMinute = data.ScheduleRandomizedMinute.ValueInt64()
+	}
+	if !data.ScheduleDayOfWeek.IsUnknown() && !data.ScheduleDayOfWeek.IsNull() {
+		body.ScheduleDayOfWeek = data.ScheduleDayOfWeek.ValueString()
+	}
+	if !data.ScheduleStartTime.IsUnknown() && !data.ScheduleStartTime.IsNull() {
+		body.ScheduleStartTime = data.ScheduleStartTime.ValueString()
+	}
+	if err := interfaces.UpdateCifsDomainPasswordSchedule(errorHandler, r, svmUUID, body); err != nil {
+		return nil, err
+	}
+	return interfaces.GetCifsDomainPasswordSchedule(errorHandler, r, svmUUID)
+}
+
+// flattenCifsPasswordSchedule converts the ONTAP password schedule to the resource model.
+func flattenCifsPasswordSchedule(schedule *interfaces.CifsDomainPasswordScheduleDataModelONTAP) *CifsPasswordScheduleResourceModel {
+	return &CifsPasswordScheduleResourceModel{
+		ScheduleEnabled:          types.BoolValue(schedule.ScheduleEnabled),
+		ScheduleWeeklyInterval:   types.Int64Value(schedule.ScheduleWeeklyInterval),
+		ScheduleRandomizedMinute: types.Int64Value(schedule.ScheduleRandomizedMinute),
+		ScheduleDayOfWeek:        types.StringValue(strings.ToLower(schedule.ScheduleDayOfWeek)),
+		ScheduleStartTime:        types.StringValue(schedule.ScheduleStartTime),
+	}
+}
+
 // ImportState imports a resource using ID from terraform import command by calling the Read method.
 func (r *CifsServiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	tflog.Debug(ctx, fmt.Sprintf("import req a protocols cifs service resource: %#v", req))
@@ -37,6 +37,15 @@ func TestAccCifsServiceResource(t *testing.T) {
 					resource.TestCheckResourceAttr("netapp-ontap_cifs_service.example", "security.lm_compatibility_level", "ntlm_ntlmv2_krb"),
 				),
 			},
+			// move the machine account and schedule the password reset
+			{
+				Config: testAccCifsServiceResourceLifecycleConfig("tftestcifs", "testSVM", "CN=Computers"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_cifs_service.example", "ad_domain.organizational_unit", "CN=Computers"),
+					resource.TestCheckResourceAttr("netapp-ontap_cifs_service.example", "password_schedule.schedule_enabled", "true"),
+					resource.TestCheckResourceAttr("netapp-ontap_cifs_service.example", "password_schedule.schedule_weekly_interval", "4"),
+				),
+			},
 			// Import and read
 			{
 				ResourceName:  "netapp-ontap_cifs_service.example",
@@ -129,3 +138,45 @@ resource "netapp-ontap_cifs_service" "example" {
 }
 `, host, admin, password, svmName, name, cifspassword)
 }
+
+func testAccCifsServiceResourceLifecycleConfig(name string, svmName string, organizationalUnit string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST_CIFS")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS_CIFS")
+	cifspassword := os.Getenv("TF_ACC_NETAPP_CIFS_ADDOMAIN_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST_CIFS, TF_ACC_NETAPP_USER, TF_ACC_NETAPP_PASS_CIFS and TF_ACC_NETAPP_CIFS_ADDOMAIN_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+	connection_profiles = [
+		{
+			name = "clustercifs"
+			hostname = "%s"
+			username = "%s"
+			password = "%s"
+			validate_certs = false
+		},
+	]
+}
+resource "netapp-ontap_cifs_service" "example" {
+	cx_profile_name = "clustercifs"
+	svm_name = "%s"
+	name = "%s"
+	ad_domain = {
+		fqdn = "mytfdomain.com"
+		organizational_unit = "%s"
+		user = "cifstest"
+		password = "%s"
+	}
+	security = {
+		lm_compatibility_level = "ntlm_ntlmv2_krb"
+	}
+	password_schedule = {
+		schedule_enabled = true
+		schedule_weekly_interval = 4
+	}
+}
+`, host, admin, password, svmName, name, organizationalUnit, cifspassword)
+}
@@ -0,0 +1,74 @@
+package protocols_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	ntest "github.com/netapp/terraform-provider-netapp-ontap/internal/provider"
+)
+
+func TestAccProtocolsCIFSShareACLResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { ntest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ntest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read
+			{
+				Config: testAccProtocolsCIFSShareACLResourceConfig("read"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_cifs_share_acl.example", "share_name", "acc_test_cifs_share"),
+					resource.TestCheckResourceAttr("netapp-ontap_cifs_share_acl.example", "type", "windows"),
+					resource.TestCheckResourceAttr("netapp-ontap_cifs_share_acl.example", "permission", "read"),
+				),
+			},
+			// Update permission in place
+			{
+				Config: testAccProtocolsCIFSShareACLResourceConfig("change"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_cifs_share_acl.example", "permission", "change"),
+				),
+			},
+			// Import and read
+			{
+				ResourceName:  "netapp-ontap_cifs_share_acl.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s,%s,%s,%s", "tfsvm", "acc_test_cifs_share", "BUILTIN\\Users", "windows", "clustercifs"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_cifs_share_acl.example", "permission", "change"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProtocolsCIFSShareACLResourceConfig(permission string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST_CIFS")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS2")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST_CIFS, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS2 must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "clustercifs"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_cifs_share_acl" "example" {
+  cx_profile_name = "clustercifs"
+  svm_name = "tfsvm"
+  share_name = "acc_test_cifs_share"
+  user_or_group = "BUILTIN\\Users"
+  permission = "%s"
+}`, host, admin, password, permission)
+}
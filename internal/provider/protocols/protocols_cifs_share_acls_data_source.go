@@ -0,0 +1,197 @@
+package protocols
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ProtocolsCIFSShareAclsDataSource{}
+
+// NewProtocolsCIFSShareAclsDataSource is a helper function to simplify the provider implementation.
+func NewProtocolsCIFSShareAclsDataSource() datasource.DataSource {
+	return &ProtocolsCIFSShareAclsDataSource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "cifs_share_acls",
+		},
+	}
+}
+
+// ProtocolsCIFSShareAclsDataSource defines the data source implementation.
+type ProtocolsCIFSShareAclsDataSource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// ProtocolsCIFSShareAclsDataSourceModel describes the data source data model.
+type ProtocolsCIFSShareAclsDataSourceModel struct {
+	CxProfileName          types.String                                 `tfsdk:"cx_profile_name"`
+	SVMName                types.String                                 `tfsdk:"svm_name"`
+	ShareName              types.String                                 `tfsdk:"share_name"`
+	ProtocolsCIFSShareAcls []ProtocolsCIFSShareACLDataSourceModel       `tfsdk:"protocols_cifs_share_acls"`
+	Filter                 *ProtocolsCIFSShareAclsDataSourceFilterModel `tfsdk:"filter"`
+}
+
+// ProtocolsCIFSShareAclsDataSourceFilterModel describes the data source data model for queries.
+type ProtocolsCIFSShareAclsDataSourceFilterModel struct {
+	UserOrGroup types.String `tfsdk:"user_or_group"`
+	Type        types.String `tfsdk:"type"`
+	Permission  types.String `tfsdk:"permission"`
+}
+
+// Metadata returns the data source type name.
+func (d *ProtocolsCIFSShareAclsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.Name
+}
+
+// Schema defines the schema for the data source.
+func (d *ProtocolsCIFSShareAclsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsCIFSShareAcls data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "svm name",
+				Required:            true,
+			},
+			"share_name": schema.StringAttribute{
+				MarkdownDescription: "CIFS share name",
+				Required:            true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"user_or_group": schema.StringAttribute{
+						MarkdownDescription: "Specifies the user or group name on the access control list of a CIFS share.",
+						Optional:            true,
+					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Specifies the type of the user or group.",
+						Optional:            true,
+					},
+					"permission": schema.StringAttribute{
+						MarkdownDescription: "Specifies the access rights that a user or group has on the defined CIFS Share.",
+						Optional:            true,
+					},
+				},
+				Optional: true,
+			},
+			"protocols_cifs_share_acls": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cx_profile_name": schema.StringAttribute{
+							MarkdownDescription: "Connection profile name",
+							Required:            true,
+						},
+						"svm_name": schema.StringAttribute{
+							MarkdownDescription: "svm name",
+							Required:            true,
+						},
+						"share_name": schema.StringAttribute{
+							MarkdownDescription: "CIFS share name",
+							Required:            true,
+						},
+						"user_or_group": schema.StringAttribute{
+							MarkdownDescription: "Specifies the user or group name on the access control list of a CIFS share.",
+							Required:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Specifies the type of the user or group.",
+							Computed:            true,
+						},
+						"permission": schema.StringAttribute{
+							MarkdownDescription: "Specifies the access rights that a user or group has on the defined CIFS Share.",
+							Computed:            true,
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ProtocolsCIFSShareAclsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ProtocolsCIFSShareAclsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProtocolsCIFSShareAclsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetSvmByName
+		return
+	}
+
+	var filter *interfaces.ProtocolsCIFSShareACLDataSourceFilterModel = nil
+	if data.Filter != nil {
+		filter = &interfaces.ProtocolsCIFSShareACLDataSourceFilterModel{
+			UserOrGroup: data.Filter.UserOrGroup.ValueString(),
+			Type:        data.Filter.Type.ValueString(),
+			Permission:  data.Filter.Permission.ValueString(),
+		}
+	}
+	restInfo, err := interfaces.GetProtocolsCIFSShareAcls(errorHandler, *client, filter, svm.UUID, data.ShareName.ValueString())
+	if err != nil {
+		// error reporting done inside GetProtocolsCIFSShareAcls
+		return
+	}
+
+	data.ProtocolsCIFSShareAcls = make([]ProtocolsCIFSShareACLDataSourceModel, len(restInfo))
+	for index, record := range restInfo {
+		data.ProtocolsCIFSShareAcls[index] = ProtocolsCIFSShareACLDataSourceModel{
+			CxProfileName: data.CxProfileName,
+			SVMName:       data.SVMName,
+			ShareName:     data.ShareName,
+			UserOrGroup:   types.StringValue(record.UserOrGroup),
+			Type:          types.StringValue(record.Type),
+			Permission:    types.StringValue(record.Permission),
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,327 @@
+package protocols
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ProtocolsFpolicyEngineResource{}
+var _ resource.ResourceWithImportState = &ProtocolsFpolicyEngineResource{}
+
+// NewProtocolsFpolicyEngineResource is a helper function to simplify the provider implementation.
+func NewProtocolsFpolicyEngineResource() resource.Resource {
+	return &ProtocolsFpolicyEngineResource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "fpolicy_engine",
+		},
+	}
+}
+
+// ProtocolsFpolicyEngineResource defines the resource implementation.
+type ProtocolsFpolicyEngineResource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// ProtocolsFpolicyEngineResourceModel describes the resource data model.
+type ProtocolsFpolicyEngineResourceModel struct {
+	CxProfileName    types.String   `tfsdk:"cx_profile_name"`
+	SVMName          types.String   `tfsdk:"svm_name"`
+	Name             types.String   `tfsdk:"name"`
+	PrimaryServers   []types.String `tfsdk:"primary_servers"`
+	SecondaryServers []types.String `tfsdk:"secondary_servers"`
+	Port             types.Int64    `tfsdk:"port"`
+	Type             types.String   `tfsdk:"type"`
+	SslOption        types.String   `tfsdk:"ssl_option"`
+	ID               types.String   `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *ProtocolsFpolicyEngineResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.Name
+}
+
+// Schema defines the schema for the resource.
+func (r *ProtocolsFpolicyEngineResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsFpolicyEngine resource",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the SVM",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "External engine name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"primary_servers": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "IP addresses of the primary FPolicy servers",
+				Required:            true,
+			},
+			"secondary_servers": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "IP addresses of the secondary FPolicy servers. Not managed when omitted",
+				Optional:            true,
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "Port number of the FPolicy servers",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Notification mode of the engine. One of synchronous, asynchronous",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("synchronous"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("synchronous", "asynchronous"),
+				},
+			},
+			"ssl_option": schema.StringAttribute{
+				MarkdownDescription: "SSL option for the connection to the FPolicy servers. One of no_auth, server_auth, mutual_auth",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("no_auth"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("no_auth", "server_auth", "mutual_auth"),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "External engine identifier, svm_name/name",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ProtocolsFpolicyEngineResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ProtocolsFpolicyEngineResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProtocolsFpolicyEngineResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	restInfo, err := interfaces.GetProtocolsFpolicyEngineByName(errorHandler, *client, svm.UUID, data.Name.ValueString())
+	if err != nil {
+		// error reporting done inside GetProtocolsFpolicyEngineByName
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No FPolicy engine %s found on svm %s", data.Name.ValueString(), data.SVMName.ValueString()))
+		return
+	}
+
+	data.Name = types.StringValue(restInfo.Name)
+	data.PrimaryServers = stringsToTypes(restInfo.PrimaryServers)
+	// secondary servers are only refreshed when they are managed
+	data.SecondaryServers = refreshManagedStrings(data.SecondaryServers, restInfo.SecondaryServers)
+	data.Port = types.Int64Value(restInfo.Port)
+	data.Type = types.StringValue(restInfo.Type)
+	data.SslOption = types.StringValue(restInfo.SslOption)
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.SVMName.ValueString(), restInfo.Name))
+
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a resource and retrieve UUID
+func (r *ProtocolsFpolicyEngineResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ProtocolsFpolicyEngineResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+
+	var body interfaces.ProtocolsFpolicyEngineResourceBodyDataModelONTAP
+	body.Name = data.Name.ValueString()
+	body.PrimaryServers = typesToStrings(data.PrimaryServers)
+	body.SecondaryServers = typesToStrings(data.SecondaryServers)
+	body.Port = data.Port.ValueInt64()
+	body.Type = data.Type.ValueString()
+	body.SslOption = data.SslOption.ValueString()
+
+	err = interfaces.CreateProtocolsFpolicyEngine(errorHandler, *client, svm.UUID, body)
+	if err != nil {
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.SVMName.ValueString(), data.Name.ValueString()))
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ProtocolsFpolicyEngineResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *ProtocolsFpolicyEngineResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, plan.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, state.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+
+	var body interfaces.ProtocolsFpolicyEngineUpdateBodyDataModelONTAP
+	body.PrimaryServers = typesToStrings(plan.PrimaryServers)
+	body.SecondaryServers = typesToStrings(plan.SecondaryServers)
+	body.Port = plan.Port.ValueInt64()
+	if !plan.Type.Equal(state.Type) {
+		body.Type = plan.Type.ValueString()
+	}
+	if !plan.SslOption.Equal(state.SslOption) {
+		body.SslOption = plan.SslOption.ValueString()
+	}
+
+	err = interfaces.UpdateProtocolsFpolicyEngine(errorHandler, *client, svm.UUID, state.Name.ValueString(), body)
+	if err != nil {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ProtocolsFpolicyEngineResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ProtocolsFpolicyEngineResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+
+	err = interfaces.DeleteProtocolsFpolicyEngine(errorHandler, *client, svm.UUID, data.Name.ValueString())
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *ProtocolsFpolicyEngineResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: name,svm_name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
+}
@@ -0,0 +1,361 @@
+package protocols
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ProtocolsFpolicyEventResource{}
+var _ resource.ResourceWithImportState = &ProtocolsFpolicyEventResource{}
+
+var fpolicyFileOperations = []string{"close", "create", "create_dir", "delete", "delete_dir", "getattr", "link", "lookup",
+	"open", "read", "write", "rename", "rename_dir", "setattr", "symlink"}
+
+var fpolicyFilters = []string{"monitor_ads", "close_with_modification", "close_without_modification", "close_with_read",
+	"first_read", "first_write", "offline_bit", "open_with_delete_intent", "open_with_write_intent", "write_with_size_change",
+	"setattr_with_owner_change", "setattr_with_group_change", "setattr_with_sacl_change", "setattr_with_dacl_change",
+	"setattr_with_modify_time_change", "setattr_with_access_time_change", "setattr_with_creation_time_change",
+	"setattr_with_mode_change", "setattr_with_size_change", "setattr_with_allocation_size_change", "exclude_directory"}
+
+// NewProtocolsFpolicyEventResource is a helper function to simplify the provider implementation.
+func NewProtocolsFpolicyEventResource() resource.Resource {
+	return &ProtocolsFpolicyEventResource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "fpolicy_event",
+		},
+	}
+}
+
+// ProtocolsFpolicyEventResource defines the resource implementation.
+type ProtocolsFpolicyEventResource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// ProtocolsFpolicyEventResourceModel describes the resource data model.
+type ProtocolsFpolicyEventResourceModel struct {
+	CxProfileName    types.String   `tfsdk:"cx_profile_name"`
+	SVMName          types.String   `tfsdk:"svm_name"`
+	Name             types.String   `tfsdk:"name"`
+	Protocol         types.String   `tfsdk:"protocol"`
+	VolumeMonitoring types.Bool     `tfsdk:"volume_monitoring"`
+	FileOperations   []types.String `tfsdk:"file_operations"`
+	Filters          []types.String `tfsdk:"filters"`
+	ID               types.String   `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *ProtocolsFpolicyEventResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.Name
+}
+
+// Schema defines the schema for the resource.
+func (r *ProtocolsFpolicyEventResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsFpolicyEvent resource",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the SVM",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "FPolicy event name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "Protocol the event is monitored on. One of cifs, nfsv3, nfsv4",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("cifs", "nfsv3", "nfsv4"),
+				},
+			},
+			"volume_monitoring": schema.BoolAttribute{
+				MarkdownDescription: "Whether volume mount and unmount operations are monitored",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"file_operations": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: fmt.Sprintf("File operations to monitor. Any of %s", strings.Join(fpolicyFileOperations, ", ")),
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(fpolicyFileOperations...)),
+				},
+			},
+			"filters": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: fmt.Sprintf("Filters applied to the monitored file operations. Any of %s", strings.Join(fpolicyFilters, ", ")),
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(fpolicyFilters...)),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "FPolicy event identifier, svm_name/name",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ProtocolsFpolicyEventResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ProtocolsFpolicyEventResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProtocolsFpolicyEventResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	restInfo, err := interfaces.GetProtocolsFpolicyEventByName(errorHandler, *client, svm.UUID, data.Name.ValueString())
+	if err != nil {
+		// error reporting done inside GetProtocolsFpolicyEventByName
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No FPolicy event %s found on svm %s", data.Name.ValueString(), data.SVMName.ValueString()))
+		return
+	}
+
+	data.Name = types.StringValue(restInfo.Name)
+	if restInfo.Protocol != "" {
+		data.Protocol = types.StringValue(restInfo.Protocol)
+	} else {
+		data.Protocol = types.StringNull()
+	}
+	data.VolumeMonitoring = types.BoolValue(restInfo.VolumeMonitoring)
+	data.FileOperations = fpolicyFlagsToTypes(data.FileOperations, restInfo.FileOperations)
+	data.Filters = fpolicyFlagsToTypes(data.Filters, restInfo.Filters)
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.SVMName.ValueString(), restInfo.Name))
+
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a resource and retrieve UUID
+func (r *ProtocolsFpolicyEventResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ProtocolsFpolicyEventResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+
+	var body interfaces.ProtocolsFpolicyEventResourceBodyDataModelONTAP
+	body.Name = data.Name.ValueString()
+	body.Protocol = data.Protocol.ValueString()
+	body.VolumeMonitoring = data.VolumeMonitoring.ValueBool()
+	body.FileOperations = fpolicyFlags(nil, data.FileOperations)
+	body.Filters = fpolicyFlags(nil, data.Filters)
+
+	err = interfaces.CreateProtocolsFpolicyEvent(errorHandler, *client, svm.UUID, body)
+	if err != nil {
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.SVMName.ValueString(), data.Name.ValueString()))
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ProtocolsFpolicyEventResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *ProtocolsFpolicyEventResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, plan.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, state.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+
+	var body interfaces.ProtocolsFpolicyEventUpdateBodyDataModelONTAP
+	if !plan.Protocol.Equal(state.Protocol) {
+		body.Protocol = plan.Protocol.ValueString()
+	}
+	if !plan.VolumeMonitoring.Equal(state.VolumeMonitoring) {
+		volumeMonitoring := plan.VolumeMonitoring.ValueBool()
+		body.VolumeMonitoring = &volumeMonitoring
+	}
+	body.FileOperations = fpolicyFlags(state.FileOperations, plan.FileOperations)
+	body.Filters = fpolicyFlags(state.Filters, plan.Filters)
+
+	err = interfaces.UpdateProtocolsFpolicyEvent(errorHandler, *client, svm.UUID, state.Name.ValueString(), body)
+	if err != nil {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ProtocolsFpolicyEventResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ProtocolsFpolicyEventResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+
+	err = interfaces.DeleteProtocolsFpolicyEvent(errorHandler, *client, svm.UUID, data.Name.ValueString())
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *ProtocolsFpolicyEventResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: name,svm_name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
+}
+
+// fpolicyFlags turns the configured names into ONTAP flags, names that are no longer configured are turned off
+func fpolicyFlags(previous []types.String, current []types.String) map[string]bool {
+	if len(previous) == 0 && len(current) == 0 {
+		return nil
+	}
+	flags := make(map[string]bool)
+	for _, name := range previous {
+		flags[name.ValueString()] = false
+	}
+	for _, name := range current {
+		flags[name.ValueString()] = true
+	}
+	return flags
+}
+
+// fpolicyFlagsToTypes returns the names of the flags that are on, an empty result is kept null unless configured
+func fpolicyFlagsToTypes(prior []types.String, flags map[string]bool) []types.String {
+	var names []string
+	for name, on := range flags {
+		if on {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 && prior == nil {
+		return nil
+	}
+	sort.Strings(names)
+	return refreshManagedStrings([]types.String{}, names)
+}
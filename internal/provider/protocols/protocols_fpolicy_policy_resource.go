@@ -0,0 +1,437 @@
+package protocols
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ProtocolsFpolicyPolicyResource{}
+var _ resource.ResourceWithImportState = &ProtocolsFpolicyPolicyResource{}
+
+// NewProtocolsFpolicyPolicyResource is a helper function to simplify the provider implementation.
+func NewProtocolsFpolicyPolicyResource() resource.Resource {
+	return &ProtocolsFpolicyPolicyResource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "fpolicy_policy",
+		},
+	}
+}
+
+// ProtocolsFpolicyPolicyResource defines the resource implementation.
+type ProtocolsFpolicyPolicyResource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// ProtocolsFpolicyPolicyResourceModel describes the resource data model.
+type ProtocolsFpolicyPolicyResourceModel struct {
+	CxProfileName types.String                     `tfsdk:"cx_profile_name"`
+	SVMName       types.String                     `tfsdk:"svm_name"`
+	Name          types.String                     `tfsdk:"name"`
+	EngineName    types.String                     `tfsdk:"engine_name"`
+	Events        []types.String                   `tfsdk:"events"`
+	Mandatory     types.Bool                       `tfsdk:"mandatory"`
+	Scope         *FpolicyPolicyScopeResourceModel `tfsdk:"scope"`
+	ID            types.String                     `tfsdk:"id"`
+}
+
+// FpolicyPolicyScopeResourceModel describes the scope of an FPolicy policy.
+type FpolicyPolicyScopeResourceModel struct {
+	IncludeVolumes                  []types.String `tfsdk:"include_volumes"`
+	ExcludeVolumes                  []types.String `tfsdk:"exclude_volumes"`
+	IncludeShares                   []types.String `tfsdk:"include_shares"`
+	ExcludeShares                   []types.String `tfsdk:"exclude_shares"`
+	IncludeExportPolicies           []types.String `tfsdk:"include_export_policies"`
+	ExcludeExportPolicies           []types.String `tfsdk:"exclude_export_policies"`
+	IncludeExtension                []types.String `tfsdk:"include_extension"`
+	ExcludeExtension                []types.String `tfsdk:"exclude_extension"`
+	ObjectMonitoringWithNoExtension types.Bool     `tfsdk:"object_monitoring_with_no_extension"`
+}
+
+// Metadata returns the resource type name.
+func (r *ProtocolsFpolicyPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.Name
+}
+
+// Schema defines the schema for the resource.
+func (r *ProtocolsFpolicyPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	scopeSet := func(description string) schema.SetAttribute {
+		return schema.SetAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: description + ". Not managed when omitted",
+			Optional:            true,
+		}
+	}
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsFpolicyPolicy resource",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the SVM",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "FPolicy policy name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"engine_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the external engine the notifications are sent to, native for the native engine",
+				Required:            true,
+			},
+			"events": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Names of the FPolicy events monitored by the policy",
+				Required:            true,
+			},
+			"mandatory": schema.BoolAttribute{
+				MarkdownDescription: "Whether file access is denied when no FPolicy server is available",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"scope": schema.SingleNestedAttribute{
+				MarkdownDescription: "Volumes, shares, export policies and file extensions the policy applies to. The scope is not managed when omitted",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"include_volumes":         scopeSet("Volumes that are monitored"),
+					"exclude_volumes":         scopeSet("Volumes that are not monitored"),
+					"include_shares":          scopeSet("Shares that are monitored"),
+					"exclude_shares":          scopeSet("Shares that are not monitored"),
+					"include_export_policies": scopeSet("Export policies that are monitored"),
+					"exclude_export_policies": scopeSet("Export policies that are not monitored"),
+					"include_extension":       scopeSet("File extensions that are monitored"),
+					"exclude_extension":       scopeSet("File extensions that are not monitored"),
+					"object_monitoring_with_no_extension": schema.BoolAttribute{
+						MarkdownDescription: "Whether files without an extension are monitored",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "FPolicy policy identifier, svm_name/name",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ProtocolsFpolicyPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ProtocolsFpolicyPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProtocolsFpolicyPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	restInfo, err := interfaces.GetProtocolsFpolicyPolicyByName(errorHandler, *client, svm.UUID, data.Name.ValueString())
+	if err != nil {
+		// error reporting done inside GetProtocolsFpolicyPolicyByName
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No FPolicy policy %s found on svm %s", data.Name.ValueString(), data.SVMName.ValueString()))
+		return
+	}
+
+	data.Name = types.StringValue(restInfo.Name)
+	data.EngineName = types.StringValue(restInfo.Engine.Name)
+	data.Events = make([]types.String, len(restInfo.Events))
+	for i, event := range restInfo.Events {
+		data.Events[i] = types.StringValue(event.Name)
+	}
+	data.Mandatory = types.BoolValue(restInfo.Mandatory)
+	// the scope is only refreshed when it is managed
+	if data.Scope != nil {
+		data.Scope = flattenFpolicyPolicyScope(data.Scope, restInfo.Scope)
+	}
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.SVMName.ValueString(), restInfo.Name))
+
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a resource and retrieve UUID
+func (r *ProtocolsFpolicyPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ProtocolsFpolicyPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+
+	var body interfaces.ProtocolsFpolicyPolicyResourceBodyDataModelONTAP
+	body.Name = data.Name.ValueString()
+	body.Engine.Name = data.EngineName.ValueString()
+	body.Events = fpolicyEventNames(data.Events)
+	body.Mandatory = data.Mandatory.ValueBool()
+	body.Scope = expandFpolicyPolicyScope(data.Scope)
+
+	err = interfaces.CreateProtocolsFpolicyPolicy(errorHandler, *client, svm.UUID, body)
+	if err != nil {
+		return
+	}
+
+	if data.Scope != nil {
+		// pick up the ONTAP default for the scope options that are not set
+		restInfo, err := interfaces.GetProtocolsFpolicyPolicyByName(errorHandler, *client, svm.UUID, data.Name.ValueString())
+		if err != nil {
+			return
+		}
+		if restInfo == nil {
+			errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No FPolicy policy %s found on svm %s after create", data.Name.ValueString(), data.SVMName.ValueString()))
+			return
+		}
+		data.Scope = flattenFpolicyPolicyScope(data.Scope, restInfo.Scope)
+	}
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.SVMName.ValueString(), data.Name.ValueString()))
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ProtocolsFpolicyPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *ProtocolsFpolicyPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, plan.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, state.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+
+	var body interfaces.ProtocolsFpolicyPolicyUpdateBodyDataModelONTAP
+	if !plan.EngineName.Equal(state.EngineName) {
+		body.Engine = &interfaces.NameDataModel{Name: plan.EngineName.ValueString()}
+	}
+	if !reflect.DeepEqual(plan.Events, state.Events) {
+		body.Events = fpolicyEventNames(plan.Events)
+	}
+	if !plan.Mandatory.Equal(state.Mandatory) {
+		mandatory := plan.Mandatory.ValueBool()
+		body.Mandatory = &mandatory
+	}
+	if plan.Scope != nil && !reflect.DeepEqual(plan.Scope, state.Scope) {
+		body.Scope = expandFpolicyPolicyScope(plan.Scope)
+	}
+	if !reflect.DeepEqual(body, interfaces.ProtocolsFpolicyPolicyUpdateBodyDataModelONTAP{}) {
+		err = interfaces.UpdateProtocolsFpolicyPolicy(errorHandler, *client, svm.UUID, state.Name.ValueString(), body)
+		if err != nil {
+			return
+		}
+	}
+
+	if plan.Scope != nil {
+		restInfo, err := interfaces.GetProtocolsFpolicyPolicyByName(errorHandler, *client, svm.UUID, state.Name.ValueString())
+		if err != nil {
+			return
+		}
+		if restInfo == nil {
+			errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No FPolicy policy %s found on svm %s", state.Name.ValueString(), state.SVMName.ValueString()))
+			return
+		}
+		plan.Scope = flattenFpolicyPolicyScope(plan.Scope, restInfo.Scope)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ProtocolsFpolicyPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ProtocolsFpolicyPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+
+	// an enabled policy cannot be deleted, it may have been enabled outside of netapp-ontap_fpolicy_policy_status
+	restInfo, err := interfaces.GetProtocolsFpolicyPolicyByName(errorHandler, *client, svm.UUID, data.Name.ValueString())
+	if err != nil {
+		return
+	}
+	if restInfo == nil {
+		// already gone
+		return
+	}
+	if restInfo.Enabled {
+		enabled := false
+		err = interfaces.UpdateProtocolsFpolicyPolicy(errorHandler, *client, svm.UUID, data.Name.ValueString(), interfaces.ProtocolsFpolicyPolicyUpdateBodyDataModelONTAP{Enabled: &enabled})
+		if err != nil {
+			return
+		}
+	}
+
+	err = interfaces.DeleteProtocolsFpolicyPolicy(errorHandler, *client, svm.UUID, data.Name.ValueString())
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *ProtocolsFpolicyPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: name,svm_name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
+}
+
+func fpolicyEventNames(events []types.String) []interfaces.NameDataModel {
+	names := make([]interfaces.NameDataModel, len(events))
+	for i, event := range events {
+		names[i] = interfaces.NameDataModel{Name: event.ValueString()}
+	}
+	return names
+}
+
+// expandFpolicyPolicyScope only sends the scope options that are set
+func expandFpolicyPolicyScope(scope *FpolicyPolicyScopeResourceModel) *interfaces.FpolicyPolicyScopeDataModel {
+	if scope == nil {
+		return nil
+	}
+	return &interfaces.FpolicyPolicyScopeDataModel{
+		IncludeVolumes:                  typesToStrings(scope.IncludeVolumes),
+		ExcludeVolumes:                  typesToStrings(scope.ExcludeVolumes),
+		IncludeShares:                   typesToStrings(scope.IncludeShares),
+		ExcludeShares:                   typesToStrings(scope.ExcludeShares),
+		IncludeExportPolicies:           typesToStrings(scope.IncludeExportPolicies),
+		ExcludeExportPolicies:           typesToStrings(scope.ExcludeExportPolicies),
+		IncludeExtension:                typesToStrings(scope.IncludeExtension),
+		ExcludeExtension:                typesToStrings(scope.ExcludeExtension),
+		ObjectMonitoringWithNoExtension: knownBoolPointer(scope.ObjectMonitoringWithNoExtension),
+	}
+}
+
+// flattenFpolicyPolicyScope only refreshes the lists that are managed
+func flattenFpolicyPolicyScope(prior *FpolicyPolicyScopeResourceModel, scope interfaces.FpolicyPolicyScopeDataModel) *FpolicyPolicyScopeResourceModel {
+	return &FpolicyPolicyScopeResourceModel{
+		IncludeVolumes:                  refreshManagedStrings(prior.IncludeVolumes, scope.IncludeVolumes),
+		ExcludeVolumes:                  refreshManagedStrings(prior.ExcludeVolumes, scope.ExcludeVolumes),
+		IncludeShares:                   refreshManagedStrings(prior.IncludeShares, scope.IncludeShares),
+		ExcludeShares:                   refreshManagedStrings(prior.ExcludeShares, scope.ExcludeShares),
+		IncludeExportPolicies:           refreshManagedStrings(prior.IncludeExportPolicies, scope.IncludeExportPolicies),
+		ExcludeExportPolicies:           refreshManagedStrings(prior.ExcludeExportPolicies, scope.ExcludeExportPolicies),
+		IncludeExtension:                refreshManagedStrings(prior.IncludeExtension, scope.IncludeExtension),
+		ExcludeExtension:                refreshManagedStrings(prior.ExcludeExtension, scope.ExcludeExtension),
+		ObjectMonitoringWithNoExtension: types.BoolPointerValue(scope.ObjectMonitoringWithNoExtension),
+	}
+}
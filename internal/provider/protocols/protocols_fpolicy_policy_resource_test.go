@@ -0,0 +1,113 @@
+package protocols_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	ntest "github.com/netapp/terraform-provider-netapp-ontap/internal/provider"
+)
+
+func TestAccFpolicyPolicyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { ntest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ntest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test error
+			{
+				Config:      testAccFpolicyPolicyResourceConfig("no_such_engine", false),
+				ExpectError: regexp.MustCompile("error creating protocols_fpolicy_policy"),
+			},
+			// Create and read, disabled
+			{
+				Config: testAccFpolicyPolicyResourceConfig("${netapp-ontap_fpolicy_engine.example.name}", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_fpolicy_engine.example", "port", "9000"),
+					resource.TestCheckResourceAttr("netapp-ontap_fpolicy_event.example", "file_operations.#", "2"),
+					resource.TestCheckResourceAttr("netapp-ontap_fpolicy_policy.example", "engine_name", "acc_test_engine"),
+					resource.TestCheckResourceAttr("netapp-ontap_fpolicy_policy.example", "scope.include_volumes.#", "1"),
+					resource.TestCheckResourceAttr("netapp-ontap_fpolicy_policy_status.example", "enabled", "false"),
+				),
+			},
+			// Enable the policy without touching its definition
+			{
+				Config: testAccFpolicyPolicyResourceConfig("${netapp-ontap_fpolicy_engine.example.name}", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_fpolicy_policy_status.example", "enabled", "true"),
+					resource.TestCheckResourceAttr("netapp-ontap_fpolicy_policy_status.example", "priority", "1"),
+				),
+			},
+			// Import and read
+			{
+				ResourceName:  "netapp-ontap_fpolicy_policy.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s,%s", "acc_test_policy", "svm0", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_fpolicy_policy.example", "engine_name", "acc_test_engine"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFpolicyPolicyResourceConfig(engineName string, enabled bool) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_fpolicy_engine" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "svm0"
+  name = "acc_test_engine"
+  primary_servers = ["10.10.10.10"]
+  port = 9000
+  type = "asynchronous"
+}
+
+resource "netapp-ontap_fpolicy_event" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "svm0"
+  name = "acc_test_event"
+  protocol = "cifs"
+  file_operations = ["create", "write"]
+  filters = ["first_write"]
+}
+
+resource "netapp-ontap_fpolicy_policy" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "svm0"
+  name = "acc_test_policy"
+  engine_name = "%s"
+  events = [netapp-ontap_fpolicy_event.example.name]
+  mandatory = false
+  scope = {
+    include_volumes = ["*"]
+  }
+}
+
+resource "netapp-ontap_fpolicy_policy_status" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "svm0"
+  policy_name = netapp-ontap_fpolicy_policy.example.name
+  enabled = %t
+  priority = 1
+}`, host, admin, password, engineName, enabled)
+}
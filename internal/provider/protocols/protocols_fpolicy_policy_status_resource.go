@@ -0,0 +1,292 @@
+package protocols
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ProtocolsFpolicyPolicyStatusResource{}
+var _ resource.ResourceWithImportState = &ProtocolsFpolicyPolicyStatusResource{}
+
+// NewProtocolsFpolicyPolicyStatusResource is a helper function to simplify the provider implementation.
+func NewProtocolsFpolicyPolicyStatusResource() resource.Resource {
+	return &ProtocolsFpolicyPolicyStatusResource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "fpolicy_policy_status",
+		},
+	}
+}
+
+// ProtocolsFpolicyPolicyStatusResource defines the resource implementation.
+type ProtocolsFpolicyPolicyStatusResource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// ProtocolsFpolicyPolicyStatusResourceModel describes the resource data model.
+type ProtocolsFpolicyPolicyStatusResourceModel struct {
+	CxProfileName types.String `tfsdk:"cx_profile_name"`
+	SVMName       types.String `tfsdk:"svm_name"`
+	PolicyName    types.String `tfsdk:"policy_name"`
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	Priority      types.Int64  `tfsdk:"priority"`
+	ID            types.String `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *ProtocolsFpolicyPolicyStatusResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.Name
+}
+
+// Schema defines the schema for the resource.
+func (r *ProtocolsFpolicyPolicyStatusResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsFpolicyPolicyStatus resource",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the SVM",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the FPolicy policy",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the FPolicy policy is enabled",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"priority": schema.Int64Attribute{
+				MarkdownDescription: "Priority of the policy among the enabled policies of the SVM, required to enable the policy",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 10),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "FPolicy policy status identifier, svm_name/policy_name",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ProtocolsFpolicyPolicyStatusResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ProtocolsFpolicyPolicyStatusResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProtocolsFpolicyPolicyStatusResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	restInfo, err := interfaces.GetProtocolsFpolicyPolicyByName(errorHandler, *client, svm.UUID, data.PolicyName.ValueString())
+	if err != nil {
+		// error reporting done inside GetProtocolsFpolicyPolicyByName
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No FPolicy policy %s found on svm %s", data.PolicyName.ValueString(), data.SVMName.ValueString()))
+		return
+	}
+
+	data.Enabled = types.BoolValue(restInfo.Enabled)
+	// ONTAP only reports a priority for enabled policies
+	if restInfo.Enabled && restInfo.Priority != 0 && !data.Priority.IsNull() {
+		data.Priority = types.Int64Value(restInfo.Priority)
+	}
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.SVMName.ValueString(), restInfo.Name))
+
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a resource and retrieve UUID
+func (r *ProtocolsFpolicyPolicyStatusResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ProtocolsFpolicyPolicyStatusResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	err = applyFpolicyPolicyStatus(errorHandler, *client, data)
+	if err != nil {
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.SVMName.ValueString(), data.PolicyName.ValueString()))
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ProtocolsFpolicyPolicyStatusResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *ProtocolsFpolicyPolicyStatusResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, plan.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	err = applyFpolicyPolicyStatus(errorHandler, *client, plan)
+	if err != nil {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ProtocolsFpolicyPolicyStatusResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ProtocolsFpolicyPolicyStatusResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	// destroying the status leaves the policy in place, disabled
+	data.Enabled = types.BoolValue(false)
+	err = applyFpolicyPolicyStatus(errorHandler, *client, data)
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *ProtocolsFpolicyPolicyStatusResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: policy_name,svm_name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("policy_name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
+}
+
+// applyFpolicyPolicyStatus enables the policy with its priority, or disables it
+func applyFpolicyPolicyStatus(errorHandler *utils.ErrorHandler, r restclient.RestClient, data *ProtocolsFpolicyPolicyStatusResourceModel) error {
+	svm, err := interfaces.GetSvmByName(errorHandler, r, data.SVMName.ValueString())
+	if err != nil {
+		return err
+	}
+	restInfo, err := interfaces.GetProtocolsFpolicyPolicyByName(errorHandler, r, svm.UUID, data.PolicyName.ValueString())
+	if err != nil {
+		return err
+	}
+	if restInfo == nil {
+		return errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No FPolicy policy %s found on svm %s", data.PolicyName.ValueString(), data.SVMName.ValueString()))
+	}
+
+	enabled := data.Enabled.ValueBool()
+	if enabled == restInfo.Enabled && (!enabled || data.Priority.IsNull() || data.Priority.ValueInt64() == restInfo.Priority) {
+		return nil
+	}
+	body := interfaces.ProtocolsFpolicyPolicyUpdateBodyDataModelONTAP{Enabled: &enabled}
+	if enabled {
+		body.Priority = data.Priority.ValueInt64()
+	}
+	return interfaces.UpdateProtocolsFpolicyPolicy(errorHandler, r, svm.UUID, data.PolicyName.ValueString(), body)
+}
@@ -0,0 +1,140 @@
+package protocols
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+const exportPolicyRulesAPI = "protocols/nfs/export-policies/12884901889/rules"
+
+// exportPolicyRuleRecord returns an ONTAP export policy rule that only differs from the others by its client
+func exportPolicyRuleRecord(index int64, client string) map[string]any {
+	return map[string]any{
+		"index":                 index,
+		"clients":               []map[string]any{{"match": client}},
+		"ro_rule":               []string{"any"},
+		"rw_rule":               []string{"any"},
+		"protocols":             []string{"any"},
+		"superuser":             []string{"any"},
+		"anonymous_user":        "65534",
+		"allow_device_creation": true,
+		"ntfs_unix_security":    "fail",
+		"chown_mode":            "restricted",
+		"allow_suid":            true,
+	}
+}
+
+// exportPolicyRuleModel returns the inline rule matching exportPolicyRuleRecord
+func exportPolicyRuleModel(client string) ExportPolicyResourceRuleModel {
+	return ExportPolicyResourceRuleModel{
+		ClientsMatch:        []types.String{types.StringValue(client)},
+		RoRule:              []types.String{types.StringValue("any")},
+		RwRule:              []types.String{types.StringValue("any")},
+		Protocols:           []types.String{types.StringValue("any")},
+		AnonymousUser:       types.StringValue("65534"),
+		Superuser:           []types.String{types.StringValue("any")},
+		AllowDeviceCreation: types.BoolValue(true),
+		NtfsUnixSecurity:    types.StringValue("fail"),
+		ChownMode:           types.StringValue("restricted"),
+		AllowSuid:           types.BoolValue(true),
+	}
+}
+
+func TestReconcileExportPolicyRules(t *testing.T) {
+	errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+	cluster := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{{"version": map[string]any{"generation": 9, "major": 11, "minor": 1}}}}
+	current := restclient.RestResponse{NumRecords: 3, Records: []map[string]any{
+		// returned out of order, the rules are sorted by index
+		exportPolicyRuleRecord(2, "10.0.0.2"),
+		exportPolicyRuleRecord(1, "10.0.0.1"),
+		exportPolicyRuleRecord(3, "10.0.0.3"),
+	}}
+	created := restclient.RestResponse{NumRecords: 1, Records: []map[string]any{exportPolicyRuleRecord(4, "10.0.0.4")}}
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	read := []restclient.MockResponse{
+		{ExpectedMethod: "GET", ExpectedURL: "cluster", StatusCode: 200, Response: cluster, Err: nil},
+		{ExpectedMethod: "GET", ExpectedURL: exportPolicyRulesAPI, StatusCode: 200, Response: current, Err: nil},
+	}
+	a, b, c, d := exportPolicyRuleModel("10.0.0.1"), exportPolicyRuleModel("10.0.0.2"), exportPolicyRuleModel("10.0.0.3"), exportPolicyRuleModel("10.0.0.4")
+	bChanged := exportPolicyRuleModel("10.0.0.2")
+	bChanged.AllowSuid = types.BoolValue(false)
+	falseValue := false
+
+	tests := []struct {
+		name      string
+		desired   []ExportPolicyResourceRuleModel
+		responses []restclient.MockResponse
+		// wantBodies holds the expected body of each request after the two reads, nil for no body
+		wantBodies []map[string]any
+	}{
+		{
+			name:       "test_unchanged",
+			desired:    []ExportPolicyResourceRuleModel{a, b, c},
+			responses:  read,
+			wantBodies: []map[string]any{},
+		},
+		{
+			name:    "test_add_rule",
+			desired: []ExportPolicyResourceRuleModel{a, b, c, d},
+			responses: append(append([]restclient.MockResponse{}, read...),
+				restclient.MockResponse{ExpectedMethod: "POST", ExpectedURL: exportPolicyRulesAPI, StatusCode: 201, Response: created, Err: nil}),
+			wantBodies: []map[string]any{{"clients": []map[string]string{{"match": "10.0.0.4"}}, "index": int64(4)}},
+		},
+		{
+			name:    "test_remove_rule",
+			desired: []ExportPolicyResourceRuleModel{a, c},
+			responses: append(append([]restclient.MockResponse{}, read...),
+				restclient.MockResponse{ExpectedMethod: "DELETE", ExpectedURL: exportPolicyRulesAPI + "/2", StatusCode: 200, Response: noRecords, Err: nil}),
+			wantBodies: []map[string]any{nil},
+		},
+		{
+			name:    "test_reorder_rules",
+			desired: []ExportPolicyResourceRuleModel{c, a, b},
+			responses: append(append([]restclient.MockResponse{}, read...),
+				restclient.MockResponse{ExpectedMethod: "PATCH", ExpectedURL: exportPolicyRulesAPI + "/3", StatusCode: 200, Response: noRecords, Err: nil}),
+			wantBodies: []map[string]any{{"index": int64(1)}},
+		},
+		{
+			name:    "test_change_rule",
+			desired: []ExportPolicyResourceRuleModel{a, bChanged, c},
+			responses: append(append([]restclient.MockResponse{}, read...),
+				restclient.MockResponse{ExpectedMethod: "PATCH", ExpectedURL: exportPolicyRulesAPI + "/2", StatusCode: 200, Response: noRecords, Err: nil}),
+			// unchanged flags are not sent, so older ONTAP versions accept the body
+			wantBodies: []map[string]any{{"clients": []map[string]string{{"match": "10.0.0.2"}}, "allow_suid": &falseValue, "allow_device_creation": nil, "ntfs_unix_security": nil}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := restclient.NewRecordingMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = reconcileExportPolicyRules(errorHandler, *r, "12884901889", tt.desired)
+			if err != nil {
+				t.Fatalf("reconcileExportPolicyRules() error = %v", err)
+			}
+			requests := r.MockRequests()
+			if len(requests) != len(tt.responses) {
+				t.Fatalf("reconcileExportPolicyRules() sent %d requests, want %d: %#v", len(requests), len(tt.responses), requests)
+			}
+			for i, want := range tt.wantBodies {
+				got := requests[len(read)+i].Body
+				// only check the keys that tell the rules apart
+				for key, value := range want {
+					if !reflect.DeepEqual(got[key], value) {
+						t.Errorf("request %d %s = %v, want %v", i, key, got[key], value)
+					}
+				}
+				if want == nil && got != nil {
+					t.Errorf("request %d body = %v, want none", i, got)
+				}
+			}
+		})
+	}
+}
@@ -3,19 +3,25 @@ package protocols
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
 )
 
@@ -40,10 +46,25 @@ type ExportPolicyResource struct {
 
 // ExportPolicyResourceModel describes the resource data model.
 type ExportPolicyResourceModel struct {
-	CxProfileName types.String `tfsdk:"cx_profile_name"`
-	Name          types.String `tfsdk:"name"`
-	SVMName       types.String `tfsdk:"svm_name"`
-	ID            types.String `tfsdk:"id"`
+	CxProfileName types.String                    `tfsdk:"cx_profile_name"`
+	Name          types.String                    `tfsdk:"name"`
+	SVMName       types.String                    `tfsdk:"svm_name"`
+	Rules         []ExportPolicyResourceRuleModel `tfsdk:"rules"`
+	ID            types.String                    `tfsdk:"id"`
+}
+
+// ExportPolicyResourceRuleModel describes an inline export policy rule, its position in the list is the rule index.
+type ExportPolicyResourceRuleModel struct {
+	ClientsMatch        []types.String `tfsdk:"clients_match"`
+	RoRule              []types.String `tfsdk:"ro_rule"`
+	RwRule              []types.String `tfsdk:"rw_rule"`
+	Protocols           []types.String `tfsdk:"protocols"`
+	AnonymousUser       types.String   `tfsdk:"anonymous_user"`
+	Superuser           []types.String `tfsdk:"superuser"`
+	AllowDeviceCreation types.Bool     `tfsdk:"allow_device_creation"`
+	NtfsUnixSecurity    types.String   `tfsdk:"ntfs_unix_security"`
+	ChownMode           types.String   `tfsdk:"chown_mode"`
+	AllowSuid           types.Bool     `tfsdk:"allow_suid"`
 }
 
 // Metadata returns the resource type name.
@@ -70,6 +91,73 @@ func (r *ExportPolicyResource) Schema(ctx context.Context, req resource.SchemaRe
 				MarkdownDescription: "Name of the svm to use",
 				Required:            true,
 			},
+			"rules": schema.ListNestedAttribute{
+				MarkdownDescription: "Export policy rules, the position in the list is the rule index. When set, rules added outside of Terraform are reported as drift and removed",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"clients_match": schema.SetAttribute{
+							Required:            true,
+							MarkdownDescription: "List of Client Match Hostnames, IP Addresses, Netgroups, or Domains",
+							ElementType:         types.StringType,
+						},
+						"ro_rule": schema.SetAttribute{
+							Required:            true,
+							MarkdownDescription: "RO Access Rule",
+							ElementType:         types.StringType,
+						},
+						"rw_rule": schema.SetAttribute{
+							Required:            true,
+							MarkdownDescription: "RW Access Rule",
+							ElementType:         types.StringType,
+						},
+						"protocols": schema.SetAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{types.StringValue("any")})),
+							MarkdownDescription: "Access Protocol",
+							ElementType:         types.StringType,
+						},
+						"anonymous_user": schema.StringAttribute{
+							MarkdownDescription: "User ID To Which Anonymous Users Are Mapped",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("65534"),
+						},
+						"superuser": schema.SetAttribute{
+							MarkdownDescription: "Superuser Security Types",
+							Optional:            true,
+							Computed:            true,
+							Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{types.StringValue("any")})),
+							ElementType:         types.StringType,
+						},
+						"allow_device_creation": schema.BoolAttribute{
+							MarkdownDescription: "Allow Creation of Devices",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+						},
+						"ntfs_unix_security": schema.StringAttribute{
+							MarkdownDescription: "NTFS export UNIX security options",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("fail"),
+						},
+						"chown_mode": schema.StringAttribute{
+							MarkdownDescription: "Specifies who is authorized to change the ownership mode of a file",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("restricted"),
+						},
+						"allow_suid": schema.BoolAttribute{
+							MarkdownDescription: "Honor SetUID Bits in SETATTR",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+						},
+					},
+				},
+			},
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Export policy identifier",
@@ -136,6 +224,14 @@ func (r *ExportPolicyResource) Create(ctx context.Context, req resource.CreateRe
 
 	data.ID = types.StringValue(strconv.Itoa(exportPolicy.ID))
 
+	// rules are appended in list order, so their indexes follow the configuration
+	for _, rule := range data.Rules {
+		_, err = interfaces.CreateExportPolicyRule(errorHandler, *client, exportPolicyRuleBody(rule, nil, 0), data.ID.ValueString())
+		if err != nil {
+			return
+		}
+	}
+
 	tflog.Trace(ctx, "created a resource")
 
 	// Save data into Terraform state
@@ -181,6 +277,18 @@ func (r *ExportPolicyResource) Read(ctx context.Context, req resource.ReadReques
 		}
 	}
 
+	// only report rules when they are managed inline, otherwise they belong to netapp-ontap_nfs_export_policy_rule
+	if data.Rules != nil {
+		rules, err := getSortedExportPolicyRules(errorHandler, *client, data.ID.ValueString())
+		if err != nil {
+			return
+		}
+		data.Rules = make([]ExportPolicyResourceRuleModel, len(rules))
+		for i, rule := range rules {
+			data.Rules[i] = flattenExportPolicyRule(rule)
+		}
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -210,6 +318,14 @@ func (r *ExportPolicyResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	// rules are reconciled against what is on ONTAP rather than the prior state, so out of band changes are corrected too
+	if data.Rules != nil {
+		err = reconcileExportPolicyRules(errorHandler, *client, data.ID.ValueString(), data.Rules)
+		if err != nil {
+			return
+		}
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -257,3 +373,254 @@ func (r *ExportPolicyResource) ImportState(ctx context.Context, req resource.Imp
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[1])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
 }
+
+// getSortedExportPolicyRules returns the rules of an export policy ordered by index.
+func getSortedExportPolicyRules(errorHandler *utils.ErrorHandler, client restclient.RestClient, exportPolicyID string) ([]interfaces.ExportPolicyRuleGetDataModelONTAP, error) {
+	cluster, err := interfaces.GetCluster(errorHandler, client)
+	if err != nil {
+		// error reporting done inside GetCluster
+		return nil, err
+	}
+	if cluster == nil {
+		return nil, errorHandler.MakeAndReportError("No cluster found", "cluster not found")
+	}
+	rules, err := interfaces.GetListExportPolicyRules(errorHandler, client, exportPolicyID, nil, cluster.Version)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].Index < rules[j].Index })
+	return rules, nil
+}
+
+// flattenExportPolicyRule converts an ONTAP export policy rule into the inline rule model.
+func flattenExportPolicyRule(rule interfaces.ExportPolicyRuleGetDataModelONTAP) ExportPolicyResourceRuleModel {
+	var clientsMatch []types.String
+	for _, e := range rule.ClientsMatch {
+		clientsMatch = append(clientsMatch, types.StringValue(e.Match))
+	}
+	// ntfs_unix_security is only reported from 9.11 onwards, keep the default so older systems don't show drift
+	ntfsUnixSecurity := rule.NtfsUnixSecurity
+	if ntfsUnixSecurity == "" {
+		ntfsUnixSecurity = "fail"
+	}
+	return ExportPolicyResourceRuleModel{
+		ClientsMatch:        clientsMatch,
+		RoRule:              stringsToTypes(rule.RoRule),
+		RwRule:              stringsToTypes(rule.RwRule),
+		Protocols:           stringsToTypes(rule.Protocols),
+		AnonymousUser:       types.StringValue(rule.AnonymousUser),
+		Superuser:           stringsToTypes(rule.Superuser),
+		AllowDeviceCreation: types.BoolValue(rule.AllowDeviceCreation),
+		NtfsUnixSecurity:    types.StringValue(ntfsUnixSecurity),
+		ChownMode:           types.StringValue(rule.ChownMode),
+		AllowSuid:           types.BoolValue(rule.AllowSuid),
+	}
+}
+
+// exportPolicyRuleDefaults holds the ONTAP defaults of the optional flags of a rule, which are also the schema defaults.
+var exportPolicyRuleDefaults = ExportPolicyResourceRuleModel{
+	AllowDeviceCreation: types.BoolValue(true),
+	NtfsUnixSecurity:    types.StringValue("fail"),
+	ChownMode:           types.StringValue("restricted"),
+	AllowSuid:           types.BoolValue(true),
+}
+
+// exportPolicyRuleBody builds the POST/PATCH body for an inline rule, index 0 leaves the index out.
+// The optional flags are only sent when they differ from current, the ONTAP rule that is patched, or from the ONTAP defaults when current is nil.
+func exportPolicyRuleBody(rule ExportPolicyResourceRuleModel, current *ExportPolicyResourceRuleModel, index int64) interfaces.ExportpolicyRuleResourceBodyDataModelONTAP {
+	if current == nil {
+		current = &exportPolicyRuleDefaults
+	}
+	var body interfaces.ExportpolicyRuleResourceBodyDataModelONTAP
+	for _, e := range rule.ClientsMatch {
+		body.ClientsMatch = append(body.ClientsMatch, map[string]string{"match": e.ValueString()})
+	}
+	body.RoRule = typesToStrings(rule.RoRule)
+	body.RwRule = typesToStrings(rule.RwRule)
+	body.Protocols = typesToStrings(rule.Protocols)
+	body.Superuser = typesToStrings(rule.Superuser)
+	body.AnonymousUser = rule.AnonymousUser.ValueString()
+	if !rule.AllowDeviceCreation.Equal(current.AllowDeviceCreation) {
+		body.AllowDeviceCreation = rule.AllowDeviceCreation.ValueBoolPointer()
+	}
+	if !rule.AllowSuid.Equal(current.AllowSuid) {
+		body.AllowSuid = rule.AllowSuid.ValueBoolPointer()
+	}
+	if !rule.ChownMode.Equal(current.ChownMode) {
+		body.ChownMode = rule.ChownMode.ValueString()
+	}
+	if !rule.NtfsUnixSecurity.Equal(current.NtfsUnixSecurity) {
+		body.NtfsUnixSecurity = rule.NtfsUnixSecurity.ValueString()
+	}
+	body.Index = index
+	return body
+}
+
+// exportPolicyRuleKey returns a comparable representation of a rule, ignoring the order of set members.
+func exportPolicyRuleKey(rule ExportPolicyResourceRuleModel) string {
+	set := func(values []types.String) string {
+		s := typesToStrings(values)
+		sort.Strings(s)
+		return strings.Join(s, ",")
+	}
+	return strings.Join([]string{set(rule.ClientsMatch), set(rule.RoRule), set(rule.RwRule), set(rule.Protocols), set(rule.Superuser),
+		rule.AnonymousUser.ValueString(), rule.AllowDeviceCreation.String(), rule.NtfsUnixSecurity.ValueString(),
+		rule.ChownMode.ValueString(), rule.AllowSuid.String()}, "|")
+}
+
+// reconcileExportPolicyRules makes the rules on ONTAP match the desired list with as few calls as possible.
+// ONTAP keeps rule indexes contiguous: inserting, moving or deleting a rule shifts the rules that follow it.
+// Rules that are already present are kept, changed rules are patched in place, and the remaining ones are
+// moved only when they are not part of the longest run that is already in the desired order.
+func reconcileExportPolicyRules(errorHandler *utils.ErrorHandler, client restclient.RestClient, exportPolicyID string, desired []ExportPolicyResourceRuleModel) error {
+	rules, err := getSortedExportPolicyRules(errorHandler, client, exportPolicyID)
+	if err != nil {
+		return err
+	}
+	current := make([]ExportPolicyResourceRuleModel, len(rules))
+	for i, rule := range rules {
+		current[i] = flattenExportPolicyRule(rule)
+	}
+
+	// desiredToCurrent maps a desired position to the position of the ONTAP rule that will hold it, or -1
+	desiredToCurrent := make([]int, len(desired))
+	used := make([]bool, len(current))
+	for i := range desired {
+		desiredToCurrent[i] = -1
+		for j := range current {
+			if !used[j] && exportPolicyRuleKey(desired[i]) == exportPolicyRuleKey(current[j]) {
+				desiredToCurrent[i] = j
+				used[j] = true
+				break
+			}
+		}
+	}
+	// reuse leftover ONTAP rules for leftover desired rules with a PATCH rather than a DELETE and a POST
+	j := 0
+	for i := range desired {
+		if desiredToCurrent[i] != -1 {
+			continue
+		}
+		for j < len(current) && used[j] {
+			j++
+		}
+		if j == len(current) {
+			break
+		}
+		_, err = interfaces.UpdateExportPolicyRule(errorHandler, client, exportPolicyRuleBody(desired[i], &current[j], 0), exportPolicyID, rules[j].Index)
+		if err != nil {
+			return err
+		}
+		desiredToCurrent[i] = j
+		used[j] = true
+	}
+	// delete from the end so the indexes of the rules still to be deleted don't shift
+	for j := len(current) - 1; j >= 0; j-- {
+		if !used[j] {
+			err = interfaces.DeleteExportPolicyRule(errorHandler, client, exportPolicyID, rules[j].Index)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// order holds the desired positions of the remaining ONTAP rules, in their current order
+	currentToDesired := make(map[int]int)
+	for i, j := range desiredToCurrent {
+		if j != -1 {
+			currentToDesired[j] = i
+		}
+	}
+	var order []int
+	for j := range current {
+		if i, ok := currentToDesired[j]; ok {
+			order = append(order, i)
+		}
+	}
+	stable := longestIncreasingSubsequence(order)
+
+	position := func(i int) int {
+		for p, v := range order {
+			if v == i {
+				return p
+			}
+		}
+		return -1
+	}
+	for i := range desired {
+		// rule i goes right after rule i-1, which is already in place
+		target := 0
+		if i > 0 {
+			target = position(i-1) + 1
+		}
+		if desiredToCurrent[i] == -1 {
+			_, err = interfaces.CreateExportPolicyRule(errorHandler, client, exportPolicyRuleBody(desired[i], nil, int64(target+1)), exportPolicyID)
+			if err != nil {
+				return err
+			}
+			order = append(order[:target], append([]int{i}, order[target:]...)...)
+			continue
+		}
+		if stable[i] {
+			continue
+		}
+		from := position(i)
+		if from == target || from+1 == target {
+			continue
+		}
+		order = append(order[:from], order[from+1:]...)
+		if from < target {
+			target--
+		}
+		err = interfaces.UpdateExportPolicyRuleIndex(errorHandler, client, exportPolicyID, int64(from+1), int64(target+1))
+		if err != nil {
+			return err
+		}
+		order = append(order[:target], append([]int{i}, order[target:]...)...)
+	}
+	return nil
+}
+
+// longestIncreasingSubsequence returns the members of a longest increasing subsequence of values.
+func longestIncreasingSubsequence(values []int) map[int]bool {
+	// tails[k] is the position in values of the smallest tail of an increasing subsequence of length k+1
+	tails := []int{}
+	previous := make([]int, len(values))
+	for p, v := range values {
+		k := sort.Search(len(tails), func(k int) bool { return values[tails[k]] >= v })
+		if k > 0 {
+			previous[p] = tails[k-1]
+		} else {
+			previous[p] = -1
+		}
+		if k == len(tails) {
+			tails = append(tails, p)
+		} else {
+			tails[k] = p
+		}
+	}
+	members := make(map[int]bool)
+	if len(tails) == 0 {
+		return members
+	}
+	for p := tails[len(tails)-1]; p != -1; p = previous[p] {
+		members[values[p]] = true
+	}
+	return members
+}
+
+func stringsToTypes(values []string) []types.String {
+	var result []types.String
+	for _, v := range values {
+		result = append(result, types.StringValue(v))
+	}
+	return result
+}
+
+func typesToStrings(values []types.String) []string {
+	var result []string
+	for _, v := range values {
+		result = append(result, v.ValueString())
+	}
+	return result
+}
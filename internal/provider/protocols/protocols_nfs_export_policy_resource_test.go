@@ -40,6 +40,81 @@ func TestAccNFSExportPolicyResource(t *testing.T) {
 	})
 }
 
+func TestAccNFSExportPolicyResourceRules(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { ntest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ntest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with rules
+			{
+				Config: testAccNFSExportPolicyResourceRulesConfig([]string{"10.0.0.1", "10.0.0.2"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_nfs_export_policy.example", "rules.#", "2"),
+					resource.TestCheckResourceAttr("netapp-ontap_nfs_export_policy.example", "rules.0.clients_match.0", "10.0.0.1"),
+					resource.TestCheckResourceAttr("netapp-ontap_nfs_export_policy.example", "rules.1.anonymous_user", "65534"),
+				),
+			},
+			// Insert and reorder
+			{
+				Config: testAccNFSExportPolicyResourceRulesConfig([]string{"10.0.0.2", "10.0.0.3", "10.0.0.1"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_nfs_export_policy.example", "rules.#", "3"),
+					resource.TestCheckResourceAttr("netapp-ontap_nfs_export_policy.example", "rules.0.clients_match.0", "10.0.0.2"),
+					resource.TestCheckResourceAttr("netapp-ontap_nfs_export_policy.example", "rules.1.clients_match.0", "10.0.0.3"),
+					resource.TestCheckResourceAttr("netapp-ontap_nfs_export_policy.example", "rules.2.clients_match.0", "10.0.0.1"),
+				),
+			},
+			// Remove
+			{
+				Config: testAccNFSExportPolicyResourceRulesConfig([]string{"10.0.0.1"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_nfs_export_policy.example", "rules.#", "1"),
+					resource.TestCheckResourceAttr("netapp-ontap_nfs_export_policy.example", "rules.0.clients_match.0", "10.0.0.1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNFSExportPolicyResourceRulesConfig(clients []string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	rules := ""
+	for _, client := range clients {
+		rules += fmt.Sprintf(`
+		{
+			clients_match = ["%s"]
+			ro_rule = ["any"]
+			rw_rule = ["any"]
+		},`, client)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_nfs_export_policy" "example" {
+	cx_profile_name = "cluster4"
+	svm_name = "carchi-test"
+	name = "acc_test_rules"
+	rules = [%s
+	]
+}`, host, admin, password, rules)
+}
+
 func testAccNFSExportPolicyResourceConfig(svm string) string {
 	host := os.Getenv("TF_ACC_NETAPP_HOST")
 	admin := os.Getenv("TF_ACC_NETAPP_USER")
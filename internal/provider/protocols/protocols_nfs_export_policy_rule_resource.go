@@ -242,10 +242,12 @@ func (r *ExportPolicyRuleResource) Configure(ctx context.Context, req resource.C
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *ExportPolicyRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data *ExportPolicyRuleResourceModel
+	var data, config *ExportPolicyRuleResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// allow_device_creation and allow_suid are only sent when they are set in the configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 
 	var request interfaces.ExportpolicyRuleResourceBodyDataModelONTAP
 	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
@@ -287,11 +289,11 @@ func (r *ExportPolicyRuleResource) Create(ctx context.Context, req resource.Crea
 	if !data.AnonymousUser.IsNull() {
 		request.AnonymousUser = data.AnonymousUser.ValueString()
 	}
-	if !data.AllowDeviceCreation.IsNull() {
-		request.AllowDeviceCreation = data.AllowDeviceCreation.ValueBool()
+	if !config.AllowDeviceCreation.IsNull() {
+		request.AllowDeviceCreation = data.AllowDeviceCreation.ValueBoolPointer()
 	}
-	if !data.AllowSuid.IsNull() {
-		request.AllowSuid = data.AllowSuid.ValueBool()
+	if !config.AllowSuid.IsNull() {
+		request.AllowSuid = data.AllowSuid.ValueBoolPointer()
 	}
 	if !data.ChownMode.IsNull() {
 		request.ChownMode = data.ChownMode.ValueString()
@@ -407,10 +409,13 @@ func (r *ExportPolicyRuleResource) Read(ctx context.Context, req resource.ReadRe
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *ExportPolicyRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data *ExportPolicyRuleResourceModel
+	var data, config, state *ExportPolicyRuleResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// allow_device_creation and allow_suid are only sent when they are set in the configuration or change
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
 
 	if resp.Diagnostics.HasError() {
@@ -463,11 +468,11 @@ func (r *ExportPolicyRuleResource) Update(ctx context.Context, req resource.Upda
 	if !data.AnonymousUser.IsNull() {
 		request.AnonymousUser = data.AnonymousUser.ValueString()
 	}
-	if !data.AllowDeviceCreation.IsNull() {
-		request.AllowDeviceCreation = data.AllowDeviceCreation.ValueBool()
+	if !config.AllowDeviceCreation.IsNull() || !data.AllowDeviceCreation.Equal(state.AllowDeviceCreation) {
+		request.AllowDeviceCreation = data.AllowDeviceCreation.ValueBoolPointer()
 	}
-	if !data.AllowSuid.IsNull() {
-		request.AllowSuid = data.AllowSuid.ValueBool()
+	if !config.AllowSuid.IsNull() || !data.AllowSuid.Equal(state.AllowSuid) {
+		request.AllowSuid = data.AllowSuid.ValueBoolPointer()
 	}
 	if !data.ChownMode.IsNull() {
 		request.ChownMode = data.ChownMode.ValueString()
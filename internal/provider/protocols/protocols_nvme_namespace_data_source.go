@@ -0,0 +1,188 @@
+package protocols
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ProtocolsNvmeNamespaceDataSource{}
+
+// NewProtocolsNvmeNamespaceDataSource is a helper function to simplify the provider implementation.
+func NewProtocolsNvmeNamespaceDataSource() datasource.DataSource {
+	return &ProtocolsNvmeNamespaceDataSource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "nvme_namespace",
+		},
+	}
+}
+
+// ProtocolsNvmeNamespaceDataSource defines the data source implementation.
+type ProtocolsNvmeNamespaceDataSource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// ProtocolsNvmeNamespaceDataSourceModel describes the data source data model.
+type ProtocolsNvmeNamespaceDataSourceModel struct {
+	CxProfileName types.String `tfsdk:"cx_profile_name"`
+	SVMName       types.String `tfsdk:"svm_name"`
+	Name          types.String `tfsdk:"name"`
+	OSType        types.String `tfsdk:"os_type"`
+	Size          types.Int64  `tfsdk:"size"`
+	BlockSize     types.Int64  `tfsdk:"block_size"`
+	Used          types.Int64  `tfsdk:"used"`
+	Comment       types.String `tfsdk:"comment"`
+	VolumeName    types.String `tfsdk:"volume_name"`
+	State         types.String `tfsdk:"state"`
+	Mapped        types.Bool   `tfsdk:"mapped"`
+	SubsystemName types.String `tfsdk:"subsystem_name"`
+	ID            types.String `tfsdk:"id"`
+}
+
+// Metadata returns the data source type name.
+func (d *ProtocolsNvmeNamespaceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.Name
+}
+
+// Schema defines the schema for the data source.
+func (d *ProtocolsNvmeNamespaceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsNvmeNamespace data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the SVM",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Path of the NVMe namespace, in the form /vol/<volume>/<namespace>",
+				Required:            true,
+			},
+			"os_type": schema.StringAttribute{
+				MarkdownDescription: "Operating system of the NVMe namespace's host",
+				Computed:            true,
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "Size of the namespace in bytes",
+				Computed:            true,
+			},
+			"block_size": schema.Int64Attribute{
+				MarkdownDescription: "Block size of the namespace in bytes",
+				Computed:            true,
+			},
+			"used": schema.Int64Attribute{
+				MarkdownDescription: "Space used by the namespace in bytes",
+				Computed:            true,
+			},
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "Comment",
+				Computed:            true,
+			},
+			"volume_name": schema.StringAttribute{
+				MarkdownDescription: "Volume containing the namespace",
+				Computed:            true,
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "State of the namespace",
+				Computed:            true,
+			},
+			"mapped": schema.BoolAttribute{
+				MarkdownDescription: "Whether the namespace is mapped to a subsystem",
+				Computed:            true,
+			},
+			"subsystem_name": schema.StringAttribute{
+				MarkdownDescription: "Subsystem the namespace is mapped to",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Namespace UUID",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ProtocolsNvmeNamespaceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ProtocolsNvmeNamespaceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProtocolsNvmeNamespaceDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetProtocolsNvmeNamespaceByName(errorHandler, *client, data.Name.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetProtocolsNvmeNamespaceByName
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No NVMe namespace %s found on svm %s", data.Name.ValueString(), data.SVMName.ValueString()))
+		return
+	}
+
+	data = flattenProtocolsNvmeNamespace(data.CxProfileName, *restInfo)
+
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// flattenProtocolsNvmeNamespace converts an ONTAP namespace record into the data source model.
+func flattenProtocolsNvmeNamespace(cxProfileName types.String, record interfaces.ProtocolsNvmeNamespaceGetDataModelONTAP) ProtocolsNvmeNamespaceDataSourceModel {
+	return ProtocolsNvmeNamespaceDataSourceModel{
+		CxProfileName: cxProfileName,
+		SVMName:       types.StringValue(record.SVM.Name),
+		Name:          types.StringValue(record.Name),
+		OSType:        types.StringValue(record.OSType),
+		Size:          types.Int64Value(record.Space.Size),
+		BlockSize:     types.Int64Value(record.Space.BlockSize),
+		Used:          types.Int64Value(record.Space.Used),
+		Comment:       types.StringValue(record.Comment),
+		VolumeName:    types.StringValue(record.Location.Volume.Name),
+		State:         types.StringValue(record.Status.State),
+		Mapped:        types.BoolValue(record.Status.Mapped),
+		SubsystemName: types.StringValue(record.SubsystemMap.Subsystem.Name),
+		ID:            types.StringValue(record.UUID),
+	}
+}
@@ -0,0 +1,330 @@
+package protocols
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ProtocolsNvmeNamespaceResource{}
+var _ resource.ResourceWithImportState = &ProtocolsNvmeNamespaceResource{}
+
+// NewProtocolsNvmeNamespaceResource is a helper function to simplify the provider implementation.
+func NewProtocolsNvmeNamespaceResource() resource.Resource {
+	return &ProtocolsNvmeNamespaceResource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "nvme_namespace",
+		},
+	}
+}
+
+// ProtocolsNvmeNamespaceResource defines the resource implementation.
+type ProtocolsNvmeNamespaceResource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// ProtocolsNvmeNamespaceResourceModel describes the resource data model.
+type ProtocolsNvmeNamespaceResourceModel struct {
+	CxProfileName types.String `tfsdk:"cx_profile_name"`
+	SVMName       types.String `tfsdk:"svm_name"`
+	Name          types.String `tfsdk:"name"`
+	OSType        types.String `tfsdk:"os_type"`
+	Size          types.Int64  `tfsdk:"size"`
+	BlockSize     types.Int64  `tfsdk:"block_size"`
+	Comment       types.String `tfsdk:"comment"`
+	VolumeName    types.String `tfsdk:"volume_name"`
+	ID            types.String `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *ProtocolsNvmeNamespaceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.Name
+}
+
+// Schema defines the schema for the resource.
+func (r *ProtocolsNvmeNamespaceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsNvmeNamespace resource",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the SVM",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Path of the namespace, in the format /vol/<volume>/<namespace>",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"os_type": schema.StringAttribute{
+				MarkdownDescription: "Operating system of the NVMe host. One of aix, linux, vmware, windows",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"aix", "linux", "vmware", "windows"}...),
+				},
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "Size of the namespace in bytes",
+				Required:            true,
+			},
+			"block_size": schema.Int64Attribute{
+				MarkdownDescription: "Block size of the namespace in bytes, 512 or 4096",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIfConfigured(),
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "Comment",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"volume_name": schema.StringAttribute{
+				MarkdownDescription: "Volume the namespace is in",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Namespace UUID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ProtocolsNvmeNamespaceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ProtocolsNvmeNamespaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProtocolsNvmeNamespaceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetProtocolsNvmeNamespaceByName(errorHandler, *client, data.Name.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetProtocolsNvmeNamespaceByName
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No NVMe namespace %s found on svm %s", data.Name.ValueString(), data.SVMName.ValueString()))
+		return
+	}
+
+	data.Name = types.StringValue(restInfo.Name)
+	data.SVMName = types.StringValue(restInfo.SVM.Name)
+	data.OSType = types.StringValue(restInfo.OSType)
+	data.Size = types.Int64Value(restInfo.Space.Size)
+	data.BlockSize = types.Int64Value(restInfo.Space.BlockSize)
+	data.Comment = types.StringValue(restInfo.Comment)
+	data.VolumeName = types.StringValue(restInfo.Location.Volume.Name)
+	data.ID = types.StringValue(restInfo.UUID)
+
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a resource and retrieve UUID
+func (r *ProtocolsNvmeNamespaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ProtocolsNvmeNamespaceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var body interfaces.ProtocolsNvmeNamespaceResourceBodyDataModelONTAP
+	body.Name = data.Name.ValueString()
+	body.SVM.Name = data.SVMName.ValueString()
+	body.OSType = data.OSType.ValueString()
+	body.Space.Size = data.Size.ValueInt64()
+	if !data.BlockSize.IsUnknown() {
+		body.Space.BlockSize = data.BlockSize.ValueInt64()
+	}
+	if !data.Comment.IsUnknown() {
+		body.Comment = data.Comment.ValueString()
+	}
+
+	_, err = interfaces.CreateProtocolsNvmeNamespace(errorHandler, *client, body)
+	if err != nil {
+		return
+	}
+
+	restInfo, err := interfaces.GetProtocolsNvmeNamespaceByName(errorHandler, *client, data.Name.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No NVMe namespace %s found on svm %s after create", data.Name.ValueString(), data.SVMName.ValueString()))
+		return
+	}
+
+	data.ID = types.StringValue(restInfo.UUID)
+	data.BlockSize = types.Int64Value(restInfo.Space.BlockSize)
+	data.Comment = types.StringValue(restInfo.Comment)
+	data.VolumeName = types.StringValue(restInfo.Location.Volume.Name)
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ProtocolsNvmeNamespaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *ProtocolsNvmeNamespaceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, plan.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var request interfaces.ProtocolsNvmeNamespaceUpdateBodyDataModelONTAP
+	if !plan.Size.Equal(state.Size) {
+		request.Space = &interfaces.NvmeNamespaceSpaceRequest{Size: plan.Size.ValueInt64()}
+	}
+	if !plan.Comment.IsUnknown() && !plan.Comment.Equal(state.Comment) {
+		comment := plan.Comment.ValueString()
+		request.Comment = &comment
+	}
+	if request.Space != nil || request.Comment != nil {
+		err = interfaces.UpdateProtocolsNvmeNamespace(errorHandler, *client, request, state.ID.ValueString())
+		if err != nil {
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ProtocolsNvmeNamespaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ProtocolsNvmeNamespaceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if data.ID.IsNull() {
+		errorHandler.MakeAndReportError("UUID is null", "protocols_nvme_namespace UUID is null")
+		return
+	}
+
+	err = interfaces.DeleteProtocolsNvmeNamespace(errorHandler, *client, data.ID.ValueString())
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *ProtocolsNvmeNamespaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: name,svm_name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
+}
@@ -0,0 +1,97 @@
+package protocols_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	ntest "github.com/netapp/terraform-provider-netapp-ontap/internal/provider"
+)
+
+func TestAccNvmeNamespaceResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { ntest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ntest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create namespace, subsystem and map, then read
+			{
+				Config: testAccNvmeNamespaceResourceConfig(1073741824),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_nvme_namespace.example", "name", "/vol/lunTest/acc_test_namespace"),
+					resource.TestCheckResourceAttr("netapp-ontap_nvme_namespace.example", "size", "1073741824"),
+					resource.TestCheckResourceAttr("netapp-ontap_nvme_namespace.example", "volume_name", "lunTest"),
+					resource.TestCheckResourceAttrSet("netapp-ontap_nvme_subsystem_map.example", "nsid"),
+				),
+			},
+			// Grow the namespace in place
+			{
+				Config: testAccNvmeNamespaceResourceConfig(2147483648),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_nvme_namespace.example", "size", "2147483648"),
+				),
+			},
+			// Import and read
+			{
+				ResourceName:  "netapp-ontap_nvme_namespace.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s,%s", "/vol/lunTest/acc_test_namespace", "carchi-test", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_nvme_namespace.example", "os_type", "linux"),
+				),
+			},
+			{
+				ResourceName:  "netapp-ontap_nvme_subsystem_map.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s,%s,%s", "acc_test_ns_subsystem", "/vol/lunTest/acc_test_namespace", "carchi-test", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_nvme_subsystem_map.example", "subsystem_name", "acc_test_ns_subsystem"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNvmeNamespaceResourceConfig(size int64) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_nvme_namespace" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "carchi-test"
+  name = "/vol/lunTest/acc_test_namespace"
+  os_type = "linux"
+  size = %d
+}
+
+resource "netapp-ontap_nvme_subsystem" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "carchi-test"
+  name = "acc_test_ns_subsystem"
+  os_type = "linux"
+}
+
+resource "netapp-ontap_nvme_subsystem_map" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "carchi-test"
+  subsystem_name = netapp-ontap_nvme_subsystem.example.name
+  namespace_name = netapp-ontap_nvme_namespace.example.name
+}`, host, admin, password, size)
+}
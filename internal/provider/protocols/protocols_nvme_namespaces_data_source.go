@@ -0,0 +1,202 @@
+package protocols
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ProtocolsNvmeNamespacesDataSource{}
+
+// NewProtocolsNvmeNamespacesDataSource is a helper function to simplify the provider implementation.
+func NewProtocolsNvmeNamespacesDataSource() datasource.DataSource {
+	return &ProtocolsNvmeNamespacesDataSource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "nvme_namespaces",
+		},
+	}
+}
+
+// ProtocolsNvmeNamespacesDataSource defines the data source implementation.
+type ProtocolsNvmeNamespacesDataSource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// ProtocolsNvmeNamespacesDataSourceModel describes the data source data model.
+type ProtocolsNvmeNamespacesDataSourceModel struct {
+	CxProfileName           types.String                                  `tfsdk:"cx_profile_name"`
+	ProtocolsNvmeNamespaces []ProtocolsNvmeNamespaceDataSourceModel       `tfsdk:"protocols_nvme_namespaces"`
+	Filter                  *ProtocolsNvmeNamespacesDataSourceFilterModel `tfsdk:"filter"`
+}
+
+// ProtocolsNvmeNamespacesDataSourceFilterModel describes the data source data model for queries.
+type ProtocolsNvmeNamespacesDataSourceFilterModel struct {
+	Name       types.String `tfsdk:"name"`
+	SVMName    types.String `tfsdk:"svm_name"`
+	VolumeName types.String `tfsdk:"volume_name"`
+}
+
+// Metadata returns the data source type name.
+func (d *ProtocolsNvmeNamespacesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.Name
+}
+
+// Schema defines the schema for the data source.
+func (d *ProtocolsNvmeNamespacesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsNvmeNamespaces data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Path of the NVMe namespace",
+						Optional:            true,
+					},
+					"svm_name": schema.StringAttribute{
+						MarkdownDescription: "Name of the SVM",
+						Optional:            true,
+					},
+					"volume_name": schema.StringAttribute{
+						MarkdownDescription: "Volume containing the namespace",
+						Optional:            true,
+					},
+				},
+				Optional: true,
+			},
+			"protocols_nvme_namespaces": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cx_profile_name": schema.StringAttribute{
+							MarkdownDescription: "Connection profile name",
+							Required:            true,
+						},
+						"svm_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the SVM",
+							Required:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Path of the NVMe namespace, in the form /vol/<volume>/<namespace>",
+							Required:            true,
+						},
+						"os_type": schema.StringAttribute{
+							MarkdownDescription: "Operating system of the NVMe namespace's host",
+							Computed:            true,
+						},
+						"size": schema.Int64Attribute{
+							MarkdownDescription: "Size of the namespace in bytes",
+							Computed:            true,
+						},
+						"block_size": schema.Int64Attribute{
+							MarkdownDescription: "Block size of the namespace in bytes",
+							Computed:            true,
+						},
+						"used": schema.Int64Attribute{
+							MarkdownDescription: "Space used by the namespace in bytes",
+							Computed:            true,
+						},
+						"comment": schema.StringAttribute{
+							MarkdownDescription: "Comment",
+							Computed:            true,
+						},
+						"volume_name": schema.StringAttribute{
+							MarkdownDescription: "Volume containing the namespace",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "State of the namespace",
+							Computed:            true,
+						},
+						"mapped": schema.BoolAttribute{
+							MarkdownDescription: "Whether the namespace is mapped to a subsystem",
+							Computed:            true,
+						},
+						"subsystem_name": schema.StringAttribute{
+							MarkdownDescription: "Subsystem the namespace is mapped to",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Namespace UUID",
+							Computed:            true,
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ProtocolsNvmeNamespacesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ProtocolsNvmeNamespacesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProtocolsNvmeNamespacesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var filter *interfaces.ProtocolsNvmeNamespaceDataSourceFilterModel = nil
+	if data.Filter != nil {
+		filter = &interfaces.ProtocolsNvmeNamespaceDataSourceFilterModel{
+			Name:       data.Filter.Name.ValueString(),
+			SVMName:    data.Filter.SVMName.ValueString(),
+			VolumeName: data.Filter.VolumeName.ValueString(),
+		}
+	}
+	restInfo, err := interfaces.GetProtocolsNvmeNamespaces(errorHandler, *client, filter)
+	if err != nil {
+		// error reporting done inside GetProtocolsNvmeNamespaces
+		return
+	}
+
+	data.ProtocolsNvmeNamespaces = make([]ProtocolsNvmeNamespaceDataSourceModel, len(restInfo))
+	for index, record := range restInfo {
+		data.ProtocolsNvmeNamespaces[index] = flattenProtocolsNvmeNamespace(data.CxProfileName, record)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,77 @@
+package protocols_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	ntest "github.com/netapp/terraform-provider-netapp-ontap/internal/provider"
+)
+
+func TestAccNvmeServiceResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { ntest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ntest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test error
+			{
+				Config:      testAccNvmeServiceResourceConfig("non-existant", true),
+				ExpectError: regexp.MustCompile("error creating protocols_nvme_service"),
+			},
+			// Create and read
+			{
+				Config: testAccNvmeServiceResourceConfig("carchi-test", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_nvme_service.example", "svm_name", "carchi-test"),
+					resource.TestCheckResourceAttr("netapp-ontap_nvme_service.example", "enabled", "true"),
+				),
+			},
+			// Update and read
+			{
+				Config: testAccNvmeServiceResourceConfig("carchi-test", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_nvme_service.example", "enabled", "false"),
+				),
+			},
+			// Import and read
+			{
+				ResourceName:  "netapp-ontap_nvme_service.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s", "carchi-test", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_nvme_service.example", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNvmeServiceResourceConfig(svmName string, enabled bool) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_nvme_service" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "%s"
+  enabled = %t
+}`, host, admin, password, svmName, enabled)
+}
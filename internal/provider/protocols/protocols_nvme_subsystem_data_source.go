@@ -0,0 +1,163 @@
+package protocols
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ProtocolsNvmeSubsystemDataSource{}
+
+// NewProtocolsNvmeSubsystemDataSource is a helper function to simplify the provider implementation.
+func NewProtocolsNvmeSubsystemDataSource() datasource.DataSource {
+	return &ProtocolsNvmeSubsystemDataSource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "nvme_subsystem",
+		},
+	}
+}
+
+// ProtocolsNvmeSubsystemDataSource defines the data source implementation.
+type ProtocolsNvmeSubsystemDataSource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// ProtocolsNvmeSubsystemDataSourceModel describes the data source data model.
+type ProtocolsNvmeSubsystemDataSourceModel struct {
+	CxProfileName types.String   `tfsdk:"cx_profile_name"`
+	SVMName       types.String   `tfsdk:"svm_name"`
+	Name          types.String   `tfsdk:"name"`
+	OSType        types.String   `tfsdk:"os_type"`
+	Comment       types.String   `tfsdk:"comment"`
+	Hosts         []types.String `tfsdk:"hosts"`
+	TargetNqn     types.String   `tfsdk:"target_nqn"`
+	ID            types.String   `tfsdk:"id"`
+}
+
+// Metadata returns the data source type name.
+func (d *ProtocolsNvmeSubsystemDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.Name
+}
+
+// Schema defines the schema for the data source.
+func (d *ProtocolsNvmeSubsystemDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsNvmeSubsystem data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the SVM",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the NVMe subsystem",
+				Required:            true,
+			},
+			"os_type": schema.StringAttribute{
+				MarkdownDescription: "Operating system of the NVMe subsystem's hosts",
+				Computed:            true,
+			},
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "Comment",
+				Computed:            true,
+			},
+			"hosts": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "NVMe qualified names (NQN) of the hosts allowed to access the subsystem",
+				Computed:            true,
+			},
+			"target_nqn": schema.StringAttribute{
+				MarkdownDescription: "NVMe qualified name used to identify the subsystem",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Subsystem UUID",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ProtocolsNvmeSubsystemDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ProtocolsNvmeSubsystemDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProtocolsNvmeSubsystemDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetProtocolsNvmeSubsystemByName(errorHandler, *client, data.Name.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetProtocolsNvmeSubsystemByName
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No NVMe subsystem %s found on svm %s", data.Name.ValueString(), data.SVMName.ValueString()))
+		return
+	}
+
+	data = flattenProtocolsNvmeSubsystem(data.CxProfileName, *restInfo)
+
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// flattenProtocolsNvmeSubsystem converts an ONTAP subsystem record into the data source model.
+func flattenProtocolsNvmeSubsystem(cxProfileName types.String, record interfaces.ProtocolsNvmeSubsystemGetDataModelONTAP) ProtocolsNvmeSubsystemDataSourceModel {
+	hosts := make([]types.String, len(record.Hosts))
+	for i, host := range record.Hosts {
+		hosts[i] = types.StringValue(host.Nqn)
+	}
+	return ProtocolsNvmeSubsystemDataSourceModel{
+		CxProfileName: cxProfileName,
+		SVMName:       types.StringValue(record.SVM.Name),
+		Name:          types.StringValue(record.Name),
+		OSType:        types.StringValue(record.OSType),
+		Comment:       types.StringValue(record.Comment),
+		Hosts:         hosts,
+		TargetNqn:     types.StringValue(record.TargetNqn),
+		ID:            types.StringValue(record.UUID),
+	}
+}
@@ -0,0 +1,135 @@
+package protocols
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ProtocolsNvmeSubsystemMapDataSource{}
+
+// NewProtocolsNvmeSubsystemMapDataSource is a helper function to simplify the provider implementation.
+func NewProtocolsNvmeSubsystemMapDataSource() datasource.DataSource {
+	return &ProtocolsNvmeSubsystemMapDataSource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "nvme_subsystem_map",
+		},
+	}
+}
+
+// ProtocolsNvmeSubsystemMapDataSource defines the data source implementation.
+type ProtocolsNvmeSubsystemMapDataSource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// ProtocolsNvmeSubsystemMapDataSourceModel describes the data source data model.
+type ProtocolsNvmeSubsystemMapDataSourceModel struct {
+	CxProfileName types.String `tfsdk:"cx_profile_name"`
+	SVMName       types.String `tfsdk:"svm_name"`
+	SubsystemName types.String `tfsdk:"subsystem_name"`
+	NamespaceName types.String `tfsdk:"namespace_name"`
+	Nsid          types.String `tfsdk:"nsid"`
+	Anagrpid      types.String `tfsdk:"anagrpid"`
+}
+
+// Metadata returns the data source type name.
+func (d *ProtocolsNvmeSubsystemMapDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.Name
+}
+
+// Schema defines the schema for the data source.
+func (d *ProtocolsNvmeSubsystemMapDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsNvmeSubsystemMap data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the SVM",
+				Required:            true,
+			},
+			"subsystem_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the NVMe subsystem",
+				Required:            true,
+			},
+			"namespace_name": schema.StringAttribute{
+				MarkdownDescription: "Path of the NVMe namespace",
+				Required:            true,
+			},
+			"nsid": schema.StringAttribute{
+				MarkdownDescription: "NVMe namespace identifier presented to the hosts",
+				Computed:            true,
+			},
+			"anagrpid": schema.StringAttribute{
+				MarkdownDescription: "Asymmetric namespace access group ID",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ProtocolsNvmeSubsystemMapDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ProtocolsNvmeSubsystemMapDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProtocolsNvmeSubsystemMapDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetProtocolsNvmeSubsystemMapByName(errorHandler, *client, data.SubsystemName.ValueString(), data.NamespaceName.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetProtocolsNvmeSubsystemMapByName
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No NVMe subsystem map found for subsystem %s and namespace %s on svm %s", data.SubsystemName.ValueString(), data.NamespaceName.ValueString(), data.SVMName.ValueString()))
+		return
+	}
+
+	data.Nsid = types.StringValue(restInfo.Nsid)
+	data.Anagrpid = types.StringValue(restInfo.Anagrpid)
+
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,277 @@
+package protocols
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ProtocolsNvmeSubsystemMapResource{}
+var _ resource.ResourceWithImportState = &ProtocolsNvmeSubsystemMapResource{}
+
+// NewProtocolsNvmeSubsystemMapResource is a helper function to simplify the provider implementation.
+func NewProtocolsNvmeSubsystemMapResource() resource.Resource {
+	return &ProtocolsNvmeSubsystemMapResource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "nvme_subsystem_map",
+		},
+	}
+}
+
+// ProtocolsNvmeSubsystemMapResource defines the resource implementation.
+type ProtocolsNvmeSubsystemMapResource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// ProtocolsNvmeSubsystemMapResourceModel describes the resource data model.
+type ProtocolsNvmeSubsystemMapResourceModel struct {
+	CxProfileName types.String `tfsdk:"cx_profile_name"`
+	SVMName       types.String `tfsdk:"svm_name"`
+	SubsystemName types.String `tfsdk:"subsystem_name"`
+	NamespaceName types.String `tfsdk:"namespace_name"`
+	Nsid          types.String `tfsdk:"nsid"`
+	Anagrpid      types.String `tfsdk:"anagrpid"`
+	ID            types.String `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *ProtocolsNvmeSubsystemMapResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.Name
+}
+
+// Schema defines the schema for the resource.
+func (r *ProtocolsNvmeSubsystemMapResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsNvmeSubsystemMap resource",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the SVM",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subsystem_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the NVMe subsystem",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"namespace_name": schema.StringAttribute{
+				MarkdownDescription: "Path of the NVMe namespace",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"nsid": schema.StringAttribute{
+				MarkdownDescription: "NVMe namespace identifier presented to the hosts",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"anagrpid": schema.StringAttribute{
+				MarkdownDescription: "Asymmetric namespace access group ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Subsystem map ID, in the form subsystem_uuid/namespace_uuid",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ProtocolsNvmeSubsystemMapResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ProtocolsNvmeSubsystemMapResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProtocolsNvmeSubsystemMapResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetProtocolsNvmeSubsystemMapByName(errorHandler, *client, data.SubsystemName.ValueString(), data.NamespaceName.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetProtocolsNvmeSubsystemMapByName
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No NVMe subsystem map found for subsystem %s and namespace %s on svm %s", data.SubsystemName.ValueString(), data.NamespaceName.ValueString(), data.SVMName.ValueString()))
+		return
+	}
+
+	data.SVMName = types.StringValue(restInfo.SVM.Name)
+	data.SubsystemName = types.StringValue(restInfo.Subsystem.Name)
+	data.NamespaceName = types.StringValue(restInfo.Namespace.Name)
+	data.Nsid = types.StringValue(restInfo.Nsid)
+	data.Anagrpid = types.StringValue(restInfo.Anagrpid)
+	data.ID = types.StringValue(restInfo.Subsystem.UUID + "/" + restInfo.Namespace.UUID)
+
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a resource and retrieve UUID
+func (r *ProtocolsNvmeSubsystemMapResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ProtocolsNvmeSubsystemMapResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var body interfaces.ProtocolsNvmeSubsystemMapResourceBodyDataModelONTAP
+	body.SVM.Name = data.SVMName.ValueString()
+	body.Subsystem.Name = data.SubsystemName.ValueString()
+	body.Namespace.Name = data.NamespaceName.ValueString()
+
+	_, err = interfaces.CreateProtocolsNvmeSubsystemMap(errorHandler, *client, body)
+	if err != nil {
+		return
+	}
+
+	restInfo, err := interfaces.GetProtocolsNvmeSubsystemMapByName(errorHandler, *client, data.SubsystemName.ValueString(), data.NamespaceName.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No NVMe subsystem map found for subsystem %s and namespace %s after create", data.SubsystemName.ValueString(), data.NamespaceName.ValueString()))
+		return
+	}
+
+	data.Nsid = types.StringValue(restInfo.Nsid)
+	data.Anagrpid = types.StringValue(restInfo.Anagrpid)
+	data.ID = types.StringValue(restInfo.Subsystem.UUID + "/" + restInfo.Namespace.UUID)
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ProtocolsNvmeSubsystemMapResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ProtocolsNvmeSubsystemMapResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// every attribute other than cx_profile_name forces a new map
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ProtocolsNvmeSubsystemMapResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ProtocolsNvmeSubsystemMapResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	ids := strings.Split(data.ID.ValueString(), "/")
+	if len(ids) != 2 || ids[0] == "" || ids[1] == "" {
+		errorHandler.MakeAndReportError("ID is invalid", fmt.Sprintf("protocols_nvme_subsystem_map ID %q is not in the form subsystem_uuid/namespace_uuid", data.ID.ValueString()))
+		return
+	}
+
+	err = interfaces.DeleteProtocolsNvmeSubsystemMap(errorHandler, *client, ids[0], ids[1])
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *ProtocolsNvmeSubsystemMapResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: subsystem_name,namespace_name,svm_name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subsystem_name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace_name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[3])...)
+}
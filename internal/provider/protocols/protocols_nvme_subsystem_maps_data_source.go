@@ -0,0 +1,181 @@
+package protocols
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ProtocolsNvmeSubsystemMapsDataSource{}
+
+// NewProtocolsNvmeSubsystemMapsDataSource is a helper function to simplify the provider implementation.
+func NewProtocolsNvmeSubsystemMapsDataSource() datasource.DataSource {
+	return &ProtocolsNvmeSubsystemMapsDataSource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "nvme_subsystem_maps",
+		},
+	}
+}
+
+// ProtocolsNvmeSubsystemMapsDataSource defines the data source implementation.
+type ProtocolsNvmeSubsystemMapsDataSource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// ProtocolsNvmeSubsystemMapsDataSourceModel describes the data source data model.
+type ProtocolsNvmeSubsystemMapsDataSourceModel struct {
+	CxProfileName              types.String                                     `tfsdk:"cx_profile_name"`
+	ProtocolsNvmeSubsystemMaps []ProtocolsNvmeSubsystemMapDataSourceModel       `tfsdk:"protocols_nvme_subsystem_maps"`
+	Filter                     *ProtocolsNvmeSubsystemMapsDataSourceFilterModel `tfsdk:"filter"`
+}
+
+// ProtocolsNvmeSubsystemMapsDataSourceFilterModel describes the data source data model for queries.
+type ProtocolsNvmeSubsystemMapsDataSourceFilterModel struct {
+	SVMName       types.String `tfsdk:"svm_name"`
+	SubsystemName types.String `tfsdk:"subsystem_name"`
+	NamespaceName types.String `tfsdk:"namespace_name"`
+}
+
+// Metadata returns the data source type name.
+func (d *ProtocolsNvmeSubsystemMapsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.Name
+}
+
+// Schema defines the schema for the data source.
+func (d *ProtocolsNvmeSubsystemMapsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsNvmeSubsystemMaps data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"svm_name": schema.StringAttribute{
+						MarkdownDescription: "Name of the SVM",
+						Optional:            true,
+					},
+					"subsystem_name": schema.StringAttribute{
+						MarkdownDescription: "Name of the NVMe subsystem",
+						Optional:            true,
+					},
+					"namespace_name": schema.StringAttribute{
+						MarkdownDescription: "Path of the NVMe namespace",
+						Optional:            true,
+					},
+				},
+				Optional: true,
+			},
+			"protocols_nvme_subsystem_maps": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cx_profile_name": schema.StringAttribute{
+							MarkdownDescription: "Connection profile name",
+							Required:            true,
+						},
+						"svm_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the SVM",
+							Required:            true,
+						},
+						"subsystem_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the NVMe subsystem",
+							Required:            true,
+						},
+						"namespace_name": schema.StringAttribute{
+							MarkdownDescription: "Path of the NVMe namespace",
+							Required:            true,
+						},
+						"nsid": schema.StringAttribute{
+							MarkdownDescription: "NVMe namespace identifier presented to the hosts",
+							Computed:            true,
+						},
+						"anagrpid": schema.StringAttribute{
+							MarkdownDescription: "Asymmetric namespace access group ID",
+							Computed:            true,
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ProtocolsNvmeSubsystemMapsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ProtocolsNvmeSubsystemMapsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProtocolsNvmeSubsystemMapsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var filter *interfaces.ProtocolsNvmeSubsystemMapDataSourceFilterModel = nil
+	if data.Filter != nil {
+		filter = &interfaces.ProtocolsNvmeSubsystemMapDataSourceFilterModel{
+			SVMName:       data.Filter.SVMName.ValueString(),
+			SubsystemName: data.Filter.SubsystemName.ValueString(),
+			NamespaceName: data.Filter.NamespaceName.ValueString(),
+		}
+	}
+	restInfo, err := interfaces.GetProtocolsNvmeSubsystemMaps(errorHandler, *client, filter)
+	if err != nil {
+		// error reporting done inside GetProtocolsNvmeSubsystemMaps
+		return
+	}
+
+	data.ProtocolsNvmeSubsystemMaps = make([]ProtocolsNvmeSubsystemMapDataSourceModel, len(restInfo))
+	for index, record := range restInfo {
+		data.ProtocolsNvmeSubsystemMaps[index] = ProtocolsNvmeSubsystemMapDataSourceModel{
+			CxProfileName: data.CxProfileName,
+			SVMName:       types.StringValue(record.SVM.Name),
+			SubsystemName: types.StringValue(record.Subsystem.Name),
+			NamespaceName: types.StringValue(record.Namespace.Name),
+			Nsid:          types.StringValue(record.Nsid),
+			Anagrpid:      types.StringValue(record.Anagrpid),
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
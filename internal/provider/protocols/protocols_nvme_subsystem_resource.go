@@ -0,0 +1,334 @@
+package protocols
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ProtocolsNvmeSubsystemResource{}
+var _ resource.ResourceWithImportState = &ProtocolsNvmeSubsystemResource{}
+
+// NewProtocolsNvmeSubsystemResource is a helper function to simplify the provider implementation.
+func NewProtocolsNvmeSubsystemResource() resource.Resource {
+	return &ProtocolsNvmeSubsystemResource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "nvme_subsystem",
+		},
+	}
+}
+
+// ProtocolsNvmeSubsystemResource defines the resource implementation.
+type ProtocolsNvmeSubsystemResource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// ProtocolsNvmeSubsystemResourceModel describes the resource data model.
+type ProtocolsNvmeSubsystemResourceModel struct {
+	CxProfileName types.String   `tfsdk:"cx_profile_name"`
+	SVMName       types.String   `tfsdk:"svm_name"`
+	Name          types.String   `tfsdk:"name"`
+	OSType        types.String   `tfsdk:"os_type"`
+	Comment       types.String   `tfsdk:"comment"`
+	Hosts         []types.String `tfsdk:"hosts"`
+	TargetNqn     types.String   `tfsdk:"target_nqn"`
+	ID            types.String   `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *ProtocolsNvmeSubsystemResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.Name
+}
+
+// Schema defines the schema for the resource.
+func (r *ProtocolsNvmeSubsystemResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsNvmeSubsystem resource",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the SVM",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the NVMe subsystem",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"os_type": schema.StringAttribute{
+				MarkdownDescription: "Operating system of the NVMe subsystem's hosts. One of aix, linux, vmware, windows",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"aix", "linux", "vmware", "windows"}...),
+				},
+			},
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "Comment",
+				Optional:            true,
+			},
+			"hosts": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "NVMe qualified names (NQN) of the hosts allowed to access the subsystem",
+				Optional:            true,
+			},
+			"target_nqn": schema.StringAttribute{
+				MarkdownDescription: "NVMe qualified name used to identify the subsystem",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Subsystem UUID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ProtocolsNvmeSubsystemResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ProtocolsNvmeSubsystemResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProtocolsNvmeSubsystemResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetProtocolsNvmeSubsystemByName(errorHandler, *client, data.Name.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetProtocolsNvmeSubsystemByName
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No NVMe subsystem %s found on svm %s", data.Name.ValueString(), data.SVMName.ValueString()))
+		return
+	}
+
+	data.Name = types.StringValue(restInfo.Name)
+	data.SVMName = types.StringValue(restInfo.SVM.Name)
+	data.OSType = types.StringValue(restInfo.OSType)
+	if !data.Comment.IsNull() || restInfo.Comment != "" {
+		data.Comment = types.StringValue(restInfo.Comment)
+	}
+	// hosts added out of band show as drift
+	if data.Hosts != nil || len(restInfo.Hosts) > 0 {
+		data.Hosts = make([]types.String, len(restInfo.Hosts))
+		for i, host := range restInfo.Hosts {
+			data.Hosts[i] = types.StringValue(host.Nqn)
+		}
+	}
+	data.TargetNqn = types.StringValue(restInfo.TargetNqn)
+	data.ID = types.StringValue(restInfo.UUID)
+
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a resource and retrieve UUID
+func (r *ProtocolsNvmeSubsystemResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ProtocolsNvmeSubsystemResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var body interfaces.ProtocolsNvmeSubsystemResourceBodyDataModelONTAP
+	body.Name = data.Name.ValueString()
+	body.SVM.Name = data.SVMName.ValueString()
+	body.OSType = data.OSType.ValueString()
+	body.Comment = data.Comment.ValueString()
+	for _, host := range data.Hosts {
+		body.Hosts = append(body.Hosts, interfaces.NvmeSubsystemHost{Nqn: host.ValueString()})
+	}
+
+	_, err = interfaces.CreateProtocolsNvmeSubsystem(errorHandler, *client, body)
+	if err != nil {
+		return
+	}
+
+	restInfo, err := interfaces.GetProtocolsNvmeSubsystemByName(errorHandler, *client, data.Name.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No NVMe subsystem %s found on svm %s after create", data.Name.ValueString(), data.SVMName.ValueString()))
+		return
+	}
+
+	data.ID = types.StringValue(restInfo.UUID)
+	data.TargetNqn = types.StringValue(restInfo.TargetNqn)
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ProtocolsNvmeSubsystemResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *ProtocolsNvmeSubsystemResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, plan.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if !plan.Comment.Equal(state.Comment) {
+		err = interfaces.UpdateProtocolsNvmeSubsystem(errorHandler, *client, interfaces.ProtocolsNvmeSubsystemUpdateBodyDataModelONTAP{Comment: plan.Comment.ValueString()}, state.ID.ValueString())
+		if err != nil {
+			return
+		}
+	}
+
+	// add new hosts first so that a host being replaced never loses access to every path
+	stateHosts := make(map[string]bool)
+	for _, host := range state.Hosts {
+		stateHosts[host.ValueString()] = true
+	}
+	planHosts := make(map[string]bool)
+	for _, host := range plan.Hosts {
+		planHosts[host.ValueString()] = true
+		if !stateHosts[host.ValueString()] {
+			err = interfaces.AddProtocolsNvmeSubsystemHost(errorHandler, *client, state.ID.ValueString(), host.ValueString())
+			if err != nil {
+				return
+			}
+		}
+	}
+	for _, host := range state.Hosts {
+		if !planHosts[host.ValueString()] {
+			err = interfaces.RemoveProtocolsNvmeSubsystemHost(errorHandler, *client, state.ID.ValueString(), host.ValueString())
+			if err != nil {
+				return
+			}
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ProtocolsNvmeSubsystemResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ProtocolsNvmeSubsystemResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if data.ID.IsNull() {
+		errorHandler.MakeAndReportError("UUID is null", "protocols_nvme_subsystem UUID is null")
+		return
+	}
+
+	err = interfaces.DeleteProtocolsNvmeSubsystem(errorHandler, *client, data.ID.ValueString())
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *ProtocolsNvmeSubsystemResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: name,svm_name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
+}
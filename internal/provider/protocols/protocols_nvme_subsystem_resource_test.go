@@ -0,0 +1,78 @@
+package protocols_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	ntest "github.com/netapp/terraform-provider-netapp-ontap/internal/provider"
+)
+
+func TestAccNvmeSubsystemResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { ntest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ntest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read
+			{
+				Config: testAccNvmeSubsystemResourceConfig("first comment", `"nqn.2014-08.org.nvmexpress:uuid:acc-host1"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_nvme_subsystem.example", "name", "acc_test_subsystem"),
+					resource.TestCheckResourceAttr("netapp-ontap_nvme_subsystem.example", "comment", "first comment"),
+					resource.TestCheckResourceAttr("netapp-ontap_nvme_subsystem.example", "hosts.#", "1"),
+					resource.TestCheckResourceAttrSet("netapp-ontap_nvme_subsystem.example", "target_nqn"),
+				),
+			},
+			// Update comment and swap hosts in place
+			{
+				Config: testAccNvmeSubsystemResourceConfig("second comment", `"nqn.2014-08.org.nvmexpress:uuid:acc-host2", "nqn.2014-08.org.nvmexpress:uuid:acc-host3"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_nvme_subsystem.example", "comment", "second comment"),
+					resource.TestCheckResourceAttr("netapp-ontap_nvme_subsystem.example", "hosts.#", "2"),
+					resource.TestCheckTypeSetElemAttr("netapp-ontap_nvme_subsystem.example", "hosts.*", "nqn.2014-08.org.nvmexpress:uuid:acc-host3"),
+				),
+			},
+			// Import and read
+			{
+				ResourceName:  "netapp-ontap_nvme_subsystem.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s,%s", "acc_test_subsystem", "carchi-test", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_nvme_subsystem.example", "os_type", "linux"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNvmeSubsystemResourceConfig(comment string, hosts string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_nvme_subsystem" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "carchi-test"
+  name = "acc_test_subsystem"
+  os_type = "linux"
+  comment = "%s"
+  hosts = [%s]
+}`, host, admin, password, comment, hosts)
+}
@@ -0,0 +1,183 @@
+package protocols
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ProtocolsNvmeSubsystemsDataSource{}
+
+// NewProtocolsNvmeSubsystemsDataSource is a helper function to simplify the provider implementation.
+func NewProtocolsNvmeSubsystemsDataSource() datasource.DataSource {
+	return &ProtocolsNvmeSubsystemsDataSource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "nvme_subsystems",
+		},
+	}
+}
+
+// ProtocolsNvmeSubsystemsDataSource defines the data source implementation.
+type ProtocolsNvmeSubsystemsDataSource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// ProtocolsNvmeSubsystemsDataSourceModel describes the data source data model.
+type ProtocolsNvmeSubsystemsDataSourceModel struct {
+	CxProfileName           types.String                                  `tfsdk:"cx_profile_name"`
+	ProtocolsNvmeSubsystems []ProtocolsNvmeSubsystemDataSourceModel       `tfsdk:"protocols_nvme_subsystems"`
+	Filter                  *ProtocolsNvmeSubsystemsDataSourceFilterModel `tfsdk:"filter"`
+}
+
+// ProtocolsNvmeSubsystemsDataSourceFilterModel describes the data source data model for queries.
+type ProtocolsNvmeSubsystemsDataSourceFilterModel struct {
+	Name    types.String `tfsdk:"name"`
+	SVMName types.String `tfsdk:"svm_name"`
+	OSType  types.String `tfsdk:"os_type"`
+}
+
+// Metadata returns the data source type name.
+func (d *ProtocolsNvmeSubsystemsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.Name
+}
+
+// Schema defines the schema for the data source.
+func (d *ProtocolsNvmeSubsystemsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsNvmeSubsystems data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Name of the NVMe subsystem",
+						Optional:            true,
+					},
+					"svm_name": schema.StringAttribute{
+						MarkdownDescription: "Name of the SVM",
+						Optional:            true,
+					},
+					"os_type": schema.StringAttribute{
+						MarkdownDescription: "Operating system of the NVMe subsystem's hosts",
+						Optional:            true,
+					},
+				},
+				Optional: true,
+			},
+			"protocols_nvme_subsystems": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cx_profile_name": schema.StringAttribute{
+							MarkdownDescription: "Connection profile name",
+							Required:            true,
+						},
+						"svm_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the SVM",
+							Required:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the NVMe subsystem",
+							Required:            true,
+						},
+						"os_type": schema.StringAttribute{
+							MarkdownDescription: "Operating system of the NVMe subsystem's hosts",
+							Computed:            true,
+						},
+						"comment": schema.StringAttribute{
+							MarkdownDescription: "Comment",
+							Computed:            true,
+						},
+						"hosts": schema.SetAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "NVMe qualified names (NQN) of the hosts allowed to access the subsystem",
+							Computed:            true,
+						},
+						"target_nqn": schema.StringAttribute{
+							MarkdownDescription: "NVMe qualified name used to identify the subsystem",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Subsystem UUID",
+							Computed:            true,
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ProtocolsNvmeSubsystemsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ProtocolsNvmeSubsystemsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProtocolsNvmeSubsystemsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var filter *interfaces.ProtocolsNvmeSubsystemDataSourceFilterModel = nil
+	if data.Filter != nil {
+		filter = &interfaces.ProtocolsNvmeSubsystemDataSourceFilterModel{
+			Name:    data.Filter.Name.ValueString(),
+			SVMName: data.Filter.SVMName.ValueString(),
+			OSType:  data.Filter.OSType.ValueString(),
+		}
+	}
+	restInfo, err := interfaces.GetProtocolsNvmeSubsystems(errorHandler, *client, filter)
+	if err != nil {
+		// error reporting done inside GetProtocolsNvmeSubsystems
+		return
+	}
+
+	data.ProtocolsNvmeSubsystems = make([]ProtocolsNvmeSubsystemDataSourceModel, len(restInfo))
+	for index, record := range restInfo {
+		data.ProtocolsNvmeSubsystems[index] = flattenProtocolsNvmeSubsystem(data.CxProfileName, record)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
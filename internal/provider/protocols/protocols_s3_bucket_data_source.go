@@ -0,0 +1,223 @@
+package protocols
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ProtocolsS3BucketDataSource{}
+
+// NewProtocolsS3BucketDataSource is a helper function to simplify the provider implementation.
+func NewProtocolsS3BucketDataSource() datasource.DataSource {
+	return &ProtocolsS3BucketDataSource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "s3_bucket",
+		},
+	}
+}
+
+// ProtocolsS3BucketDataSource defines the data source implementation.
+type ProtocolsS3BucketDataSource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// ProtocolsS3BucketDataSourceModel describes the data source data model.
+type ProtocolsS3BucketDataSourceModel struct {
+	CxProfileName    types.String                            `tfsdk:"cx_profile_name"`
+	SVMName          types.String                            `tfsdk:"svm_name"`
+	Name             types.String                            `tfsdk:"name"`
+	ServerName       types.String                            `tfsdk:"server_name"`
+	Comment          types.String                            `tfsdk:"comment"`
+	Size             types.Int64                             `tfsdk:"size"`
+	LogicalUsedSize  types.Int64                             `tfsdk:"logical_used_size"`
+	VersioningState  types.String                            `tfsdk:"versioning_state"`
+	Type             types.String                            `tfsdk:"type"`
+	NasPath          types.String                            `tfsdk:"nas_path"`
+	VolumeName       types.String                            `tfsdk:"volume_name"`
+	PolicyStatements []ProtocolsS3BucketPolicyStatementModel `tfsdk:"policy_statements"`
+	ID               types.String                            `tfsdk:"id"`
+}
+
+// Metadata returns the data source type name.
+func (d *ProtocolsS3BucketDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.Name
+}
+
+// Schema defines the schema for the data source.
+func (d *ProtocolsS3BucketDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsS3Bucket data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the SVM",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the bucket",
+				Required:            true,
+			},
+			"server_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the S3 server of the SVM, the endpoint clients reach the bucket on",
+				Computed:            true,
+			},
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "Comment",
+				Computed:            true,
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "Size of the bucket in bytes",
+				Computed:            true,
+			},
+			"logical_used_size": schema.Int64Attribute{
+				MarkdownDescription: "Logical space used in the bucket, in bytes",
+				Computed:            true,
+			},
+			"versioning_state": schema.StringAttribute{
+				MarkdownDescription: "Versioning state of the bucket",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the bucket, s3 or nas",
+				Computed:            true,
+			},
+			"nas_path": schema.StringAttribute{
+				MarkdownDescription: "Path in the SVM namespace a NAS bucket exposes",
+				Computed:            true,
+			},
+			"volume_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the volume backing the bucket",
+				Computed:            true,
+			},
+			"policy_statements": schema.ListNestedAttribute{
+				MarkdownDescription: "Statements of the bucket access policy",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"sid": schema.StringAttribute{
+							MarkdownDescription: "Statement identifier",
+							Computed:            true,
+						},
+						"effect": schema.StringAttribute{
+							MarkdownDescription: "Whether the statement allows or denies access",
+							Computed:            true,
+						},
+						"actions": schema.SetAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "S3 actions the statement applies to",
+							Computed:            true,
+						},
+						"principals": schema.SetAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "S3 users and groups the statement applies to",
+							Computed:            true,
+						},
+						"resources": schema.SetAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "Bucket and objects the statement applies to",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Bucket UUID",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ProtocolsS3BucketDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ProtocolsS3BucketDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProtocolsS3BucketDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetProtocolsS3BucketByName(errorHandler, *client, data.Name.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetProtocolsS3BucketByName
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No S3 bucket %s found on svm %s", data.Name.ValueString(), data.SVMName.ValueString()))
+		return
+	}
+	service, err := interfaces.GetProtocolsS3ServiceBySVMName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+
+	data = flattenProtocolsS3Bucket(data.CxProfileName, *restInfo, service)
+
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// flattenProtocolsS3Bucket converts an ONTAP bucket record into the data source model.
+func flattenProtocolsS3Bucket(cxProfileName types.String, record interfaces.ProtocolsS3BucketGetDataModelONTAP, service *interfaces.ProtocolsS3ServiceGetDataModelONTAP) ProtocolsS3BucketDataSourceModel {
+	data := ProtocolsS3BucketDataSourceModel{
+		CxProfileName:    cxProfileName,
+		SVMName:          types.StringValue(record.SVM.Name),
+		Name:             types.StringValue(record.Name),
+		ServerName:       types.StringNull(),
+		Comment:          types.StringValue(record.Comment),
+		Size:             types.Int64Value(record.Size),
+		LogicalUsedSize:  types.Int64Value(record.LogicalUsedSize),
+		VersioningState:  types.StringValue(record.VersioningState),
+		Type:             types.StringValue(record.Type),
+		NasPath:          types.StringValue(record.NasPath),
+		VolumeName:       types.StringValue(record.Volume.Name),
+		PolicyStatements: flattenProtocolsS3BucketPolicy(nil, record.Policy.Statements),
+		ID:               types.StringValue(record.UUID),
+	}
+	if service != nil {
+		data.ServerName = types.StringValue(service.Name)
+	}
+	return data
+}
@@ -0,0 +1,510 @@
+package protocols
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ProtocolsS3BucketResource{}
+var _ resource.ResourceWithImportState = &ProtocolsS3BucketResource{}
+
+// NewProtocolsS3BucketResource is a helper function to simplify the provider implementation.
+func NewProtocolsS3BucketResource() resource.Resource {
+	return &ProtocolsS3BucketResource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "s3_bucket",
+		},
+	}
+}
+
+// ProtocolsS3BucketResource defines the resource implementation.
+type ProtocolsS3BucketResource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// ProtocolsS3BucketResourceModel describes the resource data model.
+type ProtocolsS3BucketResourceModel struct {
+	CxProfileName    types.String                            `tfsdk:"cx_profile_name"`
+	SVMName          types.String                            `tfsdk:"svm_name"`
+	Name             types.String                            `tfsdk:"name"`
+	Comment          types.String                            `tfsdk:"comment"`
+	Size             types.Int64                             `tfsdk:"size"`
+	VersioningState  types.String                            `tfsdk:"versioning_state"`
+	Type             types.String                            `tfsdk:"type"`
+	NasPath          types.String                            `tfsdk:"nas_path"`
+	PolicyStatements []ProtocolsS3BucketPolicyStatementModel `tfsdk:"policy_statements"`
+	VolumeName       types.String                            `tfsdk:"volume_name"`
+	ID               types.String                            `tfsdk:"id"`
+}
+
+// ProtocolsS3BucketPolicyStatementModel describes a statement of the bucket access policy.
+type ProtocolsS3BucketPolicyStatementModel struct {
+	Sid        types.String   `tfsdk:"sid"`
+	Effect     types.String   `tfsdk:"effect"`
+	Actions    []types.String `tfsdk:"actions"`
+	Principals []types.String `tfsdk:"principals"`
+	Resources  []types.String `tfsdk:"resources"`
+}
+
+// Metadata returns the resource type name.
+func (r *ProtocolsS3BucketResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.Name
+}
+
+// Schema defines the schema for the resource.
+func (r *ProtocolsS3BucketResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsS3Bucket resource",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the SVM",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the bucket",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`), "must be a valid S3 bucket name"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "Comment",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "Size of the bucket in bytes, ONTAP picks a default size when omitted. Not applicable to NAS buckets",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"versioning_state": schema.StringAttribute{
+				MarkdownDescription: "Versioning state of the bucket. One of enabled, suspended, disabled. Versioning can only be suspended once it has been enabled",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("enabled", "suspended", "disabled"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the bucket. One of s3, nas, defaults to s3",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("s3"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("s3", "nas"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"nas_path": schema.StringAttribute{
+				MarkdownDescription: "Path of an existing volume or directory in the SVM namespace a NAS bucket exposes",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^/`), "must be an absolute path in the SVM namespace"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy_statements": schema.ListNestedAttribute{
+				MarkdownDescription: "Statements of the bucket access policy. When omitted, the policy is not managed: ONTAP keeps its current statements and they are not read into the state, so changes made outside of Terraform are not reported. Use the netapp-ontap_s3_bucket data source to read them",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"sid": schema.StringAttribute{
+							MarkdownDescription: "Statement identifier",
+							Optional:            true,
+						},
+						"effect": schema.StringAttribute{
+							MarkdownDescription: "Whether the statement allows or denies access. One of allow, deny",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("allow", "deny"),
+							},
+						},
+						"actions": schema.SetAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "S3 actions the statement applies to, such as GetObject, PutObject, ListBucket or *",
+							Required:            true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+						"principals": schema.SetAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "S3 users and groups the statement applies to, every user when omitted",
+							Optional:            true,
+						},
+						"resources": schema.SetAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "Bucket and objects the statement applies to, such as bucket1 or bucket1/*",
+							Required:            true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"volume_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the volume backing the bucket",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Bucket UUID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ProtocolsS3BucketResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ProtocolsS3BucketResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProtocolsS3BucketResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if !readProtocolsS3Bucket(errorHandler, *client, &data) {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a resource and retrieve UUID
+func (r *ProtocolsS3BucketResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ProtocolsS3BucketResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+
+	var body interfaces.ProtocolsS3BucketResourceBodyDataModelONTAP
+	body.Name = data.Name.ValueString()
+	body.Comment = data.Comment.ValueString()
+	body.Type = data.Type.ValueString()
+	body.NasPath = data.NasPath.ValueString()
+	if !data.Size.IsUnknown() {
+		body.Size = data.Size.ValueInt64()
+	}
+	if !data.VersioningState.IsUnknown() {
+		body.VersioningState = data.VersioningState.ValueString()
+	}
+	if data.PolicyStatements != nil {
+		body.Policy = expandProtocolsS3BucketPolicy(data.PolicyStatements)
+	}
+
+	err = interfaces.CreateProtocolsS3Bucket(errorHandler, *client, svm.UUID, body)
+	if err != nil {
+		return
+	}
+
+	// the bucket is created by a job, read it back for the UUID and the ONTAP defaults
+	if !readProtocolsS3Bucket(errorHandler, *client, data) {
+		return
+	}
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ProtocolsS3BucketResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *ProtocolsS3BucketResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, plan.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, state.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+
+	var body interfaces.ProtocolsS3BucketUpdateBodyDataModelONTAP
+	changed := false
+	if !plan.Comment.Equal(state.Comment) {
+		comment := plan.Comment.ValueString()
+		body.Comment = &comment
+		changed = true
+	}
+	if !plan.Size.IsUnknown() && !plan.Size.Equal(state.Size) {
+		body.Size = plan.Size.ValueInt64()
+		changed = true
+	}
+	if !plan.VersioningState.IsUnknown() && !plan.VersioningState.Equal(state.VersioningState) {
+		body.VersioningState = plan.VersioningState.ValueString()
+		changed = true
+	}
+	if plan.PolicyStatements != nil && !equalProtocolsS3BucketPolicyStatements(plan.PolicyStatements, state.PolicyStatements) {
+		body.Policy = expandProtocolsS3BucketPolicy(plan.PolicyStatements)
+		changed = true
+	}
+	if changed {
+		err = interfaces.UpdateProtocolsS3Bucket(errorHandler, *client, svm.UUID, state.ID.ValueString(), body)
+		if err != nil {
+			return
+		}
+	}
+
+	if !readProtocolsS3Bucket(errorHandler, *client, plan) {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ProtocolsS3BucketResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ProtocolsS3BucketResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if data.ID.IsNull() {
+		errorHandler.MakeAndReportError("UUID is null", "protocols_s3_bucket UUID is null")
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	err = interfaces.DeleteProtocolsS3Bucket(errorHandler, *client, svm.UUID, data.ID.ValueString())
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *ProtocolsS3BucketResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: name,svm_name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
+}
+
+func readProtocolsS3Bucket(errorHandler *utils.ErrorHandler, r restclient.RestClient, data *ProtocolsS3BucketResourceModel) bool {
+	restInfo, err := interfaces.GetProtocolsS3BucketByName(errorHandler, r, data.Name.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetProtocolsS3BucketByName
+		return false
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No S3 bucket %s found on svm %s", data.Name.ValueString(), data.SVMName.ValueString()))
+		return false
+	}
+
+	data.Name = types.StringValue(restInfo.Name)
+	data.Comment = types.StringValue(restInfo.Comment)
+	data.Size = types.Int64Value(restInfo.Size)
+	data.VersioningState = types.StringValue(restInfo.VersioningState)
+	if restInfo.Type != "" {
+		data.Type = types.StringValue(restInfo.Type)
+	}
+	if restInfo.Type == "nas" {
+		data.NasPath = types.StringValue(restInfo.NasPath)
+	}
+	if data.PolicyStatements != nil {
+		data.PolicyStatements = flattenProtocolsS3BucketPolicy(data.PolicyStatements, restInfo.Policy.Statements)
+	}
+	data.VolumeName = types.StringValue(restInfo.Volume.Name)
+	data.ID = types.StringValue(restInfo.UUID)
+	return true
+}
+
+func expandProtocolsS3BucketPolicy(statements []ProtocolsS3BucketPolicyStatementModel) *interfaces.ProtocolsS3BucketPolicyDataModel {
+	// an empty list is sent as is, to remove every statement
+	policy := interfaces.ProtocolsS3BucketPolicyDataModel{Statements: []interfaces.ProtocolsS3BucketPolicyStatementDataModel{}}
+	for _, statement := range statements {
+		policy.Statements = append(policy.Statements, interfaces.ProtocolsS3BucketPolicyStatementDataModel{
+			Sid:        statement.Sid.ValueString(),
+			Effect:     statement.Effect.ValueString(),
+			Actions:    typesToStrings(statement.Actions),
+			Principals: typesToStrings(statement.Principals),
+			Resources:  typesToStrings(statement.Resources),
+		})
+	}
+	return &policy
+}
+
+// flattenProtocolsS3BucketPolicy keeps sid and principals unset when they were not configured and ONTAP has none
+func flattenProtocolsS3BucketPolicy(prior []ProtocolsS3BucketPolicyStatementModel, statements []interfaces.ProtocolsS3BucketPolicyStatementDataModel) []ProtocolsS3BucketPolicyStatementModel {
+	result := make([]ProtocolsS3BucketPolicyStatementModel, 0, len(statements))
+	for index, statement := range statements {
+		model := ProtocolsS3BucketPolicyStatementModel{
+			Sid:        types.StringValue(statement.Sid),
+			Effect:     types.StringValue(statement.Effect),
+			Actions:    stringsToTypes(statement.Actions),
+			Principals: stringsToTypes(statement.Principals),
+			Resources:  stringsToTypes(statement.Resources),
+		}
+		if statement.Sid == "" && (index >= len(prior) || prior[index].Sid.IsNull()) {
+			model.Sid = types.StringNull()
+		}
+		result = append(result, model)
+	}
+	return result
+}
+
+// equalProtocolsS3BucketPolicyStatements compares the policy statements element by element.
+func equalProtocolsS3BucketPolicyStatements(a, b []ProtocolsS3BucketPolicyStatementModel) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// equal compares the statements attribute by attribute.
+func (m ProtocolsS3BucketPolicyStatementModel) equal(other ProtocolsS3BucketPolicyStatementModel) bool {
+	return m.Sid.Equal(other.Sid) &&
+		m.Effect.Equal(other.Effect) &&
+		equalStringValues(m.Actions, other.Actions) &&
+		equalStringValues(m.Principals, other.Principals) &&
+		equalStringValues(m.Resources, other.Resources)
+}
+
+// equalStringValues compares two lists of strings element by element.
+func equalStringValues(a, b []types.String) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
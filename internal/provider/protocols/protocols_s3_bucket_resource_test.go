@@ -0,0 +1,88 @@
+package protocols_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	ntest "github.com/netapp/terraform-provider-netapp-ontap/internal/provider"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccProtocolsS3BucketResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { ntest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ntest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test non existant SVM
+			{
+				Config:      testAccProtocolsS3BucketResourceConfig("nosvm", "tfbucket1", "enabled"),
+				ExpectError: regexp.MustCompile("error reading svm info"),
+			},
+			// Create s3_bucket and read
+			{
+				Config: testAccProtocolsS3BucketResourceConfig("svm0", "tfbucket1", "enabled"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_s3_bucket.example", "name", "tfbucket1"),
+					resource.TestCheckResourceAttr("netapp-ontap_s3_bucket.example", "versioning_state", "enabled"),
+					resource.TestCheckResourceAttr("netapp-ontap_s3_bucket.example", "policy_statements.0.effect", "allow"),
+				),
+			},
+			// Update versioning_state
+			{
+				Config: testAccProtocolsS3BucketResourceConfig("svm0", "tfbucket1", "suspended"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_s3_bucket.example", "versioning_state", "suspended"),
+				),
+			},
+			// Import and read
+			{
+				ResourceName:  "netapp-ontap_s3_bucket.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s,%s", "tfbucket1", "svm0", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_s3_bucket.example", "name", "tfbucket1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProtocolsS3BucketResourceConfig(svm string, name string, versioningState string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_s3_bucket" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "%s"
+  name = "%s"
+  size = 107374182400
+  versioning_state = "%s"
+  policy_statements = [
+    {
+      effect = "allow"
+      actions = ["GetObject", "ListBucket"]
+      resources = ["%s", "%s/*"]
+    },
+  ]
+}`, host, admin, password, svm, name, versioningState, name, name)
+}
@@ -0,0 +1,239 @@
+package protocols
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ProtocolsS3BucketsDataSource{}
+
+// NewProtocolsS3BucketsDataSource is a helper function to simplify the provider implementation.
+func NewProtocolsS3BucketsDataSource() datasource.DataSource {
+	return &ProtocolsS3BucketsDataSource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "s3_buckets",
+		},
+	}
+}
+
+// ProtocolsS3BucketsDataSource defines the data source implementation.
+type ProtocolsS3BucketsDataSource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// ProtocolsS3BucketsDataSourceModel describes the data source data model.
+type ProtocolsS3BucketsDataSourceModel struct {
+	CxProfileName      types.String                             `tfsdk:"cx_profile_name"`
+	ProtocolsS3Buckets []ProtocolsS3BucketDataSourceModel       `tfsdk:"protocols_s3_buckets"`
+	Filter             *ProtocolsS3BucketsDataSourceFilterModel `tfsdk:"filter"`
+}
+
+// ProtocolsS3BucketsDataSourceFilterModel describes the data source data model for queries.
+type ProtocolsS3BucketsDataSourceFilterModel struct {
+	Name    types.String `tfsdk:"name"`
+	SVMName types.String `tfsdk:"svm_name"`
+	Type    types.String `tfsdk:"type"`
+}
+
+// Metadata returns the data source type name.
+func (d *ProtocolsS3BucketsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.Name
+}
+
+// Schema defines the schema for the data source.
+func (d *ProtocolsS3BucketsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsS3Buckets data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Name of the bucket",
+						Optional:            true,
+					},
+					"svm_name": schema.StringAttribute{
+						MarkdownDescription: "Name of the SVM",
+						Optional:            true,
+					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Type of the bucket, s3 or nas",
+						Optional:            true,
+					},
+				},
+				Optional: true,
+			},
+			"protocols_s3_buckets": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cx_profile_name": schema.StringAttribute{
+							MarkdownDescription: "Connection profile name",
+							Required:            true,
+						},
+						"svm_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the SVM",
+							Required:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the bucket",
+							Required:            true,
+						},
+						"server_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the S3 server of the SVM, the endpoint clients reach the bucket on",
+							Computed:            true,
+						},
+						"comment": schema.StringAttribute{
+							MarkdownDescription: "Comment",
+							Computed:            true,
+						},
+						"size": schema.Int64Attribute{
+							MarkdownDescription: "Size of the bucket in bytes",
+							Computed:            true,
+						},
+						"logical_used_size": schema.Int64Attribute{
+							MarkdownDescription: "Logical space used in the bucket, in bytes",
+							Computed:            true,
+						},
+						"versioning_state": schema.StringAttribute{
+							MarkdownDescription: "Versioning state of the bucket",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the bucket, s3 or nas",
+							Computed:            true,
+						},
+						"nas_path": schema.StringAttribute{
+							MarkdownDescription: "Path in the SVM namespace a NAS bucket exposes",
+							Computed:            true,
+						},
+						"volume_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the volume backing the bucket",
+							Computed:            true,
+						},
+						"policy_statements": schema.ListNestedAttribute{
+							MarkdownDescription: "Statements of the bucket access policy",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"sid": schema.StringAttribute{
+										MarkdownDescription: "Statement identifier",
+										Computed:            true,
+									},
+									"effect": schema.StringAttribute{
+										MarkdownDescription: "Whether the statement allows or denies access",
+										Computed:            true,
+									},
+									"actions": schema.SetAttribute{
+										ElementType:         types.StringType,
+										MarkdownDescription: "S3 actions the statement applies to",
+										Computed:            true,
+									},
+									"principals": schema.SetAttribute{
+										ElementType:         types.StringType,
+										MarkdownDescription: "S3 users and groups the statement applies to",
+										Computed:            true,
+									},
+									"resources": schema.SetAttribute{
+										ElementType:         types.StringType,
+										MarkdownDescription: "Bucket and objects the statement applies to",
+										Computed:            true,
+									},
+								},
+							},
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Bucket UUID",
+							Computed:            true,
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ProtocolsS3BucketsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ProtocolsS3BucketsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProtocolsS3BucketsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var filter *interfaces.ProtocolsS3BucketDataSourceFilterModel = nil
+	if data.Filter != nil {
+		filter = &interfaces.ProtocolsS3BucketDataSourceFilterModel{
+			Name:    data.Filter.Name.ValueString(),
+			SVMName: data.Filter.SVMName.ValueString(),
+			Type:    data.Filter.Type.ValueString(),
+		}
+	}
+	restInfo, err := interfaces.GetProtocolsS3Buckets(errorHandler, *client, filter)
+	if err != nil {
+		// error reporting done inside GetProtocolsS3Buckets
+		return
+	}
+
+	// buckets of the same SVM share the S3 server, look it up once per SVM
+	services := map[string]*interfaces.ProtocolsS3ServiceGetDataModelONTAP{}
+	data.ProtocolsS3Buckets = make([]ProtocolsS3BucketDataSourceModel, len(restInfo))
+	for index, record := range restInfo {
+		service, ok := services[record.SVM.Name]
+		if !ok {
+			service, err = interfaces.GetProtocolsS3ServiceBySVMName(errorHandler, *client, record.SVM.Name)
+			if err != nil {
+				return
+			}
+			services[record.SVM.Name] = service
+		}
+		data.ProtocolsS3Buckets[index] = flattenProtocolsS3Bucket(data.CxProfileName, record, service)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
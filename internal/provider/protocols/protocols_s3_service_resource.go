@@ -0,0 +1,350 @@
+package protocols
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ProtocolsS3ServiceResource{}
+var _ resource.ResourceWithImportState = &ProtocolsS3ServiceResource{}
+
+// NewProtocolsS3ServiceResource is a helper function to simplify the provider implementation.
+func NewProtocolsS3ServiceResource() resource.Resource {
+	return &ProtocolsS3ServiceResource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "s3_service",
+		},
+	}
+}
+
+// ProtocolsS3ServiceResource defines the resource implementation.
+type ProtocolsS3ServiceResource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// ProtocolsS3ServiceResourceModel describes the resource data model.
+type ProtocolsS3ServiceResourceModel struct {
+	CxProfileName   types.String `tfsdk:"cx_profile_name"`
+	SVMName         types.String `tfsdk:"svm_name"`
+	Name            types.String `tfsdk:"name"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	Comment         types.String `tfsdk:"comment"`
+	IsHTTPEnabled   types.Bool   `tfsdk:"is_http_enabled"`
+	IsHTTPSEnabled  types.Bool   `tfsdk:"is_https_enabled"`
+	Port            types.Int64  `tfsdk:"port"`
+	SecurePort      types.Int64  `tfsdk:"secure_port"`
+	CertificateName types.String `tfsdk:"certificate_name"`
+	ID              types.String `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *ProtocolsS3ServiceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.Name
+}
+
+// Schema defines the schema for the resource.
+func (r *ProtocolsS3ServiceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsS3Service resource",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the SVM",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the S3 server, the fully qualified domain name clients use to reach it",
+				Required:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the S3 server is enabled",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "Comment",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"is_http_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether HTTP is enabled on the S3 server",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"is_https_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether HTTPS is enabled on the S3 server",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "Port the S3 server listens on for HTTP",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(80),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"secure_port": schema.Int64Attribute{
+				MarkdownDescription: "Port the S3 server listens on for HTTPS",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(443),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"certificate_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the server certificate used for HTTPS",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "SVM UUID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ProtocolsS3ServiceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ProtocolsS3ServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProtocolsS3ServiceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetProtocolsS3ServiceBySVMName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetProtocolsS3ServiceBySVMName
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No S3 server found on svm %s", data.SVMName.ValueString()))
+		return
+	}
+
+	flattenProtocolsS3Service(&data, restInfo)
+
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a resource and retrieve UUID
+func (r *ProtocolsS3ServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ProtocolsS3ServiceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	body := expandProtocolsS3Service(data)
+	body.SVM = &interfaces.SvmDataModelONTAP{Name: data.SVMName.ValueString()}
+	err = interfaces.CreateProtocolsS3Service(errorHandler, *client, body)
+	if err != nil {
+		return
+	}
+
+	restInfo, err := interfaces.GetProtocolsS3ServiceBySVMName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No S3 server found on svm %s after create", data.SVMName.ValueString()))
+		return
+	}
+	flattenProtocolsS3Service(data, restInfo)
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ProtocolsS3ServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *ProtocolsS3ServiceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, plan.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	err = interfaces.UpdateProtocolsS3Service(errorHandler, *client, state.ID.ValueString(), expandProtocolsS3Service(plan))
+	if err != nil {
+		return
+	}
+
+	restInfo, err := interfaces.GetProtocolsS3ServiceBySVMName(errorHandler, *client, plan.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No S3 server found on svm %s after update", plan.SVMName.ValueString()))
+		return
+	}
+	flattenProtocolsS3Service(plan, restInfo)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ProtocolsS3ServiceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ProtocolsS3ServiceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if data.ID.IsNull() {
+		errorHandler.MakeAndReportError("UUID is null", "protocols_s3_service UUID is null")
+		return
+	}
+
+	err = interfaces.DeleteProtocolsS3Service(errorHandler, *client, data.ID.ValueString())
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *ProtocolsS3ServiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: svm_name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[1])...)
+}
+
+func expandProtocolsS3Service(data *ProtocolsS3ServiceResourceModel) interfaces.ProtocolsS3ServiceResourceBodyDataModelONTAP {
+	body := interfaces.ProtocolsS3ServiceResourceBodyDataModelONTAP{
+		Name:           data.Name.ValueString(),
+		Enabled:        data.Enabled.ValueBool(),
+		Comment:        data.Comment.ValueString(),
+		IsHTTPEnabled:  data.IsHTTPEnabled.ValueBool(),
+		IsHTTPSEnabled: data.IsHTTPSEnabled.ValueBool(),
+		Port:           data.Port.ValueInt64(),
+		SecurePort:     data.SecurePort.ValueInt64(),
+	}
+	if data.CertificateName.ValueString() != "" {
+		body.Certificate = &interfaces.ProtocolsS3CertificateDataModel{Name: data.CertificateName.ValueString()}
+	}
+	return body
+}
+
+func flattenProtocolsS3Service(data *ProtocolsS3ServiceResourceModel, restInfo *interfaces.ProtocolsS3ServiceGetDataModelONTAP) {
+	data.SVMName = types.StringValue(restInfo.SVM.Name)
+	data.Name = types.StringValue(restInfo.Name)
+	data.Enabled = types.BoolValue(restInfo.Enabled)
+	data.Comment = types.StringValue(restInfo.Comment)
+	data.IsHTTPEnabled = types.BoolValue(restInfo.IsHTTPEnabled)
+	data.IsHTTPSEnabled = types.BoolValue(restInfo.IsHTTPSEnabled)
+	data.Port = types.Int64Value(restInfo.Port)
+	data.SecurePort = types.Int64Value(restInfo.SecurePort)
+	data.CertificateName = types.StringValue(restInfo.Certificate.Name)
+	data.ID = types.StringValue(restInfo.SVM.UUID)
+}
@@ -0,0 +1,75 @@
+package protocols_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	ntest "github.com/netapp/terraform-provider-netapp-ontap/internal/provider"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccProtocolsS3UserResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { ntest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ntest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create s3_user and read
+			{
+				Config: testAccProtocolsS3UserResourceConfig("svm0", "tfuser1", "terraform user"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_s3_user.example", "name", "tfuser1"),
+					resource.TestCheckResourceAttrSet("netapp-ontap_s3_user.example", "access_key"),
+					resource.TestCheckResourceAttrSet("netapp-ontap_s3_user.example", "secret_key"),
+				),
+			},
+			// Update comment, the keys are kept
+			{
+				Config: testAccProtocolsS3UserResourceConfig("svm0", "tfuser1", "terraform user updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_s3_user.example", "comment", "terraform user updated"),
+					resource.TestCheckResourceAttrSet("netapp-ontap_s3_user.example", "secret_key"),
+				),
+			},
+			// Import and read
+			{
+				ResourceName:  "netapp-ontap_s3_user.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s,%s", "tfuser1", "svm0", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_s3_user.example", "name", "tfuser1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProtocolsS3UserResourceConfig(svm string, name string, comment string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_s3_user" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "%s"
+  name = "%s"
+  comment = "%s"
+}`, host, admin, password, svm, name, comment)
+}
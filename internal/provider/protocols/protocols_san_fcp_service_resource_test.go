@@ -0,0 +1,78 @@
+package protocols_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	ntest "github.com/netapp/terraform-provider-netapp-ontap/internal/provider"
+)
+
+func TestAccSanFcpServiceResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { ntest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ntest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test error
+			{
+				Config:      testAccSanFcpServiceResourceConfig("non-existant", true),
+				ExpectError: regexp.MustCompile("error creating protocols_san_fcp_service"),
+			},
+			// Create and read
+			{
+				Config: testAccSanFcpServiceResourceConfig("carchi-test", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_san_fcp_service.example", "svm_name", "carchi-test"),
+					resource.TestCheckResourceAttr("netapp-ontap_san_fcp_service.example", "enabled", "true"),
+					resource.TestCheckResourceAttrSet("netapp-ontap_san_fcp_service.example", "target_name"),
+				),
+			},
+			// Update and read
+			{
+				Config: testAccSanFcpServiceResourceConfig("carchi-test", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_san_fcp_service.example", "enabled", "false"),
+				),
+			},
+			// Import and read
+			{
+				ResourceName:  "netapp-ontap_san_fcp_service.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s", "carchi-test", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_san_fcp_service.example", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSanFcpServiceResourceConfig(svmName string, enabled bool) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_san_fcp_service" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "%s"
+  enabled = %t
+}`, host, admin, password, svmName, enabled)
+}
@@ -140,7 +140,7 @@ func (r *ProtocolsSanIgroupResource) Schema(ctx context.Context, req resource.Sc
 				Required:            true,
 			},
 			"portset": schema.SingleNestedAttribute{
-				MarkdownDescription: "Required ONTAP 9.9 or greater. The portset to which the initiator group is bound. Binding the initiator group to a portset restricts the initiators of the group to accessing mapped LUNs only through network interfaces in the portset.",
+				MarkdownDescription: "Required ONTAP 9.9 or greater. The portset to which the initiator group is bound. Binding the initiator group to a portset restricts the initiators of the group to accessing mapped LUNs only through network interfaces in the portset. The portset can be changed in place, set `name` to an empty string to unbind it.",
 				Optional:            true,
 				Computed:            true,
 				PlanModifiers:       []planmodifier.Object{objectplanmodifier.UseStateForUnknown()},
@@ -358,6 +358,19 @@ func (r *ProtocolsSanIgroupResource) Update(ctx context.Context, req resource.Up
 	if !data.OsType.Equal(state.OsType) {
 		request.OsType = data.OsType.ValueString()
 	}
+	// bind, rebind or unbind the portset in place
+	if !data.Portset.IsUnknown() && !data.Portset.Equal(state.Portset) {
+		request.Portset = &interfaces.IgroupPortsetBinding{}
+		if !data.Portset.IsNull() {
+			var portset ProtocolsSanIgroupResourcePortsetModel
+			diags := data.Portset.As(ctx, &portset, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				resp.Diagnostics.Append(diags...)
+				return
+			}
+			request.Portset.Name = portset.Name.ValueString()
+		}
+	}
 
 	tflog.Debug(ctx, fmt.Sprintf("update an igroup resource: %#v", data))
 	err = interfaces.UpdateProtocolsSanIgroup(errorHandler, *client, request, state.ID.ValueString())
@@ -0,0 +1,344 @@
+package protocols
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ProtocolsSanIscsiCredentialsResource{}
+var _ resource.ResourceWithImportState = &ProtocolsSanIscsiCredentialsResource{}
+
+// NewProtocolsSanIscsiCredentialsResource is a helper function to simplify the provider implementation.
+func NewProtocolsSanIscsiCredentialsResource() resource.Resource {
+	return &ProtocolsSanIscsiCredentialsResource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "san_iscsi_credentials",
+		},
+	}
+}
+
+// ProtocolsSanIscsiCredentialsResource defines the resource implementation.
+type ProtocolsSanIscsiCredentialsResource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// ProtocolsSanIscsiCredentialsResourceModel describes the resource data model.
+type ProtocolsSanIscsiCredentialsResourceModel struct {
+	CxProfileName      types.String `tfsdk:"cx_profile_name"`
+	SVMName            types.String `tfsdk:"svm_name"`
+	Initiator          types.String `tfsdk:"initiator"`
+	AuthenticationType types.String `tfsdk:"authentication_type"`
+	InboundUser        types.String `tfsdk:"inbound_user"`
+	InboundPassword    types.String `tfsdk:"inbound_password"`
+	OutboundUser       types.String `tfsdk:"outbound_user"`
+	OutboundPassword   types.String `tfsdk:"outbound_password"`
+	ID                 types.String `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *ProtocolsSanIscsiCredentialsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.Name
+}
+
+// Schema defines the schema for the resource.
+func (r *ProtocolsSanIscsiCredentialsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsSanIscsiCredentials resource",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the SVM",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"initiator": schema.StringAttribute{
+				MarkdownDescription: "The iSCSI initiator name, or `default` for the default credentials of the SVM",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"authentication_type": schema.StringAttribute{
+				MarkdownDescription: "The iSCSI authentication type",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("chap"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("chap", "none", "deny"),
+				},
+			},
+			"inbound_user": schema.StringAttribute{
+				MarkdownDescription: "The inbound CHAP user name",
+				Optional:            true,
+			},
+			"inbound_password": schema.StringAttribute{
+				MarkdownDescription: "The inbound CHAP password, it is not returned by ONTAP",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"outbound_user": schema.StringAttribute{
+				MarkdownDescription: "The outbound CHAP user name, inbound credentials are required with outbound credentials. Removing it clears the outbound credentials",
+				Optional:            true,
+			},
+			"outbound_password": schema.StringAttribute{
+				MarkdownDescription: "The outbound CHAP password, it is not returned by ONTAP",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "iSCSI credentials identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ProtocolsSanIscsiCredentialsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ProtocolsSanIscsiCredentialsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProtocolsSanIscsiCredentialsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetProtocolsSanIscsiCredentials(errorHandler, *client, data.SVMName.ValueString(), data.Initiator.ValueString())
+	if err != nil {
+		// error reporting done inside GetProtocolsSanIscsiCredentials
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No iSCSI credentials found for initiator %s on svm %s", data.Initiator.ValueString(), data.SVMName.ValueString()))
+		return
+	}
+
+	data.AuthenticationType = types.StringValue(restInfo.AuthenticationType)
+	// passwords are not returned by ONTAP, the values from the state are kept
+	data.InboundUser = types.StringNull()
+	if restInfo.Chap.Inbound != nil && restInfo.Chap.Inbound.User != "" {
+		data.InboundUser = types.StringValue(restInfo.Chap.Inbound.User)
+	}
+	data.OutboundUser = types.StringNull()
+	if restInfo.Chap.Outbound != nil && restInfo.Chap.Outbound.User != "" {
+		data.OutboundUser = types.StringValue(restInfo.Chap.Outbound.User)
+	}
+	data.ID = types.StringValue(restInfo.SVM.Name + "/" + restInfo.Initiator)
+
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: svm %s, initiator %s", data.SVMName.ValueString(), data.Initiator.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a resource and retrieve UUID
+func (r *ProtocolsSanIscsiCredentialsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ProtocolsSanIscsiCredentialsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var body interfaces.ProtocolsSanIscsiCredentialsResourceBodyDataModelONTAP
+	body.SVM.Name = data.SVMName.ValueString()
+	body.Initiator = data.Initiator.ValueString()
+	body.AuthenticationType = data.AuthenticationType.ValueString()
+	body.Chap = iscsiCredentialsChap(data, true, true)
+
+	err = interfaces.CreateProtocolsSanIscsiCredentials(errorHandler, *client, body)
+	if err != nil {
+		return
+	}
+
+	data.ID = types.StringValue(data.SVMName.ValueString() + "/" + data.Initiator.ValueString())
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ProtocolsSanIscsiCredentialsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *ProtocolsSanIscsiCredentialsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, plan.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetProtocolsSanIscsiCredentials(errorHandler, *client, state.SVMName.ValueString(), state.Initiator.ValueString())
+	if err != nil {
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No iSCSI credentials found for initiator %s on svm %s", state.Initiator.ValueString(), state.SVMName.ValueString()))
+		return
+	}
+
+	var body interfaces.ProtocolsSanIscsiCredentialsUpdateBodyDataModelONTAP
+	body.AuthenticationType = plan.AuthenticationType.ValueString()
+	// a CHAP user is always sent with its password
+	inbound := !plan.InboundUser.Equal(state.InboundUser) || !plan.InboundPassword.Equal(state.InboundPassword)
+	outbound := !plan.OutboundUser.Equal(state.OutboundUser) || !plan.OutboundPassword.Equal(state.OutboundPassword)
+	body.Chap = iscsiCredentialsChapUpdate(plan, inbound, outbound)
+
+	err = interfaces.UpdateProtocolsSanIscsiCredentials(errorHandler, *client, body, restInfo.SVM.UUID, restInfo.Initiator)
+	if err != nil {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ProtocolsSanIscsiCredentialsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ProtocolsSanIscsiCredentialsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetProtocolsSanIscsiCredentials(errorHandler, *client, data.SVMName.ValueString(), data.Initiator.ValueString())
+	if err != nil {
+		return
+	}
+	if restInfo == nil {
+		// already gone
+		return
+	}
+
+	err = interfaces.DeleteProtocolsSanIscsiCredentials(errorHandler, *client, restInfo.SVM.UUID, restInfo.Initiator)
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *ProtocolsSanIscsiCredentialsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: initiator,svm_name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("initiator"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
+}
+
+// iscsiCredentialsChap builds the CHAP body, only including the inbound or outbound credentials when requested.
+func iscsiCredentialsChap(data *ProtocolsSanIscsiCredentialsResourceModel, inbound bool, outbound bool) *interfaces.SanIscsiCredentialsChap {
+	var chap interfaces.SanIscsiCredentialsChap
+	if inbound && !data.InboundUser.IsNull() {
+		chap.Inbound = &interfaces.SanIscsiCredentialsChapUser{User: data.InboundUser.ValueString(), Password: data.InboundPassword.ValueString()}
+	}
+	if outbound && !data.OutboundUser.IsNull() {
+		chap.Outbound = &interfaces.SanIscsiCredentialsChapUser{User: data.OutboundUser.ValueString(), Password: data.OutboundPassword.ValueString()}
+	}
+	if chap.Inbound == nil && chap.Outbound == nil {
+		return nil
+	}
+	return &chap
+}
+
+// iscsiCredentialsChapUpdate builds the CHAP PATCH body, removed outbound credentials are cleared with an empty user.
+func iscsiCredentialsChapUpdate(data *ProtocolsSanIscsiCredentialsResourceModel, inbound bool, outbound bool) *interfaces.SanIscsiCredentialsChapUpdate {
+	var chap interfaces.SanIscsiCredentialsChapUpdate
+	if inbound && !data.InboundUser.IsNull() {
+		chap.Inbound = &interfaces.SanIscsiCredentialsChapUser{User: data.InboundUser.ValueString(), Password: data.InboundPassword.ValueString()}
+	}
+	if outbound {
+		chap.Outbound = &interfaces.SanIscsiCredentialsChapUserUpdate{User: data.OutboundUser.ValueString(), Password: data.OutboundPassword.ValueString()}
+	}
+	if chap.Inbound == nil && chap.Outbound == nil {
+		return nil
+	}
+	return &chap
+}
@@ -0,0 +1,98 @@
+package protocols_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	ntest "github.com/netapp/terraform-provider-netapp-ontap/internal/provider"
+)
+
+func TestAccSanIscsiCredentialsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { ntest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ntest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test error
+			{
+				Config:      testAccSanIscsiCredentialsResourceConfig("non-existant", "chapuser1", ""),
+				ExpectError: regexp.MustCompile("error creating protocols_san_iscsi_credentials"),
+			},
+			// Create and read
+			{
+				Config: testAccSanIscsiCredentialsResourceConfig("carchi-test", "chapuser1", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_san_iscsi_credentials.example", "authentication_type", "chap"),
+					resource.TestCheckResourceAttr("netapp-ontap_san_iscsi_credentials.example", "inbound_user", "chapuser1"),
+				),
+			},
+			// Update and read
+			{
+				Config: testAccSanIscsiCredentialsResourceConfig("carchi-test", "chapuser2", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_san_iscsi_credentials.example", "inbound_user", "chapuser2"),
+				),
+			},
+			// Add outbound credentials
+			{
+				Config: testAccSanIscsiCredentialsResourceConfig("carchi-test", "chapuser2", "chapoutbound1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_san_iscsi_credentials.example", "outbound_user", "chapoutbound1"),
+				),
+			},
+			// Remove outbound credentials, they are cleared on ONTAP and the plan converges
+			{
+				Config: testAccSanIscsiCredentialsResourceConfig("carchi-test", "chapuser2", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("netapp-ontap_san_iscsi_credentials.example", "outbound_user"),
+				),
+			},
+			// Import and read
+			{
+				ResourceName:            "netapp-ontap_san_iscsi_credentials.example",
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("%s,%s,%s", "iqn.1995-08.com.example:acc-test", "carchi-test", "cluster4"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"inbound_password"},
+			},
+		},
+	})
+}
+
+func testAccSanIscsiCredentialsResourceConfig(svmName string, user string, outboundUser string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	outbound := ""
+	if outboundUser != "" {
+		outbound = fmt.Sprintf(`
+  outbound_user = "%s"
+  outbound_password = "chapoutbound123"`, outboundUser)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_san_iscsi_credentials" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "%s"
+  initiator = "iqn.1995-08.com.example:acc-test"
+  inbound_user = "%s"
+  inbound_password = "chappassword123"%s
+}`, host, admin, password, svmName, user, outbound)
+}
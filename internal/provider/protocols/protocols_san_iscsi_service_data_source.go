@@ -0,0 +1,131 @@
+package protocols
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ProtocolsSanIscsiServiceDataSource{}
+
+// NewProtocolsSanIscsiServiceDataSource is a helper function to simplify the provider implementation.
+func NewProtocolsSanIscsiServiceDataSource() datasource.DataSource {
+	return &ProtocolsSanIscsiServiceDataSource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "san_iscsi_service",
+		},
+	}
+}
+
+// ProtocolsSanIscsiServiceDataSource defines the data source implementation.
+type ProtocolsSanIscsiServiceDataSource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// ProtocolsSanIscsiServiceDataSourceModel describes the data source data model.
+type ProtocolsSanIscsiServiceDataSourceModel struct {
+	CxProfileName types.String `tfsdk:"cx_profile_name"`
+	SVMName       types.String `tfsdk:"svm_name"`
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	TargetAlias   types.String `tfsdk:"target_alias"`
+	TargetName    types.String `tfsdk:"target_name"`
+}
+
+// Metadata returns the data source type name.
+func (d *ProtocolsSanIscsiServiceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.Name
+}
+
+// Schema defines the schema for the data source.
+func (d *ProtocolsSanIscsiServiceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsSanIscsiService data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the SVM",
+				Required:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "iSCSI service is enabled",
+				Computed:            true,
+			},
+			"target_alias": schema.StringAttribute{
+				MarkdownDescription: "The iSCSI target alias",
+				Computed:            true,
+			},
+			"target_name": schema.StringAttribute{
+				MarkdownDescription: "The iSCSI target name (IQN)",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ProtocolsSanIscsiServiceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ProtocolsSanIscsiServiceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProtocolsSanIscsiServiceDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetProtocolsSanIscsiService(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetProtocolsSanIscsiService
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No iSCSI service found on svm %s", data.SVMName.ValueString()))
+		return
+	}
+
+	data.Enabled = types.BoolValue(restInfo.Enabled)
+	data.TargetAlias = types.StringValue(restInfo.Target.Alias)
+	data.TargetName = types.StringValue(restInfo.Target.Name)
+
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
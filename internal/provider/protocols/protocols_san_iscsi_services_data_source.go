@@ -0,0 +1,164 @@
+package protocols
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ProtocolsSanIscsiServicesDataSource{}
+
+// NewProtocolsSanIscsiServicesDataSource is a helper function to simplify the provider implementation.
+func NewProtocolsSanIscsiServicesDataSource() datasource.DataSource {
+	return &ProtocolsSanIscsiServicesDataSource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "san_iscsi_services",
+		},
+	}
+}
+
+// ProtocolsSanIscsiServicesDataSource defines the data source implementation.
+type ProtocolsSanIscsiServicesDataSource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// ProtocolsSanIscsiServicesDataSourceModel describes the data source data model.
+type ProtocolsSanIscsiServicesDataSourceModel struct {
+	CxProfileName             types.String                                    `tfsdk:"cx_profile_name"`
+	ProtocolsSanIscsiServices []ProtocolsSanIscsiServiceDataSourceModel       `tfsdk:"protocols_san_iscsi_services"`
+	Filter                    *ProtocolsSanIscsiServicesDataSourceFilterModel `tfsdk:"filter"`
+}
+
+// ProtocolsSanIscsiServicesDataSourceFilterModel describes the data source data model for queries.
+type ProtocolsSanIscsiServicesDataSourceFilterModel struct {
+	SVMName types.String `tfsdk:"svm_name"`
+}
+
+// Metadata returns the data source type name.
+func (d *ProtocolsSanIscsiServicesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.Name
+}
+
+// Schema defines the schema for the data source.
+func (d *ProtocolsSanIscsiServicesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsSanIscsiServices data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"svm_name": schema.StringAttribute{
+						MarkdownDescription: "Name of the SVM",
+						Optional:            true,
+					},
+				},
+				Optional: true,
+			},
+			"protocols_san_iscsi_services": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cx_profile_name": schema.StringAttribute{
+							MarkdownDescription: "Connection profile name",
+							Required:            true,
+						},
+						"svm_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the SVM",
+							Required:            true,
+						},
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: "iSCSI service is enabled",
+							Computed:            true,
+						},
+						"target_alias": schema.StringAttribute{
+							MarkdownDescription: "The iSCSI target alias",
+							Computed:            true,
+						},
+						"target_name": schema.StringAttribute{
+							MarkdownDescription: "The iSCSI target name (IQN)",
+							Computed:            true,
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ProtocolsSanIscsiServicesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ProtocolsSanIscsiServicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProtocolsSanIscsiServicesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var filter *interfaces.ProtocolsSanIscsiServiceDataSourceFilterModel = nil
+	if data.Filter != nil {
+		filter = &interfaces.ProtocolsSanIscsiServiceDataSourceFilterModel{
+			SVMName: data.Filter.SVMName.ValueString(),
+		}
+	}
+	restInfo, err := interfaces.GetProtocolsSanIscsiServices(errorHandler, *client, filter)
+	if err != nil {
+		// error reporting done inside GetProtocolsSanIscsiServices
+		return
+	}
+
+	data.ProtocolsSanIscsiServices = make([]ProtocolsSanIscsiServiceDataSourceModel, len(restInfo))
+	for index, record := range restInfo {
+		data.ProtocolsSanIscsiServices[index] = ProtocolsSanIscsiServiceDataSourceModel{
+			CxProfileName: data.CxProfileName,
+			SVMName:       types.StringValue(record.SVM.Name),
+			Enabled:       types.BoolValue(record.Enabled),
+			TargetAlias:   types.StringValue(record.Target.Alias),
+			TargetName:    types.StringValue(record.Target.Name),
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,151 @@
+package protocols
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ProtocolsSanPortsetDataSource{}
+
+// NewProtocolsSanPortsetDataSource is a helper function to simplify the provider implementation.
+func NewProtocolsSanPortsetDataSource() datasource.DataSource {
+	return &ProtocolsSanPortsetDataSource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "san_portset",
+		},
+	}
+}
+
+// ProtocolsSanPortsetDataSource defines the data source implementation.
+type ProtocolsSanPortsetDataSource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// ProtocolsSanPortsetDataSourceModel describes the data source data model.
+type ProtocolsSanPortsetDataSourceModel struct {
+	CxProfileName types.String   `tfsdk:"cx_profile_name"`
+	SVMName       types.String   `tfsdk:"svm_name"`
+	Name          types.String   `tfsdk:"name"`
+	Protocol      types.String   `tfsdk:"protocol"`
+	Interfaces    []types.String `tfsdk:"interfaces"`
+	ID            types.String   `tfsdk:"id"`
+}
+
+// Metadata returns the data source type name.
+func (d *ProtocolsSanPortsetDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.Name
+}
+
+// Schema defines the schema for the data source.
+func (d *ProtocolsSanPortsetDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsSanPortset data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the SVM",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the portset",
+				Required:            true,
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "Protocols allowed on the portset",
+				Computed:            true,
+			},
+			"interfaces": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Names of the iSCSI and FC network interfaces in the portset",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Portset UUID",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ProtocolsSanPortsetDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ProtocolsSanPortsetDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProtocolsSanPortsetDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetProtocolsSanPortsetByName(errorHandler, *client, data.Name.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetProtocolsSanPortsetByName
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No portset %s found on svm %s", data.Name.ValueString(), data.SVMName.ValueString()))
+		return
+	}
+
+	data = flattenProtocolsSanPortset(data.CxProfileName, *restInfo)
+
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// flattenProtocolsSanPortset converts an ONTAP portset record into the data source model.
+func flattenProtocolsSanPortset(cxProfileName types.String, record interfaces.ProtocolsSanPortsetGetDataModelONTAP) ProtocolsSanPortsetDataSourceModel {
+	members := make([]types.String, len(record.Interfaces))
+	for i, member := range record.Interfaces {
+		members[i] = types.StringValue(member.Name())
+	}
+	return ProtocolsSanPortsetDataSourceModel{
+		CxProfileName: cxProfileName,
+		SVMName:       types.StringValue(record.SVM.Name),
+		Name:          types.StringValue(record.Name),
+		Protocol:      types.StringValue(record.Protocol),
+		Interfaces:    members,
+		ID:            types.StringValue(record.UUID),
+	}
+}
@@ -0,0 +1,351 @@
+package protocols
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ProtocolsSanPortsetResource{}
+var _ resource.ResourceWithImportState = &ProtocolsSanPortsetResource{}
+
+// NewProtocolsSanPortsetResource is a helper function to simplify the provider implementation.
+func NewProtocolsSanPortsetResource() resource.Resource {
+	return &ProtocolsSanPortsetResource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "san_portset",
+		},
+	}
+}
+
+// ProtocolsSanPortsetResource defines the resource implementation.
+type ProtocolsSanPortsetResource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// ProtocolsSanPortsetResourceModel describes the resource data model.
+type ProtocolsSanPortsetResourceModel struct {
+	CxProfileName types.String   `tfsdk:"cx_profile_name"`
+	SVMName       types.String   `tfsdk:"svm_name"`
+	Name          types.String   `tfsdk:"name"`
+	Protocol      types.String   `tfsdk:"protocol"`
+	Interfaces    []types.String `tfsdk:"interfaces"`
+	ID            types.String   `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *ProtocolsSanPortsetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.Name
+}
+
+// Schema defines the schema for the resource.
+func (r *ProtocolsSanPortsetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsSanPortset resource",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the SVM",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the portset",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "Protocols allowed on the portset. One of fcp, iscsi, mixed, defaults to mixed",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("mixed"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"fcp", "iscsi", "mixed"}...),
+				},
+			},
+			"interfaces": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Names of the iSCSI and FC network interfaces in the portset",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Portset UUID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ProtocolsSanPortsetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ProtocolsSanPortsetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProtocolsSanPortsetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetProtocolsSanPortsetByName(errorHandler, *client, data.Name.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetProtocolsSanPortsetByName
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No portset %s found on svm %s", data.Name.ValueString(), data.SVMName.ValueString()))
+		return
+	}
+
+	data.Name = types.StringValue(restInfo.Name)
+	data.SVMName = types.StringValue(restInfo.SVM.Name)
+	data.Protocol = types.StringValue(restInfo.Protocol)
+	// interfaces added out of band show as drift
+	if data.Interfaces != nil || len(restInfo.Interfaces) > 0 {
+		data.Interfaces = make([]types.String, len(restInfo.Interfaces))
+		for i, member := range restInfo.Interfaces {
+			data.Interfaces[i] = types.StringValue(member.Name())
+		}
+	}
+	data.ID = types.StringValue(restInfo.UUID)
+
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a resource and retrieve UUID
+func (r *ProtocolsSanPortsetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ProtocolsSanPortsetResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var body interfaces.ProtocolsSanPortsetResourceBodyDataModelONTAP
+	body.Name = data.Name.ValueString()
+	body.SVM.Name = data.SVMName.ValueString()
+	body.Protocol = data.Protocol.ValueString()
+	for _, name := range data.Interfaces {
+		member, err := portsetInterface(errorHandler, *client, data.Protocol.ValueString(), data.SVMName.ValueString(), name.ValueString())
+		if err != nil {
+			return
+		}
+		body.Interfaces = append(body.Interfaces, member)
+	}
+
+	_, err = interfaces.CreateProtocolsSanPortset(errorHandler, *client, body)
+	if err != nil {
+		return
+	}
+
+	restInfo, err := interfaces.GetProtocolsSanPortsetByName(errorHandler, *client, data.Name.ValueString(), data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No portset %s found on svm %s after create", data.Name.ValueString(), data.SVMName.ValueString()))
+		return
+	}
+
+	data.ID = types.StringValue(restInfo.UUID)
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ProtocolsSanPortsetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *ProtocolsSanPortsetResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, plan.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetProtocolsSanPortsetByName(errorHandler, *client, state.Name.ValueString(), state.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No portset %s found on svm %s", state.Name.ValueString(), state.SVMName.ValueString()))
+		return
+	}
+
+	// add new interfaces first so that hosts never lose every path while an interface is being replaced
+	current := make(map[string]string)
+	for _, member := range restInfo.Interfaces {
+		current[member.Name()] = member.UUID
+	}
+	planInterfaces := make(map[string]bool)
+	for _, name := range plan.Interfaces {
+		planInterfaces[name.ValueString()] = true
+		if _, ok := current[name.ValueString()]; !ok {
+			member, err := portsetInterface(errorHandler, *client, plan.Protocol.ValueString(), plan.SVMName.ValueString(), name.ValueString())
+			if err != nil {
+				return
+			}
+			err = interfaces.AddProtocolsSanPortsetInterface(errorHandler, *client, state.ID.ValueString(), member)
+			if err != nil {
+				return
+			}
+		}
+	}
+	for name, uuid := range current {
+		if !planInterfaces[name] {
+			err = interfaces.RemoveProtocolsSanPortsetInterface(errorHandler, *client, state.ID.ValueString(), uuid)
+			if err != nil {
+				return
+			}
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ProtocolsSanPortsetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ProtocolsSanPortsetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if data.ID.IsNull() {
+		errorHandler.MakeAndReportError("UUID is null", "protocols_san_portset UUID is null")
+		return
+	}
+
+	err = interfaces.DeleteProtocolsSanPortset(errorHandler, *client, data.ID.ValueString())
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *ProtocolsSanPortsetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: name,svm_name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
+}
+
+// portsetInterface builds a portset member, on a mixed portset the interface name is looked up to tell iSCSI and FC interfaces apart.
+func portsetInterface(errorHandler *utils.ErrorHandler, client restclient.RestClient, protocol string, svmName string, name string) (interfaces.PortsetInterface, error) {
+	member := interfaces.PortsetInterface{}
+	switch protocol {
+	case "iscsi":
+		member.IP = &interfaces.PortsetInterfaceName{Name: name}
+	case "fcp":
+		member.FC = &interfaces.PortsetInterfaceName{Name: name}
+	default:
+		ipInterfaces, err := interfaces.GetListIPInterfaces(errorHandler, client, &interfaces.IPInterfaceDataSourceFilterModel{Name: name, SVMName: svmName}, nil)
+		if err != nil {
+			return member, err
+		}
+		if len(ipInterfaces) > 0 {
+			member.IP = &interfaces.PortsetInterfaceName{Name: name}
+		} else {
+			member.FC = &interfaces.PortsetInterfaceName{Name: name}
+		}
+	}
+	return member, nil
+}
@@ -0,0 +1,103 @@
+package protocols_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	ntest "github.com/netapp/terraform-provider-netapp-ontap/internal/provider"
+)
+
+func TestAccSanPortsetResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { ntest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ntest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read
+			{
+				Config: testAccSanPortsetResourceConfig(`netapp-ontap_network_ip_interface.lif1.name`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_san_portset.example", "name", "acc_test_portset"),
+					resource.TestCheckResourceAttr("netapp-ontap_san_portset.example", "protocol", "iscsi"),
+					resource.TestCheckResourceAttr("netapp-ontap_san_portset.example", "interfaces.#", "1"),
+				),
+			},
+			// Swap interfaces in place
+			{
+				Config: testAccSanPortsetResourceConfig(`netapp-ontap_network_ip_interface.lif2.name`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_san_portset.example", "interfaces.#", "1"),
+					resource.TestCheckTypeSetElemAttr("netapp-ontap_san_portset.example", "interfaces.*", "acc_test_portset_lif2"),
+				),
+			},
+			// Import and read
+			{
+				ResourceName:  "netapp-ontap_san_portset.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s,%s", "acc_test_portset", "svm0", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_san_portset.example", "protocol", "iscsi"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSanPortsetResourceConfig(interfaces string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_network_ip_interface" "lif1" {
+  cx_profile_name = "cluster4"
+  name = "acc_test_portset_lif1"
+  svm_name = "svm0"
+  ip = {
+    address = "10.10.10.31"
+    netmask = 18
+  }
+  location = {
+    home_port = "e0d"
+    home_node = "ontap_cluster_1-01"
+  }
+}
+
+resource "netapp-ontap_network_ip_interface" "lif2" {
+  cx_profile_name = "cluster4"
+  name = "acc_test_portset_lif2"
+  svm_name = "svm0"
+  ip = {
+    address = "10.10.10.32"
+    netmask = 18
+  }
+  location = {
+    home_port = "e0d"
+    home_node = "ontap_cluster_1-01"
+  }
+}
+
+resource "netapp-ontap_san_portset" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "svm0"
+  name = "acc_test_portset"
+  protocol = "iscsi"
+  interfaces = [%s]
+}`, host, admin, password, interfaces)
+}
@@ -0,0 +1,175 @@
+package protocols
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ProtocolsSanPortsetsDataSource{}
+
+// NewProtocolsSanPortsetsDataSource is a helper function to simplify the provider implementation.
+func NewProtocolsSanPortsetsDataSource() datasource.DataSource {
+	return &ProtocolsSanPortsetsDataSource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "san_portsets",
+		},
+	}
+}
+
+// ProtocolsSanPortsetsDataSource defines the data source implementation.
+type ProtocolsSanPortsetsDataSource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// ProtocolsSanPortsetsDataSourceModel describes the data source data model.
+type ProtocolsSanPortsetsDataSourceModel struct {
+	CxProfileName        types.String                               `tfsdk:"cx_profile_name"`
+	ProtocolsSanPortsets []ProtocolsSanPortsetDataSourceModel       `tfsdk:"protocols_san_portsets"`
+	Filter               *ProtocolsSanPortsetsDataSourceFilterModel `tfsdk:"filter"`
+}
+
+// ProtocolsSanPortsetsDataSourceFilterModel describes the data source data model for queries.
+type ProtocolsSanPortsetsDataSourceFilterModel struct {
+	Name     types.String `tfsdk:"name"`
+	SVMName  types.String `tfsdk:"svm_name"`
+	Protocol types.String `tfsdk:"protocol"`
+}
+
+// Metadata returns the data source type name.
+func (d *ProtocolsSanPortsetsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.Name
+}
+
+// Schema defines the schema for the data source.
+func (d *ProtocolsSanPortsetsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsSanPortsets data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Name of the portset",
+						Optional:            true,
+					},
+					"svm_name": schema.StringAttribute{
+						MarkdownDescription: "Name of the SVM",
+						Optional:            true,
+					},
+					"protocol": schema.StringAttribute{
+						MarkdownDescription: "Protocols allowed on the portset",
+						Optional:            true,
+					},
+				},
+				Optional: true,
+			},
+			"protocols_san_portsets": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cx_profile_name": schema.StringAttribute{
+							MarkdownDescription: "Connection profile name",
+							Required:            true,
+						},
+						"svm_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the SVM",
+							Required:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the portset",
+							Required:            true,
+						},
+						"protocol": schema.StringAttribute{
+							MarkdownDescription: "Protocols allowed on the portset",
+							Computed:            true,
+						},
+						"interfaces": schema.SetAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "Names of the iSCSI and FC network interfaces in the portset",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Portset UUID",
+							Computed:            true,
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ProtocolsSanPortsetsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ProtocolsSanPortsetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProtocolsSanPortsetsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var filter *interfaces.ProtocolsSanPortsetDataSourceFilterModel = nil
+	if data.Filter != nil {
+		filter = &interfaces.ProtocolsSanPortsetDataSourceFilterModel{
+			Name:     data.Filter.Name.ValueString(),
+			SVMName:  data.Filter.SVMName.ValueString(),
+			Protocol: data.Filter.Protocol.ValueString(),
+		}
+	}
+	restInfo, err := interfaces.GetProtocolsSanPortsets(errorHandler, *client, filter)
+	if err != nil {
+		// error reporting done inside GetProtocolsSanPortsets
+		return
+	}
+
+	data.ProtocolsSanPortsets = make([]ProtocolsSanPortsetDataSourceModel, len(restInfo))
+	for index, record := range restInfo {
+		data.ProtocolsSanPortsets[index] = flattenProtocolsSanPortset(data.CxProfileName, record)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,447 @@
+package protocols
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ProtocolsVscanOnAccessPolicyResource{}
+var _ resource.ResourceWithImportState = &ProtocolsVscanOnAccessPolicyResource{}
+
+// NewProtocolsVscanOnAccessPolicyResource is a helper function to simplify the provider implementation.
+func NewProtocolsVscanOnAccessPolicyResource() resource.Resource {
+	return &ProtocolsVscanOnAccessPolicyResource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "vscan_on_access_policy",
+		},
+	}
+}
+
+// ProtocolsVscanOnAccessPolicyResource defines the resource implementation.
+type ProtocolsVscanOnAccessPolicyResource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// ProtocolsVscanOnAccessPolicyResourceModel describes the resource data model.
+type ProtocolsVscanOnAccessPolicyResourceModel struct {
+	CxProfileName types.String                     `tfsdk:"cx_profile_name"`
+	SVMName       types.String                     `tfsdk:"svm_name"`
+	Name          types.String                     `tfsdk:"name"`
+	Enabled       types.Bool                       `tfsdk:"enabled"`
+	Mandatory     types.Bool                       `tfsdk:"mandatory"`
+	Scope         *VscanOnAccessScopeResourceModel `tfsdk:"scope"`
+	ID            types.String                     `tfsdk:"id"`
+}
+
+// VscanOnAccessScopeResourceModel describes the scope of an on-access policy.
+type VscanOnAccessScopeResourceModel struct {
+	MaxFileSize          types.Int64    `tfsdk:"max_file_size"`
+	IncludeExtensions    []types.String `tfsdk:"include_extensions"`
+	ExcludeExtensions    []types.String `tfsdk:"exclude_extensions"`
+	ExcludePaths         []types.String `tfsdk:"exclude_paths"`
+	ScanWithoutExtension types.Bool     `tfsdk:"scan_without_extension"`
+	OnlyExecuteAccess    types.Bool     `tfsdk:"only_execute_access"`
+	ScanReadonlyVolumes  types.Bool     `tfsdk:"scan_readonly_volumes"`
+}
+
+// Metadata returns the resource type name.
+func (r *ProtocolsVscanOnAccessPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.Name
+}
+
+// Schema defines the schema for the resource.
+func (r *ProtocolsVscanOnAccessPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsVscanOnAccessPolicy resource",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the SVM",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "On-access policy name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the on-access policy is enabled",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"mandatory": schema.BoolAttribute{
+				MarkdownDescription: "Whether file access is denied when no Vscan server is available to scan the file",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"scope": schema.SingleNestedAttribute{
+				MarkdownDescription: "Files scanned by the policy. The scope is not managed when omitted",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"max_file_size": schema.Int64Attribute{
+						MarkdownDescription: "Maximum size of the files to scan, in bytes",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+					"include_extensions": schema.SetAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "File extensions to scan. Not managed when omitted",
+						Optional:            true,
+					},
+					"exclude_extensions": schema.SetAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "File extensions to exclude from scanning. Not managed when omitted",
+						Optional:            true,
+					},
+					"exclude_paths": schema.SetAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "File paths to exclude from scanning. Not managed when omitted",
+						Optional:            true,
+					},
+					"scan_without_extension": schema.BoolAttribute{
+						MarkdownDescription: "Whether files without an extension are scanned",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"only_execute_access": schema.BoolAttribute{
+						MarkdownDescription: "Whether only files opened with execute access are scanned",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"scan_readonly_volumes": schema.BoolAttribute{
+						MarkdownDescription: "Whether files on read-only volumes are scanned",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "On-access policy identifier, svm_name/name",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ProtocolsVscanOnAccessPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ProtocolsVscanOnAccessPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProtocolsVscanOnAccessPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	restInfo, err := interfaces.GetProtocolsVscanOnAccessPolicyByName(errorHandler, *client, svm.UUID, data.Name.ValueString())
+	if err != nil {
+		// error reporting done inside GetProtocolsVscanOnAccessPolicyByName
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No Vscan on-access policy %s found on svm %s", data.Name.ValueString(), data.SVMName.ValueString()))
+		return
+	}
+
+	data.Name = types.StringValue(restInfo.Name)
+	data.Enabled = types.BoolValue(restInfo.Enabled)
+	data.Mandatory = types.BoolValue(restInfo.Mandatory)
+	// the scope is only refreshed when it is managed
+	if data.Scope != nil {
+		data.Scope = flattenVscanOnAccessScope(data.Scope, restInfo.Scope)
+	}
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.SVMName.ValueString(), restInfo.Name))
+
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a resource and retrieve UUID
+func (r *ProtocolsVscanOnAccessPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ProtocolsVscanOnAccessPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+
+	var body interfaces.ProtocolsVscanOnAccessPolicyResourceBodyDataModelONTAP
+	body.Name = data.Name.ValueString()
+	body.Enabled = data.Enabled.ValueBool()
+	body.Mandatory = data.Mandatory.ValueBool()
+	body.Scope = expandVscanOnAccessScope(data.Scope)
+
+	err = interfaces.CreateProtocolsVscanOnAccessPolicy(errorHandler, *client, svm.UUID, body)
+	if err != nil {
+		return
+	}
+
+	if data.Scope != nil {
+		// pick up the ONTAP defaults for the scope options that are not set
+		restInfo, err := interfaces.GetProtocolsVscanOnAccessPolicyByName(errorHandler, *client, svm.UUID, data.Name.ValueString())
+		if err != nil {
+			return
+		}
+		if restInfo == nil {
+			errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No Vscan on-access policy %s found on svm %s after create", data.Name.ValueString(), data.SVMName.ValueString()))
+			return
+		}
+		data.Scope = flattenVscanOnAccessScope(data.Scope, restInfo.Scope)
+	}
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.SVMName.ValueString(), data.Name.ValueString()))
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ProtocolsVscanOnAccessPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *ProtocolsVscanOnAccessPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, plan.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, state.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+
+	var body interfaces.ProtocolsVscanOnAccessPolicyUpdateBodyDataModelONTAP
+	if !plan.Enabled.Equal(state.Enabled) {
+		enabled := plan.Enabled.ValueBool()
+		body.Enabled = &enabled
+	}
+	if !plan.Mandatory.Equal(state.Mandatory) {
+		mandatory := plan.Mandatory.ValueBool()
+		body.Mandatory = &mandatory
+	}
+	if plan.Scope != nil && !reflect.DeepEqual(plan.Scope, state.Scope) {
+		body.Scope = expandVscanOnAccessScope(plan.Scope)
+	}
+	if body != (interfaces.ProtocolsVscanOnAccessPolicyUpdateBodyDataModelONTAP{}) {
+		err = interfaces.UpdateProtocolsVscanOnAccessPolicy(errorHandler, *client, svm.UUID, state.Name.ValueString(), body)
+		if err != nil {
+			return
+		}
+	}
+
+	if plan.Scope != nil {
+		restInfo, err := interfaces.GetProtocolsVscanOnAccessPolicyByName(errorHandler, *client, svm.UUID, state.Name.ValueString())
+		if err != nil {
+			return
+		}
+		if restInfo == nil {
+			errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No Vscan on-access policy %s found on svm %s", state.Name.ValueString(), state.SVMName.ValueString()))
+			return
+		}
+		plan.Scope = flattenVscanOnAccessScope(plan.Scope, restInfo.Scope)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ProtocolsVscanOnAccessPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ProtocolsVscanOnAccessPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+
+	// an enabled policy cannot be deleted
+	if data.Enabled.ValueBool() {
+		enabled := false
+		err = interfaces.UpdateProtocolsVscanOnAccessPolicy(errorHandler, *client, svm.UUID, data.Name.ValueString(), interfaces.ProtocolsVscanOnAccessPolicyUpdateBodyDataModelONTAP{Enabled: &enabled})
+		if err != nil {
+			return
+		}
+	}
+
+	err = interfaces.DeleteProtocolsVscanOnAccessPolicy(errorHandler, *client, svm.UUID, data.Name.ValueString())
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *ProtocolsVscanOnAccessPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: name,svm_name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
+}
+
+// expandVscanOnAccessScope only sends the scope options that are set, ONTAP keeps its defaults for the others
+func expandVscanOnAccessScope(scope *VscanOnAccessScopeResourceModel) *interfaces.VscanOnAccessScopeDataModel {
+	if scope == nil {
+		return nil
+	}
+	return &interfaces.VscanOnAccessScopeDataModel{
+		MaxFileSize:          scope.MaxFileSize.ValueInt64(),
+		IncludeExtensions:    typesToStrings(scope.IncludeExtensions),
+		ExcludeExtensions:    typesToStrings(scope.ExcludeExtensions),
+		ExcludePaths:         typesToStrings(scope.ExcludePaths),
+		ScanWithoutExtension: knownBoolPointer(scope.ScanWithoutExtension),
+		OnlyExecuteAccess:    knownBoolPointer(scope.OnlyExecuteAccess),
+		ScanReadonlyVolumes:  knownBoolPointer(scope.ScanReadonlyVolumes),
+	}
+}
+
+// flattenVscanOnAccessScope only refreshes the extension and path lists that are managed
+func flattenVscanOnAccessScope(prior *VscanOnAccessScopeResourceModel, scope interfaces.VscanOnAccessScopeDataModel) *VscanOnAccessScopeResourceModel {
+	return &VscanOnAccessScopeResourceModel{
+		MaxFileSize:          types.Int64Value(scope.MaxFileSize),
+		IncludeExtensions:    refreshManagedStrings(prior.IncludeExtensions, scope.IncludeExtensions),
+		ExcludeExtensions:    refreshManagedStrings(prior.ExcludeExtensions, scope.ExcludeExtensions),
+		ExcludePaths:         refreshManagedStrings(prior.ExcludePaths, scope.ExcludePaths),
+		ScanWithoutExtension: types.BoolPointerValue(scope.ScanWithoutExtension),
+		OnlyExecuteAccess:    types.BoolPointerValue(scope.OnlyExecuteAccess),
+		ScanReadonlyVolumes:  types.BoolPointerValue(scope.ScanReadonlyVolumes),
+	}
+}
+
+func knownBoolPointer(value types.Bool) *bool {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	return value.ValueBoolPointer()
+}
+
+func refreshManagedStrings(prior []types.String, values []string) []types.String {
+	if prior == nil {
+		return nil
+	}
+	result := make([]types.String, 0, len(values))
+	for _, v := range values {
+		result = append(result, types.StringValue(v))
+	}
+	return result
+}
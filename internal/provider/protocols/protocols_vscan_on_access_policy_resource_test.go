@@ -0,0 +1,87 @@
+package protocols_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	ntest "github.com/netapp/terraform-provider-netapp-ontap/internal/provider"
+)
+
+func TestAccVscanOnAccessPolicyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { ntest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ntest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test error
+			{
+				Config:      testAccVscanOnAccessPolicyResourceConfig(-1, true),
+				ExpectError: regexp.MustCompile("error creating protocols_vscan_on_access_policy"),
+			},
+			// Create and read
+			{
+				Config: testAccVscanOnAccessPolicyResourceConfig(2147483648, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_vscan_on_access_policy.example", "name", "acc_test_policy"),
+					resource.TestCheckResourceAttr("netapp-ontap_vscan_on_access_policy.example", "mandatory", "true"),
+					resource.TestCheckResourceAttr("netapp-ontap_vscan_on_access_policy.example", "scope.max_file_size", "2147483648"),
+					resource.TestCheckResourceAttr("netapp-ontap_vscan_on_access_policy.example", "scope.include_extensions.#", "2"),
+				),
+			},
+			// Update and read
+			{
+				Config: testAccVscanOnAccessPolicyResourceConfig(1073741824, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_vscan_on_access_policy.example", "mandatory", "false"),
+					resource.TestCheckResourceAttr("netapp-ontap_vscan_on_access_policy.example", "scope.max_file_size", "1073741824"),
+				),
+			},
+			// Import and read
+			{
+				ResourceName:  "netapp-ontap_vscan_on_access_policy.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s,%s", "acc_test_policy", "svm0", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_vscan_on_access_policy.example", "mandatory", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVscanOnAccessPolicyResourceConfig(maxFileSize int64, mandatory bool) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_vscan_on_access_policy" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "svm0"
+  name = "acc_test_policy"
+  enabled = false
+  mandatory = %t
+  scope = {
+    max_file_size = %d
+    include_extensions = ["exe", "dll"]
+    scan_readonly_volumes = true
+  }
+}`, host, admin, password, mandatory, maxFileSize)
+}
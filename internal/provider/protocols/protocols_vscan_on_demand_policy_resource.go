@@ -0,0 +1,413 @@
+package protocols
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ProtocolsVscanOnDemandPolicyResource{}
+var _ resource.ResourceWithImportState = &ProtocolsVscanOnDemandPolicyResource{}
+
+// NewProtocolsVscanOnDemandPolicyResource is a helper function to simplify the provider implementation.
+func NewProtocolsVscanOnDemandPolicyResource() resource.Resource {
+	return &ProtocolsVscanOnDemandPolicyResource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "vscan_on_demand_policy",
+		},
+	}
+}
+
+// ProtocolsVscanOnDemandPolicyResource defines the resource implementation.
+type ProtocolsVscanOnDemandPolicyResource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// ProtocolsVscanOnDemandPolicyResourceModel describes the resource data model.
+type ProtocolsVscanOnDemandPolicyResourceModel struct {
+	CxProfileName types.String                     `tfsdk:"cx_profile_name"`
+	SVMName       types.String                     `tfsdk:"svm_name"`
+	Name          types.String                     `tfsdk:"name"`
+	LogPath       types.String                     `tfsdk:"log_path"`
+	ScanPaths     []types.String                   `tfsdk:"scan_paths"`
+	ScheduleName  types.String                     `tfsdk:"schedule_name"`
+	Scope         *VscanOnDemandScopeResourceModel `tfsdk:"scope"`
+	ID            types.String                     `tfsdk:"id"`
+}
+
+// VscanOnDemandScopeResourceModel describes the scope of an on-demand task.
+type VscanOnDemandScopeResourceModel struct {
+	MaxFileSize          types.Int64    `tfsdk:"max_file_size"`
+	IncludeExtensions    []types.String `tfsdk:"include_extensions"`
+	ExcludeExtensions    []types.String `tfsdk:"exclude_extensions"`
+	ExcludePaths         []types.String `tfsdk:"exclude_paths"`
+	ScanWithoutExtension types.Bool     `tfsdk:"scan_without_extension"`
+}
+
+// Metadata returns the resource type name.
+func (r *ProtocolsVscanOnDemandPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.Name
+}
+
+// Schema defines the schema for the resource.
+func (r *ProtocolsVscanOnDemandPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsVscanOnDemandPolicy resource",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the SVM",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "On-demand task name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"log_path": schema.StringAttribute{
+				MarkdownDescription: "Path of the directory the task report is written to",
+				Required:            true,
+			},
+			"scan_paths": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Paths to scan",
+				Required:            true,
+			},
+			"schedule_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the cron schedule the task runs on. The task is recreated when the schedule is removed",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+						resp.RequiresReplace = req.PlanValue.IsNull() && !req.StateValue.IsNull()
+					}, "Removing the schedule requires replacing the task", "Removing the schedule requires replacing the task"),
+				},
+			},
+			"scope": schema.SingleNestedAttribute{
+				MarkdownDescription: "Files scanned by the task. The scope is not managed when omitted",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"max_file_size": schema.Int64Attribute{
+						MarkdownDescription: "Maximum size of the files to scan, in bytes",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+					"include_extensions": schema.SetAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "File extensions to scan. Not managed when omitted",
+						Optional:            true,
+					},
+					"exclude_extensions": schema.SetAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "File extensions to exclude from scanning. Not managed when omitted",
+						Optional:            true,
+					},
+					"exclude_paths": schema.SetAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "File paths to exclude from scanning. Not managed when omitted",
+						Optional:            true,
+					},
+					"scan_without_extension": schema.BoolAttribute{
+						MarkdownDescription: "Whether files without an extension are scanned",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "On-demand task identifier, svm_name/name",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ProtocolsVscanOnDemandPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ProtocolsVscanOnDemandPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProtocolsVscanOnDemandPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	restInfo, err := interfaces.GetProtocolsVscanOnDemandPolicyByName(errorHandler, *client, svm.UUID, data.Name.ValueString())
+	if err != nil {
+		// error reporting done inside GetProtocolsVscanOnDemandPolicyByName
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No Vscan on-demand task %s found on svm %s", data.Name.ValueString(), data.SVMName.ValueString()))
+		return
+	}
+
+	data.Name = types.StringValue(restInfo.Name)
+	data.LogPath = types.StringValue(restInfo.LogPath)
+	data.ScanPaths = stringsToTypes(restInfo.ScanPaths)
+	if restInfo.Schedule != nil && restInfo.Schedule.Name != "" {
+		data.ScheduleName = types.StringValue(restInfo.Schedule.Name)
+	} else {
+		data.ScheduleName = types.StringNull()
+	}
+	// the scope is only refreshed when it is managed
+	if data.Scope != nil {
+		data.Scope = flattenVscanOnDemandScope(data.Scope, restInfo.Scope)
+	}
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.SVMName.ValueString(), restInfo.Name))
+
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a resource and retrieve UUID
+func (r *ProtocolsVscanOnDemandPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ProtocolsVscanOnDemandPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+
+	var body interfaces.ProtocolsVscanOnDemandPolicyResourceBodyDataModelONTAP
+	body.Name = data.Name.ValueString()
+	body.LogPath = data.LogPath.ValueString()
+	body.ScanPaths = typesToStrings(data.ScanPaths)
+	if !data.ScheduleName.IsNull() {
+		body.Schedule = &interfaces.NameDataModel{Name: data.ScheduleName.ValueString()}
+	}
+	body.Scope = expandVscanOnDemandScope(data.Scope)
+
+	err = interfaces.CreateProtocolsVscanOnDemandPolicy(errorHandler, *client, svm.UUID, body)
+	if err != nil {
+		return
+	}
+
+	if data.Scope != nil {
+		// pick up the ONTAP defaults for the scope options that are not set
+		restInfo, err := interfaces.GetProtocolsVscanOnDemandPolicyByName(errorHandler, *client, svm.UUID, data.Name.ValueString())
+		if err != nil {
+			return
+		}
+		if restInfo == nil {
+			errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No Vscan on-demand task %s found on svm %s after create", data.Name.ValueString(), data.SVMName.ValueString()))
+			return
+		}
+		data.Scope = flattenVscanOnDemandScope(data.Scope, restInfo.Scope)
+	}
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.SVMName.ValueString(), data.Name.ValueString()))
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ProtocolsVscanOnDemandPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *ProtocolsVscanOnDemandPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, plan.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, state.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+
+	var body interfaces.ProtocolsVscanOnDemandPolicyUpdateBodyDataModelONTAP
+	if !plan.LogPath.Equal(state.LogPath) {
+		body.LogPath = plan.LogPath.ValueString()
+	}
+	if !reflect.DeepEqual(plan.ScanPaths, state.ScanPaths) {
+		body.ScanPaths = typesToStrings(plan.ScanPaths)
+	}
+	if !plan.ScheduleName.IsNull() && !plan.ScheduleName.Equal(state.ScheduleName) {
+		body.Schedule = &interfaces.NameDataModel{Name: plan.ScheduleName.ValueString()}
+	}
+	if plan.Scope != nil && !reflect.DeepEqual(plan.Scope, state.Scope) {
+		body.Scope = expandVscanOnDemandScope(plan.Scope)
+	}
+	if !reflect.DeepEqual(body, interfaces.ProtocolsVscanOnDemandPolicyUpdateBodyDataModelONTAP{}) {
+		err = interfaces.UpdateProtocolsVscanOnDemandPolicy(errorHandler, *client, svm.UUID, state.Name.ValueString(), body)
+		if err != nil {
+			return
+		}
+	}
+
+	if plan.Scope != nil {
+		restInfo, err := interfaces.GetProtocolsVscanOnDemandPolicyByName(errorHandler, *client, svm.UUID, state.Name.ValueString())
+		if err != nil {
+			return
+		}
+		if restInfo == nil {
+			errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No Vscan on-demand task %s found on svm %s", state.Name.ValueString(), state.SVMName.ValueString()))
+			return
+		}
+		plan.Scope = flattenVscanOnDemandScope(plan.Scope, restInfo.Scope)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ProtocolsVscanOnDemandPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ProtocolsVscanOnDemandPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+
+	err = interfaces.DeleteProtocolsVscanOnDemandPolicy(errorHandler, *client, svm.UUID, data.Name.ValueString())
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *ProtocolsVscanOnDemandPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: name,svm_name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
+}
+
+// expandVscanOnDemandScope only sends the scope options that are set, ONTAP keeps its defaults for the others
+func expandVscanOnDemandScope(scope *VscanOnDemandScopeResourceModel) *interfaces.VscanOnDemandScopeDataModel {
+	if scope == nil {
+		return nil
+	}
+	return &interfaces.VscanOnDemandScopeDataModel{
+		MaxFileSize:          scope.MaxFileSize.ValueInt64(),
+		IncludeExtensions:    typesToStrings(scope.IncludeExtensions),
+		ExcludeExtensions:    typesToStrings(scope.ExcludeExtensions),
+		ExcludePaths:         typesToStrings(scope.ExcludePaths),
+		ScanWithoutExtension: knownBoolPointer(scope.ScanWithoutExtension),
+	}
+}
+
+// flattenVscanOnDemandScope only refreshes the extension and path lists that are managed
+func flattenVscanOnDemandScope(prior *VscanOnDemandScopeResourceModel, scope interfaces.VscanOnDemandScopeDataModel) *VscanOnDemandScopeResourceModel {
+	return &VscanOnDemandScopeResourceModel{
+		MaxFileSize:          types.Int64Value(scope.MaxFileSize),
+		IncludeExtensions:    refreshManagedStrings(prior.IncludeExtensions, scope.IncludeExtensions),
+		ExcludeExtensions:    refreshManagedStrings(prior.ExcludeExtensions, scope.ExcludeExtensions),
+		ExcludePaths:         refreshManagedStrings(prior.ExcludePaths, scope.ExcludePaths),
+		ScanWithoutExtension: types.BoolPointerValue(scope.ScanWithoutExtension),
+	}
+}
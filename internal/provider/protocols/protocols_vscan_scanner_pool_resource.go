@@ -0,0 +1,339 @@
+package protocols
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ProtocolsVscanScannerPoolResource{}
+var _ resource.ResourceWithImportState = &ProtocolsVscanScannerPoolResource{}
+
+// NewProtocolsVscanScannerPoolResource is a helper function to simplify the provider implementation.
+func NewProtocolsVscanScannerPoolResource() resource.Resource {
+	return &ProtocolsVscanScannerPoolResource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "vscan_scanner_pool",
+		},
+	}
+}
+
+// ProtocolsVscanScannerPoolResource defines the resource implementation.
+type ProtocolsVscanScannerPoolResource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// ProtocolsVscanScannerPoolResourceModel describes the resource data model.
+type ProtocolsVscanScannerPoolResourceModel struct {
+	CxProfileName   types.String   `tfsdk:"cx_profile_name"`
+	SVMName         types.String   `tfsdk:"svm_name"`
+	Name            types.String   `tfsdk:"name"`
+	Servers         []types.String `tfsdk:"servers"`
+	PrivilegedUsers []types.String `tfsdk:"privileged_users"`
+	Role            types.String   `tfsdk:"role"`
+	ID              types.String   `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *ProtocolsVscanScannerPoolResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.Name
+}
+
+// Schema defines the schema for the resource.
+func (r *ProtocolsVscanScannerPoolResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "ProtocolsVscanScannerPool resource",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the SVM",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Scanner pool name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"servers": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Host names or IP addresses of the Vscan servers allowed to connect to the SVM",
+				Required:            true,
+			},
+			"privileged_users": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Privileged users, in domain\\user format, the Vscan servers connect with",
+				Required:            true,
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Role of the scanner pool on the cluster. One of primary, secondary, idle",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("primary", "secondary", "idle"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Scanner pool identifier, svm_name/name",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ProtocolsVscanScannerPoolResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ProtocolsVscanScannerPoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProtocolsVscanScannerPoolResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	restInfo, err := interfaces.GetProtocolsVscanScannerPoolByName(errorHandler, *client, svm.UUID, data.Name.ValueString())
+	if err != nil {
+		// error reporting done inside GetProtocolsVscanScannerPoolByName
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No Vscan scanner pool %s found on svm %s", data.Name.ValueString(), data.SVMName.ValueString()))
+		return
+	}
+
+	data.Name = types.StringValue(restInfo.Name)
+	data.Servers = make([]types.String, len(restInfo.Servers))
+	for i, server := range restInfo.Servers {
+		data.Servers[i] = types.StringValue(server)
+	}
+	data.PrivilegedUsers = make([]types.String, len(restInfo.PrivilegedUsers))
+	for i, user := range restInfo.PrivilegedUsers {
+		data.PrivilegedUsers[i] = types.StringValue(user)
+	}
+	data.Role = types.StringValue(restInfo.Role)
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.SVMName.ValueString(), restInfo.Name))
+
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a resource and retrieve UUID
+func (r *ProtocolsVscanScannerPoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ProtocolsVscanScannerPoolResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+
+	var body interfaces.ProtocolsVscanScannerPoolResourceBodyDataModelONTAP
+	body.Name = data.Name.ValueString()
+	body.Servers = typesToStrings(data.Servers)
+	body.PrivilegedUsers = typesToStrings(data.PrivilegedUsers)
+	if !data.Role.IsUnknown() && !data.Role.IsNull() {
+		cluster, err := vscanScannerPoolCluster(errorHandler, *client)
+		if err != nil {
+			return
+		}
+		body.Role = data.Role.ValueString()
+		body.Cluster = cluster
+	}
+
+	err = interfaces.CreateProtocolsVscanScannerPool(errorHandler, *client, svm.UUID, body)
+	if err != nil {
+		return
+	}
+
+	if data.Role.IsUnknown() {
+		restInfo, err := interfaces.GetProtocolsVscanScannerPoolByName(errorHandler, *client, svm.UUID, data.Name.ValueString())
+		if err != nil {
+			return
+		}
+		if restInfo == nil {
+			errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No Vscan scanner pool %s found on svm %s after create", data.Name.ValueString(), data.SVMName.ValueString()))
+			return
+		}
+		data.Role = types.StringValue(restInfo.Role)
+	}
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.SVMName.ValueString(), data.Name.ValueString()))
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ProtocolsVscanScannerPoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *ProtocolsVscanScannerPoolResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, plan.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, state.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+
+	var body interfaces.ProtocolsVscanScannerPoolUpdateBodyDataModelONTAP
+	body.Servers = typesToStrings(plan.Servers)
+	body.PrivilegedUsers = typesToStrings(plan.PrivilegedUsers)
+	if !plan.Role.Equal(state.Role) {
+		cluster, err := vscanScannerPoolCluster(errorHandler, *client)
+		if err != nil {
+			return
+		}
+		body.Role = plan.Role.ValueString()
+		body.Cluster = cluster
+	}
+
+	err = interfaces.UpdateProtocolsVscanScannerPool(errorHandler, *client, svm.UUID, state.Name.ValueString(), body)
+	if err != nil {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ProtocolsVscanScannerPoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ProtocolsVscanScannerPoolResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+
+	err = interfaces.DeleteProtocolsVscanScannerPool(errorHandler, *client, svm.UUID, data.Name.ValueString())
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *ProtocolsVscanScannerPoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: name,svm_name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
+}
+
+// vscanScannerPoolCluster returns the cluster the scanner pool role is applied on
+func vscanScannerPoolCluster(errorHandler *utils.ErrorHandler, r restclient.RestClient) (*interfaces.VscanScannerPoolCluster, error) {
+	cluster, err := interfaces.GetCluster(errorHandler, r)
+	if err != nil {
+		return nil, err
+	}
+	return &interfaces.VscanScannerPoolCluster{Name: cluster.Name}, nil
+}
@@ -0,0 +1,82 @@
+package protocols_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	ntest "github.com/netapp/terraform-provider-netapp-ontap/internal/provider"
+)
+
+func TestAccVscanScannerPoolResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { ntest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ntest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test error
+			{
+				Config:      testAccVscanScannerPoolResourceConfig("non-existant", "10.10.10.10", "idle"),
+				ExpectError: regexp.MustCompile("error reading svm info"),
+			},
+			// Create and read
+			{
+				Config: testAccVscanScannerPoolResourceConfig("svm0", "10.10.10.10", "idle"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_vscan_scanner_pool.example", "name", "acc_test_pool"),
+					resource.TestCheckResourceAttr("netapp-ontap_vscan_scanner_pool.example", "servers.#", "1"),
+					resource.TestCheckResourceAttr("netapp-ontap_vscan_scanner_pool.example", "role", "idle"),
+				),
+			},
+			// Update servers and role
+			{
+				Config: testAccVscanScannerPoolResourceConfig("svm0", "10.10.10.11", "primary"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("netapp-ontap_vscan_scanner_pool.example", "servers.*", "10.10.10.11"),
+					resource.TestCheckResourceAttr("netapp-ontap_vscan_scanner_pool.example", "role", "primary"),
+				),
+			},
+			// Import and read
+			{
+				ResourceName:  "netapp-ontap_vscan_scanner_pool.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s,%s", "acc_test_pool", "svm0", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_vscan_scanner_pool.example", "role", "primary"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVscanScannerPoolResourceConfig(svmName string, server string, role string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_vscan_scanner_pool" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "%s"
+  name = "acc_test_pool"
+  servers = ["%s"]
+  privileged_users = ["cifs\\u1"]
+  role = "%s"
+}`, host, admin, password, svmName, server, role)
+}
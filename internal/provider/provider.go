@@ -223,18 +223,50 @@ func (p *ONTAPProvider) Resources(ctx context.Context) []func() resource.Resourc
 		cluster.NewClusterResource,
 		cluster.NewClusterLicensingLicenseResource,
 		cluster.NewClusterPeerResource,
+		cluster.NewClusterPeeringResource,
 		cluster.NewClusterScheduleResource,
 		NewExampleResource,
 		protocols.NewExportPolicyResource,
 		protocols.NewExportPolicyRuleResource,
+		networking.NewBroadcastDomainResource,
+		networking.NewEthernetPortResource,
+		networking.NewIPBgpPeerGroupResource,
 		networking.NewIPInterfaceResource,
 		networking.NewIPRouteResource,
+		networking.NewIPServicePolicyResource,
+		networking.NewIPSubnetResource,
+		networking.NewIPspaceResource,
 		name_services.NewNameServicesDNSResource,
 		name_services.NewNameServicesLDAPResource,
+		name_services.NewNameServicesLocalHostResource,
+		name_services.NewNameServicesNameMappingResource,
+		name_services.NewNameServicesUnixGroupResource,
+		name_services.NewNameServicesUnixUserResource,
 		protocols.NewProtocolsCIFSShareResource,
+		protocols.NewProtocolsCIFSShareACLResource,
 		protocols.NewProtocolsNfsServiceResource,
+		protocols.NewProtocolsNvmeNamespaceResource,
+		protocols.NewProtocolsNvmeServiceResource,
+		protocols.NewProtocolsSanFcpServiceResource,
+		protocols.NewProtocolsSanIscsiCredentialsResource,
+		protocols.NewProtocolsSanIscsiServiceResource,
+		protocols.NewProtocolsSanPortsetResource,
+		protocols.NewProtocolsNvmeSubsystemResource,
+		protocols.NewProtocolsNvmeSubsystemMapResource,
+		protocols.NewProtocolsFpolicyEngineResource,
+		protocols.NewProtocolsFpolicyEventResource,
+		protocols.NewProtocolsFpolicyPolicyResource,
+		protocols.NewProtocolsFpolicyPolicyStatusResource,
+		protocols.NewProtocolsS3BucketResource,
+		protocols.NewProtocolsS3GroupResource,
+		protocols.NewProtocolsS3ServiceResource,
+		protocols.NewProtocolsS3UserResource,
 		protocols.NewProtocolsSanIgroupResource,
 		protocols.NewProtocolsSanLunMapResource,
+		protocols.NewProtocolsVscanResource,
+		protocols.NewProtocolsVscanOnAccessPolicyResource,
+		protocols.NewProtocolsVscanOnDemandPolicyResource,
+		protocols.NewProtocolsVscanScannerPoolResource,
 		security.NewSecurityAccountResource,
 		security.NewSecurityRoleResource,
 		security.NewSecurityLoginMessageResource,
@@ -250,6 +282,8 @@ func (p *ONTAPProvider) Resources(ctx context.Context) []func() resource.Resourc
 		storage.NewStorageQtreeResource,
 		storage.NewStorageVolumeEfficiencyPolicyResource,
 		storage.NewStorageVolumeSnapshotResource,
+		storage.NewStorageVolumeSnapshotRestoreResource,
+		svm.NewSvmAuditResource,
 		svm.NewSVMPeerResource,
 		svm.NewSvmResource,
 	}
@@ -280,18 +314,48 @@ func (p *ONTAPProvider) DataSources(ctx context.Context) []func() datasource.Dat
 		protocols.NewExportPoliciesDataSource,
 		protocols.NewExportPolicyRuleDataSource,
 		protocols.NewExportPolicyRulesDataSource,
+		networking.NewBroadcastDomainDataSource,
+		networking.NewBroadcastDomainsDataSource,
+		networking.NewEthernetPortDataSource,
+		networking.NewEthernetPortsDataSource,
 		networking.NewIPInterfaceDataSource,
 		networking.NewIPInterfacesDataSource,
 		networking.NewIPRouteDataSource,
 		networking.NewIPRoutesDataSource,
+		networking.NewIPspaceDataSource,
+		networking.NewIPspacesDataSource,
 		name_services.NewNameServicesDNSDataSource,
 		name_services.NewNameServicesDNSsDataSource,
 		name_services.NewNameServicesLDAPDataSource,
 		name_services.NewNameServicesLDAPsDataSource,
+		name_services.NewNameServicesNameMappingDataSource,
+		name_services.NewNameServicesNameMappingsDataSource,
+		name_services.NewNameServicesUnixGroupDataSource,
+		name_services.NewNameServicesUnixGroupsDataSource,
+		name_services.NewNameServicesUnixUserDataSource,
+		name_services.NewNameServicesUnixUsersDataSource,
 		protocols.NewProtocolsCIFSShareDataSource,
 		protocols.NewProtocolsCIFSSharesDataSource,
+		protocols.NewProtocolsCIFSShareACLDataSource,
+		protocols.NewProtocolsCIFSShareAclsDataSource,
 		protocols.NewProtocolsNfsServiceDataSource,
 		protocols.NewProtocolsNfsServicesDataSource,
+		protocols.NewProtocolsNvmeNamespaceDataSource,
+		protocols.NewProtocolsNvmeNamespacesDataSource,
+		protocols.NewProtocolsNvmeServiceDataSource,
+		protocols.NewProtocolsNvmeServicesDataSource,
+		protocols.NewProtocolsSanFcpServiceDataSource,
+		protocols.NewProtocolsSanFcpServicesDataSource,
+		protocols.NewProtocolsSanIscsiServiceDataSource,
+		protocols.NewProtocolsSanIscsiServicesDataSource,
+		protocols.NewProtocolsSanPortsetDataSource,
+		protocols.NewProtocolsSanPortsetsDataSource,
+		protocols.NewProtocolsS3BucketDataSource,
+		protocols.NewProtocolsS3BucketsDataSource,
+		protocols.NewProtocolsNvmeSubsystemDataSource,
+		protocols.NewProtocolsNvmeSubsystemsDataSource,
+		protocols.NewProtocolsNvmeSubsystemMapDataSource,
+		protocols.NewProtocolsNvmeSubsystemMapsDataSource,
 		protocols.NewProtocolsSanIgroupDataSource,
 		protocols.NewProtocolsSanIgroupsDataSource,
 		protocols.NewProtocolsSanLunMapDataSource,
@@ -320,6 +384,7 @@ func (p *ONTAPProvider) DataSources(ctx context.Context) []func() datasource.Dat
 		storage.NewStorageQOSPoliciesDataSource,
 		storage.NewStorageQuotaRuleDataSource,
 		storage.NewStorageQuotaRulesDataSource,
+		storage.NewStorageQuotaReportsDataSource,
 		storage.NewStorageQtreeDataSource,
 		storage.NewStorageQtreesDataSource,
 		storage.NewStorageVolumeSnapshotDataSource,
@@ -4,23 +4,34 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &StorageLunResource{}
 var _ resource.ResourceWithImportState = &StorageLunResource{}
+var _ resource.ResourceWithModifyPlan = &StorageLunResource{}
+
+// lunMoveTimeout is how long to wait for a LUN move between volumes to complete.
+const lunMoveTimeout = 3600 * time.Second
+
+// lunMovePollInterval is how often the LUN move progress is checked.
+const lunMovePollInterval = 10 * time.Second
 
 // NewStorageLunResource is a helper function to simplify the provider implementation.
 func NewStorageLunResource() resource.Resource {
@@ -48,9 +59,17 @@ type StorageLunResourceModel struct {
 	QoSPolicyName types.String `tfsdk:"qos_policy_name"`
 	SerialNumber  types.String `tfsdk:"serial_number"`
 	LogicalUnit   types.String `tfsdk:"logical_unit"`
+	Clone         *LunClone    `tfsdk:"clone"`
+	AllowShrink   types.Bool   `tfsdk:"allow_shrink_mapped"`
 	ID            types.String `tfsdk:"id"`
 }
 
+// LunClone describes the source of a LUN clone.
+type LunClone struct {
+	SourceName   types.String `tfsdk:"source_name"`
+	SnapshotName types.String `tfsdk:"snapshot_name"`
+}
+
 // Metadata returns the resource type name.
 func (r *StorageLunResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + r.config.Name
@@ -86,18 +105,29 @@ func (r *StorageLunResource) Schema(ctx context.Context, req resource.SchemaRequ
 			"svm_name": schema.StringAttribute{
 				MarkdownDescription: "SVM name",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"volume_name": schema.StringAttribute{
-				MarkdownDescription: "Volume name",
+				MarkdownDescription: "Volume name. Changing the volume moves the lun to the new volume in the same SVM",
 				Required:            true,
 			},
 			"os_type": schema.StringAttribute{
-				MarkdownDescription: "OS type",
-				Required:            true,
+				MarkdownDescription: "OS type. Required unless clone is set",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"size": schema.Int64Attribute{
-				MarkdownDescription: "Size of the lun in byte if size_unit is not provided, otherwise size in the specified unit",
-				Required:            true,
+				MarkdownDescription: "Size of the lun in byte if size_unit is not provided, otherwise size in the specified unit. Required unless clone is set",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"size_unit": schema.StringAttribute{
 				MarkdownDescription: "The unit used to interpret the size parameter",
@@ -107,6 +137,27 @@ func (r *StorageLunResource) Schema(ctx context.Context, req resource.SchemaRequ
 				MarkdownDescription: "QoS policy name",
 				Optional:            true,
 			},
+			"clone": schema.SingleNestedAttribute{
+				MarkdownDescription: "Create the lun as a clone of another lun, or of a lun in a snapshot",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"source_name": schema.StringAttribute{
+						MarkdownDescription: "Path of the source lun, for instance /vol/vol1/lun1. A name without path refers to a lun in volume_name",
+						Required:            true,
+					},
+					"snapshot_name": schema.StringAttribute{
+						MarkdownDescription: "Snapshot of the source lun volume to clone from",
+						Optional:            true,
+					},
+				},
+			},
+			"allow_shrink_mapped": schema.BoolAttribute{
+				MarkdownDescription: "Allow reducing the size of a lun that is mapped to an igroup. Shrinking a lun in use by a host can cause data loss",
+				Optional:            true,
+			},
 			"serial_number": schema.StringAttribute{
 				MarkdownDescription: "Serial number for lun",
 				Computed:            true,
@@ -125,6 +176,22 @@ func (r *StorageLunResource) Schema(ctx context.Context, req resource.SchemaRequ
 	}
 }
 
+// ModifyPlan marks name as unknown when the lun is moved or renamed, as ONTAP computes the new lun path.
+func (r *StorageLunResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan, state, config *StorageLunResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || state == nil || plan == nil {
+		return
+	}
+
+	if config.Name.IsNull() && (!plan.VolumeName.Equal(state.VolumeName) || !plan.LogicalUnit.Equal(state.LogicalUnit)) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("name"), types.StringUnknown())...)
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *StorageLunResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
@@ -187,15 +254,7 @@ func (r *StorageLunResource) Read(ctx context.Context, req resource.ReadRequest,
 	data.VolumeName = types.StringValue(restInfo.Location.Volume.Name)
 	data.OSType = types.StringValue(restInfo.OSType)
 	data.SerialNumber = types.StringValue(restInfo.SerialNumber)
-	if !data.SizeUnit.IsNull() {
-		var sizeUnit string
-		var size int64
-		size, sizeUnit = interfaces.ByteFormat(int64(restInfo.Space.Size))
-		data.Size = types.Int64Value(size)
-		data.SizeUnit = types.StringValue(sizeUnit)
-	} else {
-		data.Size = types.Int64Value(restInfo.Space.Size)
-	}
+	setLunSize(&data, restInfo.Space.Size)
 	if restInfo.QoSPolicy.Name != "" {
 		data.QoSPolicyName = types.StringValue(restInfo.QoSPolicy.Name)
 	}
@@ -230,15 +289,30 @@ func (r *StorageLunResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 	body.Locations.Volume.Name = data.VolumeName.ValueString()
 	body.SVM.Name = data.SVMName.ValueString()
-	body.OsType = data.OSType.ValueString()
-	if !data.SizeUnit.IsNull() {
-		if _, ok := interfaces.POW2BYTEMAP[data.SizeUnit.ValueString()]; !ok {
-			errorHandler.MakeAndReportError("error creating flexcache", fmt.Sprintf("invalid input for size_unit: %s, required one of: bytes, b, kb, mb, gb, tb, pb, eb, zb, yb", data.SizeUnit.ValueString()))
-			return
+	if data.Clone == nil && (data.OSType.IsUnknown() || data.Size.IsUnknown()) {
+		errorHandler.MakeAndReportError("error creating lun", "os_type and size are required unless clone is set")
+		return
+	}
+	if data.Clone != nil {
+		body.Clone = &interfaces.LunClone{
+			Source: interfaces.LunCloneSource{
+				Name: lunCloneSourcePath(data.VolumeName.ValueString(), data.Clone.SourceName.ValueString(), data.Clone.SnapshotName.ValueString()),
+			},
+		}
+	}
+	if !data.OSType.IsUnknown() {
+		body.OsType = data.OSType.ValueString()
+	}
+	if !data.Size.IsUnknown() {
+		if !data.SizeUnit.IsNull() {
+			if _, ok := interfaces.POW2BYTEMAP[data.SizeUnit.ValueString()]; !ok {
+				errorHandler.MakeAndReportError("error creating flexcache", fmt.Sprintf("invalid input for size_unit: %s, required one of: bytes, b, kb, mb, gb, tb, pb, eb, zb, yb", data.SizeUnit.ValueString()))
+				return
+			}
+			body.Space.Size = data.Size.ValueInt64() * int64(interfaces.POW2BYTEMAP[data.SizeUnit.ValueString()])
+		} else {
+			body.Space.Size = data.Size.ValueInt64()
 		}
-		body.Space.Size = data.Size.ValueInt64() * int64(interfaces.POW2BYTEMAP[data.SizeUnit.ValueString()])
-	} else {
-		body.Space.Size = data.Size.ValueInt64()
 	}
 
 	if !data.QoSPolicyName.IsNull() {
@@ -261,6 +335,18 @@ func (r *StorageLunResource) Create(ctx context.Context, req resource.CreateRequ
 	data.LogicalUnit = types.StringValue(resource.Location.LogicalUnit)
 	data.Name = types.StringValue(resource.Name)
 
+	// os_type and size are inherited from the source when cloning
+	if data.OSType.IsUnknown() || data.Size.IsUnknown() {
+		restInfo, err := interfaces.GetStorageLunByUUID(errorHandler, *client, resource.UUID)
+		if err != nil {
+			return
+		}
+		data.OSType = types.StringValue(restInfo.OSType)
+		if data.Size.IsUnknown() {
+			setLunSize(data, restInfo.Space.Size)
+		}
+	}
+
 	tflog.Trace(ctx, "created a resource")
 
 	// Save data into Terraform state
@@ -292,12 +378,10 @@ func (r *StorageLunResource) Update(ctx context.Context, req resource.UpdateRequ
 	if !plan.LogicalUnit.Equal(state.LogicalUnit) {
 		request.Locations.LogicalUnit = plan.LogicalUnit.ValueString()
 	}
-	if !plan.VolumeName.Equal(state.VolumeName) {
+	moved := !plan.VolumeName.Equal(state.VolumeName)
+	if moved {
 		request.Locations.Volume.Name = plan.VolumeName.ValueString()
 	}
-	if !plan.SVMName.Equal(state.SVMName) {
-		request.SVM.Name = plan.SVMName.ValueString()
-	}
 	if !plan.OSType.Equal(state.OSType) {
 		request.OsType = plan.OSType.ValueString()
 	}
@@ -309,7 +393,21 @@ func (r *StorageLunResource) Update(ctx context.Context, req resource.UpdateRequ
 		}
 		baseUnit = int64(interfaces.POW2BYTEMAP[plan.SizeUnit.ValueString()])
 	}
+	stateBaseUnit := int64(1)
+	if !state.SizeUnit.IsNull() {
+		stateBaseUnit = int64(interfaces.POW2BYTEMAP[state.SizeUnit.ValueString()])
+	}
 	request.Space.Size = plan.Size.ValueInt64() * baseUnit
+	if request.Space.Size < state.Size.ValueInt64()*stateBaseUnit && !plan.AllowShrink.ValueBool() {
+		restInfo, err := interfaces.GetStorageLunByUUID(errorHandler, *client, state.ID.ValueString())
+		if err != nil {
+			return
+		}
+		if restInfo.Status.Mapped {
+			errorHandler.MakeAndReportError("error updating lun", fmt.Sprintf("lun %s is mapped, reducing its size can cause data loss on the host. Set allow_shrink_mapped to true to resize it", state.Name.ValueString()))
+			return
+		}
+	}
 
 	if !plan.QoSPolicyName.Equal(state.QoSPolicyName) {
 		request.QosPolicy = plan.QoSPolicyName.ValueString()
@@ -319,10 +417,72 @@ func (r *StorageLunResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	if moved {
+		err = waitForStorageLunMove(errorHandler, *client, state.ID.ValueString())
+		if err != nil {
+			return
+		}
+	}
+	if plan.Name.IsUnknown() {
+		restInfo, err := interfaces.GetStorageLunByUUID(errorHandler, *client, state.ID.ValueString())
+		if err != nil {
+			return
+		}
+		plan.Name = types.StringValue(restInfo.Name)
+		plan.LogicalUnit = types.StringValue(restInfo.Location.LogicalUnit)
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
+// waitForStorageLunMove waits for a lun move between volumes to complete.
+func waitForStorageLunMove(errorHandler *utils.ErrorHandler, client restclient.RestClient, uuid string) error {
+	for timeRemaining := lunMoveTimeout; timeRemaining > 0; timeRemaining -= lunMovePollInterval {
+		restInfo, err := interfaces.GetStorageLunByUUID(errorHandler, client, uuid)
+		if err != nil {
+			return err
+		}
+		switch restInfo.Movement.Progress.State {
+		case "", "complete":
+			return nil
+		case "failed", "paused_error":
+			return errorHandler.MakeAndReportError("error moving lun", fmt.Sprintf("lun %s move %s: %s", restInfo.Name, restInfo.Movement.Progress.State, restInfo.Movement.Progress.Failure.Message))
+		}
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("waiting for lun %s move, state: %s", restInfo.Name, restInfo.Movement.Progress.State))
+		time.Sleep(lunMovePollInterval)
+	}
+	return errorHandler.MakeAndReportError("error moving lun", fmt.Sprintf("lun %s move did not complete within %s", uuid, lunMoveTimeout))
+}
+
+// lunCloneSourcePath returns the path of the lun to clone from. A source name without path refers to a lun in volumeName.
+func lunCloneSourcePath(volumeName string, sourceName string, snapshotName string) string {
+	if !strings.HasPrefix(sourceName, "/vol/") {
+		sourceName = "/vol/" + volumeName + "/" + sourceName
+	}
+	if snapshotName == "" {
+		return sourceName
+	}
+	// /vol/<volume>/<lun> in snapshot <snapshot> is /vol/<volume>/.snapshot/<snapshot>/<lun>
+	parts := strings.SplitN(strings.TrimPrefix(sourceName, "/vol/"), "/", 2)
+	if len(parts) != 2 {
+		return sourceName
+	}
+	return "/vol/" + parts[0] + "/.snapshot/" + snapshotName + "/" + parts[1]
+}
+
+// setLunSize sets size in the size_unit when provided, in bytes otherwise.
+func setLunSize(data *StorageLunResourceModel, size int64) {
+	if !data.SizeUnit.IsNull() {
+		var sizeUnit string
+		size, sizeUnit = interfaces.ByteFormat(size)
+		data.Size = types.Int64Value(size)
+		data.SizeUnit = types.StringValue(sizeUnit)
+	} else {
+		data.Size = types.Int64Value(size)
+	}
+}
+
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *StorageLunResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data *StorageLunResourceModel
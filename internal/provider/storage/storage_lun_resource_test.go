@@ -162,3 +162,70 @@ resource "netapp-ontap_lun" "example_size" {
   size_unit = "%s"
 }`, host, admin, password, logicalUnit, svmname, volumeName, osType, size, size_unit)
 }
+
+func TestAccStorageLunResouceCloneAndMove(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { ntest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ntest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create a lun as a clone of an existing lun
+			{
+				Config: testAccStorageLunResourceCloneConfig("ACC-lun-clone", "lunTest", "/vol/lunTest/ACC-import-lun", 1048576),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_lun.example_clone", "name", "/vol/lunTest/ACC-lun-clone"),
+					resource.TestCheckResourceAttr("netapp-ontap_lun.example_clone", "os_type", "linux"),
+					resource.TestCheckResourceAttr("netapp-ontap_lun.example_clone", "size", "1048576"),
+				),
+			},
+			// Move the lun to another volume in the same SVM
+			{
+				Config: testAccStorageLunResourceCloneConfig("ACC-lun-clone", "carchi_test_root", "/vol/lunTest/ACC-import-lun", 1048576),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_lun.example_clone", "name", "/vol/carchi_test_root/ACC-lun-clone"),
+					resource.TestCheckResourceAttr("netapp-ontap_lun.example_clone", "volume_name", "carchi_test_root"),
+				),
+			},
+			// Shrink the lun, it is not mapped
+			{
+				Config: testAccStorageLunResourceCloneConfig("ACC-lun-clone", "carchi_test_root", "/vol/lunTest/ACC-import-lun", 524288),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_lun.example_clone", "size", "524288"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStorageLunResourceCloneConfig(logicalUnit string, volumeName string, sourceName string, size int64) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_lun" "example_clone" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  logical_unit = "%s"
+  svm_name = "carchi-test"
+  volume_name = "%s"
+  size = "%d"
+  clone = {
+    source_name = "%s"
+  }
+}`, host, admin, password, logicalUnit, volumeName, size, sourceName)
+}
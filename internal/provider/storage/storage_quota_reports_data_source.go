@@ -0,0 +1,272 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &StorageQuotaReportsDataSource{}
+
+// NewStorageQuotaReportsDataSource is a helper function to simplify the provider implementation.
+func NewStorageQuotaReportsDataSource() datasource.DataSource {
+	return &StorageQuotaReportsDataSource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "quota_reports",
+		},
+	}
+}
+
+// StorageQuotaReportsDataSource defines the data source implementation.
+type StorageQuotaReportsDataSource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// StorageQuotaReportsDataSourceModel describes the data source data model.
+type StorageQuotaReportsDataSourceModel struct {
+	CxProfileName types.String                              `tfsdk:"cx_profile_name"`
+	QuotaReports  []StorageQuotaReportDataSourceModel       `tfsdk:"quota_reports"`
+	Filter        *StorageQuotaReportsDataSourceFilterModel `tfsdk:"filter"`
+}
+
+// StorageQuotaReportDataSourceModel describes a quota report record.
+type StorageQuotaReportDataSourceModel struct {
+	Index      types.Int64            `tfsdk:"index"`
+	SVMName    types.String           `tfsdk:"svm_name"`
+	VolumeName types.String           `tfsdk:"volume_name"`
+	Type       types.String           `tfsdk:"type"`
+	QtreeName  types.String           `tfsdk:"qtree_name"`
+	Users      []types.String         `tfsdk:"users"`
+	GroupName  types.String           `tfsdk:"group_name"`
+	Specifier  types.String           `tfsdk:"specifier"`
+	Space      *QuotaReportUsageModel `tfsdk:"space"`
+	Files      *QuotaReportUsageModel `tfsdk:"files"`
+}
+
+// QuotaReportUsageModel describes the limits and usage reported for space or files.
+type QuotaReportUsageModel struct {
+	HardLimit            types.Int64 `tfsdk:"hard_limit"`
+	SoftLimit            types.Int64 `tfsdk:"soft_limit"`
+	Used                 types.Int64 `tfsdk:"used"`
+	UsedHardLimitPercent types.Int64 `tfsdk:"used_hard_limit_percent"`
+	UsedSoftLimitPercent types.Int64 `tfsdk:"used_soft_limit_percent"`
+}
+
+// StorageQuotaReportsDataSourceFilterModel describes the data source data model for queries.
+type StorageQuotaReportsDataSourceFilterModel struct {
+	Type   types.String `tfsdk:"type"`
+	SVM    types.String `tfsdk:"svm_name"`
+	Volume types.String `tfsdk:"volume_name"`
+	Qtree  types.String `tfsdk:"qtree_name"`
+}
+
+// Metadata returns the data source type name.
+func (d *StorageQuotaReportsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.Name
+}
+
+// quotaReportUsageSchema returns the schema for the space and files usage attributes.
+func quotaReportUsageSchema(description string, unit string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description,
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"hard_limit": schema.Int64Attribute{
+				MarkdownDescription: "Hard limit, in " + unit,
+				Computed:            true,
+			},
+			"soft_limit": schema.Int64Attribute{
+				MarkdownDescription: "Soft limit, in " + unit,
+				Computed:            true,
+			},
+			"used": schema.Int64Attribute{
+				MarkdownDescription: "Total used, in " + unit,
+				Computed:            true,
+			},
+			"used_hard_limit_percent": schema.Int64Attribute{
+				MarkdownDescription: "Total used as a percentage of the hard limit",
+				Computed:            true,
+			},
+			"used_soft_limit_percent": schema.Int64Attribute{
+				MarkdownDescription: "Total used as a percentage of the soft limit",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Schema defines the schema for the data source.
+func (d *StorageQuotaReportsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "StorageQuotaReports data source",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Quota report type",
+						Optional:            true,
+					},
+					"svm_name": schema.StringAttribute{
+						MarkdownDescription: "Quota report svm name",
+						Optional:            true,
+					},
+					"volume_name": schema.StringAttribute{
+						MarkdownDescription: "Quota report volume name",
+						Optional:            true,
+					},
+					"qtree_name": schema.StringAttribute{
+						MarkdownDescription: "Quota report qtree name",
+						Optional:            true,
+					},
+				},
+				Optional: true,
+			},
+			"quota_reports": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"index": schema.Int64Attribute{
+							MarkdownDescription: "Index that identifies the report record within the volume",
+							Computed:            true,
+						},
+						"svm_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the SVM",
+							Computed:            true,
+						},
+						"volume_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the volume",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Quota type of the report record. This type can be user, group, or tree",
+							Computed:            true,
+						},
+						"qtree_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the qtree",
+							Computed:            true,
+						},
+						"users": schema.ListAttribute{
+							MarkdownDescription: "Names of the users the user quota applies to",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"group_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the group the group quota applies to",
+							Computed:            true,
+						},
+						"specifier": schema.StringAttribute{
+							MarkdownDescription: "Quota specifier",
+							Computed:            true,
+						},
+						"space": quotaReportUsageSchema("Space limits and usage", "bytes"),
+						"files": quotaReportUsageSchema("Files limits and usage", "number of files"),
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *StorageQuotaReportsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *StorageQuotaReportsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data StorageQuotaReportsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var filter *interfaces.StorageQuotaReportsDataSourceFilterModel = nil
+	if data.Filter != nil {
+		filter = &interfaces.StorageQuotaReportsDataSourceFilterModel{
+			Type:       data.Filter.Type.ValueString(),
+			SVMName:    data.Filter.SVM.ValueString(),
+			VolumeName: data.Filter.Volume.ValueString(),
+			QtreeName:  data.Filter.Qtree.ValueString(),
+		}
+	}
+	restInfo, err := interfaces.GetStorageQuotaReports(errorHandler, *client, filter)
+	if err != nil {
+		// error reporting done inside GetStorageQuotaReports
+		return
+	}
+
+	data.QuotaReports = make([]StorageQuotaReportDataSourceModel, len(restInfo))
+	for index, record := range restInfo {
+		users := make([]types.String, len(record.Users))
+		for i, user := range record.Users {
+			users[i] = types.StringValue(user.Name)
+		}
+		data.QuotaReports[index] = StorageQuotaReportDataSourceModel{
+			Index:      types.Int64Value(record.Index),
+			SVMName:    types.StringValue(record.SVM.Name),
+			VolumeName: types.StringValue(record.Volume.Name),
+			Type:       types.StringValue(record.Type),
+			QtreeName:  types.StringValue(record.Qtree.Name),
+			Users:      users,
+			GroupName:  types.StringValue(record.Group.Name),
+			Specifier:  types.StringValue(record.Specifier),
+			Space:      flattenQuotaReportUsage(record.Space),
+			Files:      flattenQuotaReportUsage(record.Files),
+		}
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func flattenQuotaReportUsage(usage interfaces.QuotaReportUsageONTAP) *QuotaReportUsageModel {
+	return &QuotaReportUsageModel{
+		HardLimit:            types.Int64Value(usage.HardLimit),
+		SoftLimit:            types.Int64Value(usage.SoftLimit),
+		Used:                 types.Int64Value(usage.Used.Total),
+		UsedHardLimitPercent: types.Int64Value(usage.Used.HardLimitPercent),
+		UsedSoftLimitPercent: types.Int64Value(usage.Used.SoftLimitPercent),
+	}
+}
@@ -8,17 +8,21 @@ import (
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/svm"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
 )
 
@@ -50,6 +54,8 @@ type StorageQuotaRulesResourceModel struct {
 	Qtree         *Qtree       `tfsdk:"qtree"`
 	Type          types.String `tfsdk:"type"`
 	Files         types.Object `tfsdk:"files"`
+	Space         types.Object `tfsdk:"space"`
+	QuotaState    types.String `tfsdk:"quota_state"`
 	ID            types.String `tfsdk:"id"`
 }
 
@@ -79,6 +85,12 @@ type Files struct {
 	SoftLimit types.Int64 `tfsdk:"soft_limit"`
 }
 
+// Space describes Space data model.
+type Space struct {
+	HardLimit types.Int64 `tfsdk:"hard_limit"`
+	SoftLimit types.Int64 `tfsdk:"soft_limit"`
+}
+
 // Metadata returns the resource type name.
 func (r *StorageQuotaRulesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + r.config.Name
@@ -172,6 +184,35 @@ func (r *StorageQuotaRulesResource) Schema(ctx context.Context, req resource.Sch
 					},
 				},
 			},
+			"space": schema.SingleNestedAttribute{
+				MarkdownDescription: "Space limits of the rule. Removing space clears the space limits",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"hard_limit": schema.Int64Attribute{
+						MarkdownDescription: "Specifies the hard limit for space, in bytes",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+					"soft_limit": schema.Int64Attribute{
+						MarkdownDescription: "Specifies the soft limit for space, in bytes",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"quota_state": schema.StringAttribute{
+				MarkdownDescription: "Quota state to enforce on the volume: on, off or resize. With on, quotas are turned off and on again when the rule changes so the new limits are applied. With resize, quotas are enabled and ONTAP resizes them when the rule changes. Quotas on the volume are not managed when not set",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"on", "off", "resize"}...),
+				},
+			},
 			"id": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -250,6 +291,32 @@ func (r *StorageQuotaRulesResource) Read(ctx context.Context, req resource.ReadR
 		resp.Diagnostics.Append(diags...)
 	}
 	data.Files = objectValue
+
+	// Space is only read when it is managed, or on import when ONTAP reports a limit
+	importing := data.ID.ValueString() == ""
+	if !data.Space.IsNull() || (importing && (restInfo.Space.HardLimit > 0 || restInfo.Space.SoftLimit > 0)) {
+		elements = map[string]attr.Value{
+			"hard_limit": types.Int64Value(restInfo.Space.HardLimit),
+			"soft_limit": types.Int64Value(restInfo.Space.SoftLimit),
+		}
+		objectValue, diags = types.ObjectValue(elementTypes, elements)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+		}
+		data.Space = objectValue
+	}
+
+	if !data.QuotaState.IsNull() {
+		volumeQuota, err := interfaces.GetStorageVolumeQuota(errorHandler, *client, data.Volume.Name.ValueString(), data.SVM.Name.ValueString())
+		if err != nil {
+			return
+		}
+		if !volumeQuota.Quota.Enabled {
+			data.QuotaState = types.StringValue("off")
+		} else if data.QuotaState.ValueString() == "off" {
+			data.QuotaState = types.StringValue("on")
+		}
+	}
 	// user and group are not modified and hence not added.
 	data.ID = types.StringValue(restInfo.UUID)
 
@@ -310,6 +377,22 @@ func (r *StorageQuotaRulesResource) Create(ctx context.Context, req resource.Cre
 		}
 	}
 
+	if !data.Space.IsNull() {
+		var space Space
+		diags := data.Space.As(ctx, &space, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		body.Space = &interfaces.QuotaSpace{}
+		if !space.HardLimit.IsUnknown() {
+			body.Space.HardLimit = space.HardLimit.ValueInt64()
+		}
+		if !space.SoftLimit.IsUnknown() {
+			body.Space.SoftLimit = space.SoftLimit.ValueInt64()
+		}
+	}
+
 	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
 	if err != nil {
 		// error reporting done inside NewClient
@@ -322,6 +405,32 @@ func (r *StorageQuotaRulesResource) Create(ctx context.Context, req resource.Cre
 	}
 
 	data.ID = types.StringValue(resource.UUID)
+	// limits left unset in the plan are unknown, record what was sent to ONTAP
+	limitTypes := map[string]attr.Type{
+		"hard_limit": types.Int64Type,
+		"soft_limit": types.Int64Type,
+	}
+	if !data.Files.IsNull() {
+		var diags diag.Diagnostics
+		data.Files, diags = types.ObjectValue(limitTypes, map[string]attr.Value{
+			"hard_limit": types.Int64Value(body.Files.HardLimit),
+			"soft_limit": types.Int64Value(body.Files.SoftLimit),
+		})
+		resp.Diagnostics.Append(diags...)
+	}
+	if body.Space != nil {
+		var diags diag.Diagnostics
+		data.Space, diags = types.ObjectValue(limitTypes, map[string]attr.Value{
+			"hard_limit": types.Int64Value(body.Space.HardLimit),
+			"soft_limit": types.Int64Value(body.Space.SoftLimit),
+		})
+		resp.Diagnostics.Append(diags...)
+	}
+
+	err = r.applyQuotaState(errorHandler, *client, data, true)
+	if err != nil {
+		return
+	}
 
 	tflog.Trace(ctx, "created a resource")
 
@@ -356,6 +465,7 @@ func (r *StorageQuotaRulesResource) Update(ctx context.Context, req resource.Upd
 				resp.Diagnostics.Append(diags...)
 				return
 			}
+			request.Files = &interfaces.Files{}
 			if !files.HardLimit.IsUnknown() {
 				request.Files.HardLimit = files.HardLimit.ValueInt64()
 			}
@@ -364,16 +474,104 @@ func (r *StorageQuotaRulesResource) Update(ctx context.Context, req resource.Upd
 			}
 		}
 	}
+	if !plan.Space.IsUnknown() && !plan.Space.Equal(state.Space) {
+		if plan.Space.IsNull() {
+			// -1 clears the limits on ONTAP
+			request.Space = &interfaces.QuotaSpace{HardLimit: -1, SoftLimit: -1}
+		} else {
+			var space Space
+			diags := plan.Space.As(ctx, &space, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				resp.Diagnostics.Append(diags...)
+				return
+			}
+			request.Space = &interfaces.QuotaSpace{}
+			if !space.HardLimit.IsUnknown() {
+				request.Space.HardLimit = space.HardLimit.ValueInt64()
+			}
+			if !space.SoftLimit.IsUnknown() {
+				request.Space.SoftLimit = space.SoftLimit.ValueInt64()
+			}
+		}
+	}
 
-	err = interfaces.UpdateQuotaRules(errorHandler, *client, state.ID.ValueString(), request)
-	if err != nil {
-		return
+	ruleChanged := request.Files != nil || request.Space != nil
+	if ruleChanged {
+		err = interfaces.UpdateQuotaRules(errorHandler, *client, state.ID.ValueString(), request)
+		if err != nil {
+			return
+		}
+	}
+
+	// limits left unset in the plan are unknown, record what was sent to ONTAP
+	limitTypes := map[string]attr.Type{
+		"hard_limit": types.Int64Type,
+		"soft_limit": types.Int64Type,
+	}
+	if request.Files != nil {
+		var diags diag.Diagnostics
+		plan.Files, diags = types.ObjectValue(limitTypes, map[string]attr.Value{
+			"hard_limit": types.Int64Value(request.Files.HardLimit),
+			"soft_limit": types.Int64Value(request.Files.SoftLimit),
+		})
+		resp.Diagnostics.Append(diags...)
+	}
+	if request.Space != nil && !plan.Space.IsNull() {
+		var diags diag.Diagnostics
+		plan.Space, diags = types.ObjectValue(limitTypes, map[string]attr.Value{
+			"hard_limit": types.Int64Value(request.Space.HardLimit),
+			"soft_limit": types.Int64Value(request.Space.SoftLimit),
+		})
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if ruleChanged || !plan.QuotaState.Equal(state.QuotaState) {
+		err = r.applyQuotaState(errorHandler, *client, plan, ruleChanged)
+		if err != nil {
+			return
+		}
 	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
+// applyQuotaState turns quotas on or off on the volume to match quota_state.
+// When quota_state is on and the rule changed, quotas are turned off and on again so ONTAP reinitializes them with the new limits.
+// When quota_state is resize, ONTAP resizes the quotas as part of the rule change.
+func (r *StorageQuotaRulesResource) applyQuotaState(errorHandler *utils.ErrorHandler, client restclient.RestClient, data *StorageQuotaRulesResourceModel, ruleChanged bool) error {
+	if data.QuotaState.IsNull() || data.QuotaState.IsUnknown() {
+		return nil
+	}
+	volumeQuota, err := interfaces.GetStorageVolumeQuota(errorHandler, client, data.Volume.Name.ValueString(), data.SVM.Name.ValueString())
+	if err != nil {
+		return err
+	}
+	switch data.QuotaState.ValueString() {
+	case "off":
+		if volumeQuota.Quota.Enabled {
+			return interfaces.UpdateStorageVolumeQuota(errorHandler, client, volumeQuota.UUID, false)
+		}
+	case "on":
+		if volumeQuota.Quota.Enabled && ruleChanged {
+			tflog.Debug(errorHandler.Ctx, fmt.Sprintf("reinitializing quotas on volume %s", data.Volume.Name.ValueString()))
+			err = interfaces.UpdateStorageVolumeQuota(errorHandler, client, volumeQuota.UUID, false)
+			if err != nil {
+				return err
+			}
+			return interfaces.UpdateStorageVolumeQuota(errorHandler, client, volumeQuota.UUID, true)
+		}
+		if !volumeQuota.Quota.Enabled {
+			return interfaces.UpdateStorageVolumeQuota(errorHandler, client, volumeQuota.UUID, true)
+		}
+	case "resize":
+		if !volumeQuota.Quota.Enabled {
+			return interfaces.UpdateStorageVolumeQuota(errorHandler, client, volumeQuota.UUID, true)
+		}
+	}
+	return nil
+}
+
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *StorageQuotaRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data *StorageQuotaRulesResourceModel
@@ -22,7 +22,7 @@ func TestAccStorageQuotaRuleResource(t *testing.T) {
 					resource.TestCheckResourceAttr("netapp-ontap_quota_rule.example", "qtree.name", ""),
 				),
 			},
-			// Update a option
+			// Update a option, space is not set and shows no change
 			{
 				Config: testAccStorageQuotaRuleResourceBasicConfig("lunTest", "carchi-test", 100, 70),
 				Check: resource.ComposeTestCheckFunc(
@@ -30,6 +30,31 @@ func TestAccStorageQuotaRuleResource(t *testing.T) {
 					resource.TestCheckResourceAttr("netapp-ontap_quota_rule.example", "files.soft_limit", "70"),
 				),
 			},
+			// Update space limits and turn quotas on
+			{
+				Config: testAccStorageQuotaRuleResourceSpaceConfig("lunTest", "carchi-test", 1073741824, 858993459, "on"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_quota_rule.example", "space.hard_limit", "1073741824"),
+					resource.TestCheckResourceAttr("netapp-ontap_quota_rule.example", "space.soft_limit", "858993459"),
+					resource.TestCheckResourceAttr("netapp-ontap_quota_rule.example", "quota_state", "on"),
+				),
+			},
+			// Update space limits, quotas are resized
+			{
+				Config: testAccStorageQuotaRuleResourceSpaceConfig("lunTest", "carchi-test", 2147483648, 1073741824, "resize"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_quota_rule.example", "space.hard_limit", "2147483648"),
+					resource.TestCheckResourceAttr("netapp-ontap_quota_rule.example", "quota_state", "resize"),
+				),
+			},
+			// Remove space, the space limits are cleared
+			{
+				Config: testAccStorageQuotaRuleResourceBasicConfig("lunTest", "carchi-test", 100, 70),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("netapp-ontap_quota_rule.example", "space"),
+					resource.TestCheckResourceAttr("netapp-ontap_quota_rule.example", "files.soft_limit", "70"),
+				),
+			},
 			// Import and read
 			{
 				ResourceName:  "netapp-ontap_quota_rule.example",
@@ -83,3 +108,48 @@ resource "netapp-ontap_quota_rule" "example" {
 	  }
   }`, host, admin, password, volumeName, svmName, hardLimit, softLimit)
 }
+
+func testAccStorageQuotaRuleResourceSpaceConfig(volumeName string, svmName string, hardLimit int64, softLimit int64, quotaState string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_quota_rule" "example" {
+	cx_profile_name = "cluster4"
+	volume = {
+	  name = "%s"
+	  }
+	svm = {
+	  name = "%s"
+	  }
+	type = "tree"
+	qtree = {
+	  name = ""
+	  }
+	files = {
+	  hard_limit = 100
+	  soft_limit = 70
+	  }
+	space = {
+	  hard_limit = %v
+	  soft_limit = %v
+	  }
+	quota_state = "%s"
+  }`, host, admin, password, volumeName, svmName, hardLimit, softLimit, quotaState)
+}
@@ -0,0 +1,170 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+const snapshotPolicySchedulesAPI = "storage/snapshot-policies/5f7a8e91-3c2b-11ef-8f2a-005056b3a1c2/schedules"
+
+// snapshotPolicyCopy returns a copy of schedule with prefix, a null prefix when it is empty
+func snapshotPolicyCopy(schedule string, prefix string) CopyResourceModel {
+	onecopy := CopyResourceModel{
+		Count:           types.Int64Value(2),
+		Schedule:        ScheduleResourceModel{Name: types.StringValue(schedule)},
+		RetentionPeriod: types.StringNull(),
+		SnapmirrorLabel: types.StringNull(),
+		Prefix:          types.StringNull(),
+	}
+	if prefix != "" {
+		onecopy.Prefix = types.StringValue(prefix)
+	}
+	return onecopy
+}
+
+func TestSnapshotPolicyPrefixChangeRequiresReplace(t *testing.T) {
+	tests := []struct {
+		name  string
+		plan  []CopyResourceModel
+		state []CopyResourceModel
+		want  bool
+	}{
+		{
+			name:  "test_single_schedule_prefix_change",
+			plan:  []CopyResourceModel{snapshotPolicyCopy("hourly", "new")},
+			state: []CopyResourceModel{snapshotPolicyCopy("hourly", "")},
+			want:  true,
+		},
+		{
+			name:  "test_single_schedule_count_change",
+			plan:  []CopyResourceModel{{Count: types.Int64Value(4), Schedule: ScheduleResourceModel{Name: types.StringValue("hourly")}}},
+			state: []CopyResourceModel{snapshotPolicyCopy("hourly", "")},
+			want:  false,
+		},
+		{
+			name:  "test_prefix_change_with_kept_schedule",
+			plan:  []CopyResourceModel{snapshotPolicyCopy("hourly", "new"), snapshotPolicyCopy("daily", "")},
+			state: []CopyResourceModel{snapshotPolicyCopy("hourly", ""), snapshotPolicyCopy("daily", "")},
+			want:  false,
+		},
+		{
+			name:  "test_prefix_change_with_added_schedule",
+			plan:  []CopyResourceModel{snapshotPolicyCopy("hourly", "new"), snapshotPolicyCopy("daily", "")},
+			state: []CopyResourceModel{snapshotPolicyCopy("hourly", "")},
+			want:  false,
+		},
+		{
+			name:  "test_prefix_change_with_removed_schedule",
+			plan:  []CopyResourceModel{snapshotPolicyCopy("hourly", "new")},
+			state: []CopyResourceModel{snapshotPolicyCopy("hourly", ""), snapshotPolicyCopy("daily", "")},
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := snapshotPolicyPrefixChangeRequiresReplace(tt.plan, tt.state); got != tt.want {
+				t.Errorf("snapshotPolicyPrefixChangeRequiresReplace() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateSnapshotPolicyCopies(t *testing.T) {
+	noRecords := restclient.RestResponse{NumRecords: 0, Records: []map[string]any{}}
+	schedules := restclient.RestResponse{NumRecords: 2, Records: []map[string]any{
+		{"schedule": map[string]any{"name": "hourly", "uuid": "hourly-uuid"}, "count": 2, "prefix": "hourly"},
+		{"schedule": map[string]any{"name": "daily", "uuid": "daily-uuid"}, "count": 2, "prefix": "daily"},
+	}}
+	get := restclient.MockResponse{ExpectedMethod: "GET", ExpectedURL: snapshotPolicySchedulesAPI, StatusCode: 200, Response: schedules, Err: nil}
+	create := restclient.MockResponse{ExpectedMethod: "POST", ExpectedURL: snapshotPolicySchedulesAPI, StatusCode: 201, Response: noRecords, Err: nil}
+	createFailed := restclient.MockResponse{ExpectedMethod: "POST", ExpectedURL: snapshotPolicySchedulesAPI, StatusCode: 400, Response: noRecords, Err: fmt.Errorf("invalid prefix")}
+	deleteHourly := restclient.MockResponse{ExpectedMethod: "DELETE", ExpectedURL: snapshotPolicySchedulesAPI + "/hourly-uuid", StatusCode: 200, Response: noRecords, Err: nil}
+	deleteDaily := restclient.MockResponse{ExpectedMethod: "DELETE", ExpectedURL: snapshotPolicySchedulesAPI + "/daily-uuid", StatusCode: 200, Response: noRecords, Err: nil}
+
+	tests := []struct {
+		name      string
+		plan      []CopyResourceModel
+		state     []CopyResourceModel
+		responses []restclient.MockResponse
+		// wantPrefixes holds the prefix of each POST, in order
+		wantPrefixes []string
+		wantErr      bool
+	}{
+		{
+			name:         "test_prefix_change_with_kept_schedule",
+			plan:         []CopyResourceModel{snapshotPolicyCopy("hourly", "new"), snapshotPolicyCopy("daily", "")},
+			state:        []CopyResourceModel{snapshotPolicyCopy("hourly", ""), snapshotPolicyCopy("daily", "")},
+			responses:    []restclient.MockResponse{get, deleteHourly, create},
+			wantPrefixes: []string{"new"},
+		},
+		{
+			// daily is still in the policy while hourly is removed and added back
+			name:         "test_prefix_change_with_removed_schedule",
+			plan:         []CopyResourceModel{snapshotPolicyCopy("hourly", "new")},
+			state:        []CopyResourceModel{snapshotPolicyCopy("hourly", ""), snapshotPolicyCopy("daily", "")},
+			responses:    []restclient.MockResponse{get, deleteHourly, create, deleteDaily},
+			wantPrefixes: []string{"new"},
+		},
+		{
+			name:         "test_prefix_change_failure_adds_back_schedule",
+			plan:         []CopyResourceModel{snapshotPolicyCopy("hourly", "new"), snapshotPolicyCopy("daily", "")},
+			state:        []CopyResourceModel{snapshotPolicyCopy("hourly", "old"), snapshotPolicyCopy("daily", "")},
+			responses:    []restclient.MockResponse{get, deleteHourly, createFailed, create},
+			wantPrefixes: []string{"new", "old"},
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errorHandler := utils.NewErrorHandler(context.Background(), &diag.Diagnostics{})
+			r, err := restclient.NewRecordingMockedRestClient(tt.responses)
+			if err != nil {
+				panic(err)
+			}
+			err = updateSnapshotPolicyCopies(errorHandler, *r, "5f7a8e91-3c2b-11ef-8f2a-005056b3a1c2", tt.plan, tt.state)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("updateSnapshotPolicyCopies() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			requests := r.MockRequests()
+			if len(requests) != len(tt.responses) {
+				t.Fatalf("updateSnapshotPolicyCopies() sent %d requests, want %d", len(requests), len(tt.responses))
+			}
+			var prefixes []string
+			for _, request := range requests {
+				if request.Method == "POST" {
+					prefixes = append(prefixes, fmt.Sprint(request.Body["prefix"]))
+				}
+			}
+			if fmt.Sprint(prefixes) != fmt.Sprint(tt.wantPrefixes) {
+				t.Errorf("updateSnapshotPolicyCopies() prefixes = %v, want %v", prefixes, tt.wantPrefixes)
+			}
+		})
+	}
+}
+
+func TestFlattenSnapshotPolicyCopies(t *testing.T) {
+	copies := []interfaces.CopyType{{Count: 2, Prefix: "hourly", RetentionPeriod: "0", SnapmirrorLabel: "-"}}
+	copies[0].Schedule.Name = "hourly"
+
+	// unmanaged values keep the ONTAP defaults out of the state
+	got := flattenSnapshotPolicyCopies(copies, []CopyResourceModel{snapshotPolicyCopy("hourly", "")})
+	if !got[0].Prefix.IsNull() || !got[0].RetentionPeriod.IsNull() || !got[0].SnapmirrorLabel.IsNull() {
+		t.Errorf("flattenSnapshotPolicyCopies() = %#v, want null prefix, retention_period and snapmirror_label", got[0])
+	}
+
+	// managed values report the ONTAP defaults, so a change outside of Terraform shows as drift
+	state := snapshotPolicyCopy("hourly", "custom")
+	state.RetentionPeriod = types.StringValue("PT1H")
+	state.SnapmirrorLabel = types.StringValue("weekly")
+	got = flattenSnapshotPolicyCopies(copies, []CopyResourceModel{state})
+	if got[0].Prefix.ValueString() != "hourly" || got[0].RetentionPeriod.ValueString() != "0" || got[0].SnapmirrorLabel.ValueString() != "-" {
+		t.Errorf("flattenSnapshotPolicyCopies() = %#v, want the ONTAP values", got[0])
+	}
+}
@@ -6,13 +6,12 @@ import (
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -20,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/mitchellh/mapstructure"
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
 	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
 )
 
@@ -87,43 +87,40 @@ func (r *SnapshotPolicyResource) Schema(ctx context.Context, req resource.Schema
 				Required:            true,
 			},
 			"copies": schema.SetNestedAttribute{
-				MarkdownDescription: "Snapshot copy",
+				MarkdownDescription: "Snapshot copy schedules. Schedules are added, modified or removed in place, except that changing the prefix of the only schedule replaces the policy",
 				Required:            true,
-				PlanModifiers:       []planmodifier.Set{setplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplaceIf(snapshotPolicyCopiesRequireReplace,
+						"Changing the prefix of the only schedule replaces the policy",
+						"Changing the prefix of the only schedule replaces the policy"),
+				},
 				NestedObject: schema.NestedAttributeObject{
-					PlanModifiers: []planmodifier.Object{objectplanmodifier.RequiresReplace()},
 					Attributes: map[string]schema.Attribute{
 						"count": schema.Int64Attribute{
 							MarkdownDescription: "The number of Snapshot copies to maintain for this schedule",
 							Required:            true,
-							PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
 						},
 						"schedule": schema.SingleNestedAttribute{
 							MarkdownDescription: "Schedule at which Snapshot copies are captured on the volume",
 							Required:            true,
-							PlanModifiers:       []planmodifier.Object{objectplanmodifier.RequiresReplace()},
 							Attributes: map[string]schema.Attribute{
 								"name": schema.StringAttribute{
 									MarkdownDescription: "Some common schedules already defined in the system are hourly, daily, weekly, at 15 minute intervals, and at 5 minute intervals. Snapshot copy policies with custom schedules can be referenced",
 									Required:            true,
-									PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 								},
 							},
 						},
 						"retention_period": schema.StringAttribute{
-							MarkdownDescription: "The retention period of Snapshot copies for this schedule",
+							MarkdownDescription: "The retention period of Snapshot copies for this schedule. When not set, the ONTAP default 0 is not reported",
 							Optional:            true,
-							PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 						},
 						"snapmirror_label": schema.StringAttribute{
-							MarkdownDescription: "Label for SnapMirror operations",
+							MarkdownDescription: "Label for SnapMirror operations. When not set, the ONTAP default - is not reported",
 							Optional:            true,
-							PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 						},
 						"prefix": schema.StringAttribute{
-							MarkdownDescription: "The prefix to use while creating Snapshot copies at regular intervals",
+							MarkdownDescription: "The prefix to use while creating Snapshot copies at regular intervals. When not set, the ONTAP default, the schedule name, is not reported. ONTAP cannot modify a prefix, changing it removes and adds back the schedule",
 							Optional:            true,
-							PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 						},
 					},
 				},
@@ -212,6 +209,7 @@ func (r *SnapshotPolicyResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 	data.Name = types.StringValue(restInfo.Name)
 	data.ID = types.StringValue(restInfo.UUID)
+	data.Copies = flattenSnapshotPolicyCopies(restInfo.Copies, data.Copies)
 
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
@@ -240,19 +238,7 @@ func (r *SnapshotPolicyResource) Create(ctx context.Context, req resource.Create
 
 	copies := []interfaces.CopyType{}
 	for _, v := range data.Copies {
-		onecopy := interfaces.CopyType{}
-		onecopy.Count = v.Count.ValueInt64()
-		onecopy.Schedule.Name = v.Schedule.Name.ValueString()
-		if !v.Prefix.IsNull() {
-			onecopy.Prefix = v.Prefix.ValueString()
-		}
-		if !v.RetentionPeriod.IsNull() {
-			onecopy.RetentionPeriod = v.RetentionPeriod.ValueString()
-		}
-		if !v.SnapmirrorLabel.IsNull() {
-			onecopy.SnapmirrorLabel = v.SnapmirrorLabel.ValueString()
-		}
-		copies = append(copies, onecopy)
+		copies = append(copies, expandSnapshotPolicyCopy(v))
 	}
 	err := mapstructure.Decode(copies, &body.Copies)
 	if err != nil {
@@ -311,15 +297,22 @@ func (r *SnapshotPolicyResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	var body interfaces.SnapshotPolicyResourceUpdateRequestONTAP
-	if !data.Comment.Equal(state.Comment) {
-		body.Comment = data.Comment.ValueString()
-	}
-	if !data.Enabled.Equal(state.Enabled) {
-		body.Enabled = data.Enabled.ValueBool()
+	if !data.Comment.Equal(state.Comment) || !data.Enabled.Equal(state.Enabled) {
+		var body interfaces.SnapshotPolicyResourceUpdateRequestONTAP
+		if !data.Comment.Equal(state.Comment) {
+			body.Comment = data.Comment.ValueString()
+		}
+		if !data.Enabled.Equal(state.Enabled) {
+			body.Enabled = data.Enabled.ValueBool()
+		}
+
+		err = interfaces.UpdateSnapshotPolicy(errorHandler, *client, body, data.ID.ValueString())
+		if err != nil {
+			return
+		}
 	}
 
-	err = interfaces.UpdateSnapshotPolicy(errorHandler, *client, body, data.ID.ValueString())
+	err = updateSnapshotPolicyCopies(errorHandler, *client, data.ID.ValueString(), data.Copies, state.Copies)
 	if err != nil {
 		return
 	}
@@ -371,3 +364,178 @@ func (r *SnapshotPolicyResource) ImportState(ctx context.Context, req resource.I
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[1])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[2])...)
 }
+
+// updateSnapshotPolicyCopies applies the schedule changes using the storage/snapshot-policies/{uuid}/schedules endpoint.
+// New schedules are added first and removed schedules last, as a policy cannot be left without a schedule.
+// A prefix change removes and adds back the schedule while the other schedules are in place, see snapshotPolicyCopiesRequireReplace.
+func updateSnapshotPolicyCopies(errorHandler *utils.ErrorHandler, client restclient.RestClient, id string, plan []CopyResourceModel, state []CopyResourceModel) error {
+	current := make(map[string]CopyResourceModel, len(state))
+	for _, v := range state {
+		current[v.Schedule.Name.ValueString()] = v
+	}
+	wanted := make(map[string]CopyResourceModel, len(plan))
+	for _, v := range plan {
+		wanted[v.Schedule.Name.ValueString()] = v
+	}
+
+	schedules, err := interfaces.GetSnapshotPolicySchedules(errorHandler, client, id)
+	if err != nil {
+		return err
+	}
+	scheduleUUIDs := make(map[string]string, len(schedules))
+	for _, v := range schedules {
+		scheduleUUIDs[v.Schedule.Name] = v.Schedule.UUID
+	}
+
+	// add
+	for _, v := range plan {
+		if _, ok := current[v.Schedule.Name.ValueString()]; ok {
+			continue
+		}
+		err = interfaces.CreateSnapshotPolicySchedule(errorHandler, client, expandSnapshotPolicyCopy(v), id)
+		if err != nil {
+			return err
+		}
+	}
+	// modify
+	for _, v := range plan {
+		name := v.Schedule.Name.ValueString()
+		old, ok := current[name]
+		if !ok {
+			continue
+		}
+		scheduleUUID, ok := scheduleUUIDs[name]
+		if !ok {
+			return errorHandler.MakeAndReportError("error updating snapshot policy schedule", fmt.Sprintf("schedule %s not found in snapshot policy %s", name, id))
+		}
+		if !v.Prefix.Equal(old.Prefix) {
+			// prefix cannot be modified, remove and add back the schedule
+			err = interfaces.DeleteSnapshotPolicySchedule(errorHandler, client, id, scheduleUUID)
+			if err != nil {
+				return err
+			}
+			err = interfaces.CreateSnapshotPolicySchedule(errorHandler, client, expandSnapshotPolicyCopy(v), id)
+			if err != nil {
+				// the create error is the one reported, add back the previous schedule so the policy does not lose it
+				bestEffort := utils.NewErrorHandler(errorHandler.Ctx, &diag.Diagnostics{})
+				if restoreErr := interfaces.CreateSnapshotPolicySchedule(bestEffort, client, expandSnapshotPolicyCopy(old), id); restoreErr != nil {
+					tflog.Warn(errorHandler.Ctx, fmt.Sprintf("failed to add back schedule %s to snapshot policy %s: %s", name, id, restoreErr))
+				}
+				return err
+			}
+			continue
+		}
+		if !v.Count.Equal(old.Count) || !v.SnapmirrorLabel.Equal(old.SnapmirrorLabel) || !v.RetentionPeriod.Equal(old.RetentionPeriod) {
+			var body interfaces.SnapshotPolicyScheduleUpdateRequestONTAP
+			body.Count = v.Count.ValueInt64()
+			body.SnapmirrorLabel = v.SnapmirrorLabel.ValueString()
+			body.RetentionPeriod = v.RetentionPeriod.ValueString()
+			err = interfaces.UpdateSnapshotPolicySchedule(errorHandler, client, body, id, scheduleUUID)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	// remove
+	for _, v := range state {
+		name := v.Schedule.Name.ValueString()
+		if _, ok := wanted[name]; ok {
+			continue
+		}
+		scheduleUUID, ok := scheduleUUIDs[name]
+		if !ok {
+			// already removed outside of terraform
+			continue
+		}
+		err = interfaces.DeleteSnapshotPolicySchedule(errorHandler, client, id, scheduleUUID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// snapshotPolicyCopiesRequireReplace replaces the policy when the prefix of a schedule changes and no other schedule is kept or added,
+// as ONTAP refuses to remove the last schedule of a policy to add it back with the new prefix
+func snapshotPolicyCopiesRequireReplace(ctx context.Context, req planmodifier.SetRequest, resp *setplanmodifier.RequiresReplaceIfFuncResponse) {
+	if req.PlanValue.IsUnknown() || req.StateValue.IsNull() {
+		return
+	}
+	var plan, state []CopyResourceModel
+	resp.Diagnostics.Append(req.PlanValue.ElementsAs(ctx, &plan, false)...)
+	resp.Diagnostics.Append(req.StateValue.ElementsAs(ctx, &state, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.RequiresReplace = snapshotPolicyPrefixChangeRequiresReplace(plan, state)
+}
+
+// snapshotPolicyPrefixChangeRequiresReplace reports whether a prefix changes while the policy has no other schedule
+func snapshotPolicyPrefixChangeRequiresReplace(plan []CopyResourceModel, state []CopyResourceModel) bool {
+	names := make(map[string]bool, len(state)+len(plan))
+	current := make(map[string]CopyResourceModel, len(state))
+	for _, v := range state {
+		names[v.Schedule.Name.ValueString()] = true
+		current[v.Schedule.Name.ValueString()] = v
+	}
+	prefixChanged := false
+	for _, v := range plan {
+		names[v.Schedule.Name.ValueString()] = true
+		if old, ok := current[v.Schedule.Name.ValueString()]; ok && !v.Prefix.IsUnknown() && !v.Prefix.Equal(old.Prefix) {
+			prefixChanged = true
+		}
+	}
+	return prefixChanged && len(names) < 2
+}
+
+// expandSnapshotPolicyCopy converts a copy from the terraform model to the ONTAP model
+func expandSnapshotPolicyCopy(v CopyResourceModel) interfaces.CopyType {
+	onecopy := interfaces.CopyType{}
+	onecopy.Count = v.Count.ValueInt64()
+	onecopy.Schedule.Name = v.Schedule.Name.ValueString()
+	if !v.Prefix.IsNull() {
+		onecopy.Prefix = v.Prefix.ValueString()
+	}
+	if !v.RetentionPeriod.IsNull() {
+		onecopy.RetentionPeriod = v.RetentionPeriod.ValueString()
+	}
+	if !v.SnapmirrorLabel.IsNull() {
+		onecopy.SnapmirrorLabel = v.SnapmirrorLabel.ValueString()
+	}
+	return onecopy
+}
+
+// flattenSnapshotPolicyCopies converts the ONTAP copies to the terraform model.
+// An optional value that is null in the prior state stays null when ONTAP reports its default value:
+// the schedule name for prefix, 0 for retention_period and - for snapmirror_label.
+// A value set in the prior state is always reported as is, so a change made outside of Terraform shows as drift.
+func flattenSnapshotPolicyCopies(copies []interfaces.CopyType, state []CopyResourceModel) []CopyResourceModel {
+	current := make(map[string]CopyResourceModel, len(state))
+	for _, v := range state {
+		current[v.Schedule.Name.ValueString()] = v
+	}
+	result := make([]CopyResourceModel, 0, len(copies))
+	for _, v := range copies {
+		old, ok := current[v.Schedule.Name]
+		onecopy := CopyResourceModel{
+			Count:           types.Int64Value(v.Count),
+			Schedule:        ScheduleResourceModel{Name: types.StringValue(v.Schedule.Name)},
+			Prefix:          types.StringNull(),
+			RetentionPeriod: types.StringNull(),
+			SnapmirrorLabel: types.StringNull(),
+		}
+		onecopy.Prefix = flattenSnapshotPolicyCopyValue(v.Prefix, v.Schedule.Name, ok && !old.Prefix.IsNull())
+		onecopy.RetentionPeriod = flattenSnapshotPolicyCopyValue(v.RetentionPeriod, "0", ok && !old.RetentionPeriod.IsNull())
+		onecopy.SnapmirrorLabel = flattenSnapshotPolicyCopyValue(v.SnapmirrorLabel, "-", ok && !old.SnapmirrorLabel.IsNull())
+		result = append(result, onecopy)
+	}
+	return result
+}
+
+// flattenSnapshotPolicyCopyValue returns null for an empty value, or for the ONTAP default when the value is not managed
+func flattenSnapshotPolicyCopyValue(value string, ontapDefault string, managed bool) types.String {
+	if value == "" || (value == ontapDefault && !managed) {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
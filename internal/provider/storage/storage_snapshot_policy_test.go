@@ -38,6 +38,23 @@ func TestAccStorageSnapshotPolicyResource(t *testing.T) {
 					resource.TestCheckResourceAttr("netapp-ontap_snapshot_policy.example", "enabled", "true"),
 				),
 			},
+			// Update schedules in place: modify count and label on daily, add weekly
+			{
+				Config: testAccStorageSnapshotPolicyResourceCopiesConfig("tf-sn-policy", "carchi-test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_snapshot_policy.example", "name", "tf-sn-policy"),
+					resource.TestCheckResourceAttr("netapp-ontap_snapshot_policy.example", "copies.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("netapp-ontap_snapshot_policy.example", "copies.*", map[string]string{
+						"count":            "5",
+						"schedule.name":    "daily",
+						"snapmirror_label": "daily",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("netapp-ontap_snapshot_policy.example", "copies.*", map[string]string{
+						"count":         "2",
+						"schedule.name": "weekly",
+					}),
+				),
+			},
 			// Test importing a resource
 			{
 				ResourceName:  "netapp-ontap_snapshot_policy.example",
@@ -89,3 +106,49 @@ resource "netapp-ontap_snapshot_policy" "example" {
   ]
 }`, host, admin, password, name, svmname, comment, enabled)
 }
+
+func testAccStorageSnapshotPolicyResourceCopiesConfig(name string, svmname string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_snapshot_policy" "example" {
+  # required to know which system to interface with
+  cx_profile_name = "cluster4"
+  name = "%s"
+  svm_name = "%s"
+  comment = "Update the existing snapshot policy"
+  enabled = true
+  copies = [
+  {
+	count = 5
+	schedule = {
+	  name = "daily"
+	}
+	snapmirror_label = "daily"
+  },
+  {
+	count = 2
+	schedule = {
+	  name = "weekly"
+	}
+  },
+  ]
+}`, host, admin, password, name, svmname)
+}
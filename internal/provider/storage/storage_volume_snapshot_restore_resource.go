@@ -0,0 +1,234 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &StorageVolumeSnapshotRestoreResource{}
+
+// NewStorageVolumeSnapshotRestoreResource is a helper function to simplify the provider implementation.
+func NewStorageVolumeSnapshotRestoreResource() resource.Resource {
+	return &StorageVolumeSnapshotRestoreResource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "volume_snapshot_restore",
+		},
+	}
+}
+
+// StorageVolumeSnapshotRestoreResource defines the resource implementation.
+type StorageVolumeSnapshotRestoreResource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// StorageVolumeSnapshotRestoreResourceModel describes the resource data model.
+type StorageVolumeSnapshotRestoreResourceModel struct {
+	CxProfileName types.String `tfsdk:"cx_profile_name"`
+	SVMName       types.String `tfsdk:"svm_name"`
+	VolumeName    types.String `tfsdk:"volume_name"`
+	SnapshotName  types.String `tfsdk:"snapshot_name"`
+	FilePath      types.String `tfsdk:"file_path"`
+	RestorePath   types.String `tfsdk:"restore_path"`
+	RestoreTime   types.String `tfsdk:"restore_time"`
+	ID            types.String `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *StorageVolumeSnapshotRestoreResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.Name
+}
+
+// Schema defines the schema for the resource.
+func (r *StorageVolumeSnapshotRestoreResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Storage Volume Snapshot restore resource. The restore runs when the resource is created, any change recreates the resource and triggers a new restore.",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the SVM the volume is on",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"volume_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the volume to restore",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"snapshot_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the snapshot to restore from",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"file_path": schema.StringAttribute{
+				MarkdownDescription: "Path of a single file to restore, relative to the volume root. The whole volume is restored when not set",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"restore_path": schema.StringAttribute{
+				MarkdownDescription: "Destination path for the restored file. Defaults to file_path. Only applies when file_path is set",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"restore_time": schema.StringAttribute{
+				MarkdownDescription: "Time at which the restore completed, in RFC3339 format",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "storage/volumes/snapshots identifier of the restored snapshot",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *StorageVolumeSnapshotRestoreResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.ProviderConfig = config
+}
+
+// Create restores the volume or file and sets the initial Terraform state.
+func (r *StorageVolumeSnapshotRestoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *StorageVolumeSnapshotRestoreResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	if data.FilePath.IsNull() && !data.RestorePath.IsNull() {
+		errorHandler.MakeAndReportError("invalid restore_path", "restore_path requires file_path to be set")
+		return
+	}
+
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	svm, err := interfaces.GetSvmByName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		return
+	}
+	if svm == nil {
+		errorHandler.MakeAndReportError("No svm found", fmt.Sprintf("svm %s not found.", data.SVMName))
+		return
+	}
+	volume, err := interfaces.GetUUIDVolumeByName(errorHandler, *client, svm.UUID, data.VolumeName.ValueString())
+	if err != nil {
+		return
+	}
+	snapshot, err := interfaces.GetUUIDStorageVolumeSnapshotsByName(errorHandler, *client, data.SnapshotName.ValueString(), volume.UUID)
+	if err != nil {
+		return
+	}
+	if snapshot == nil {
+		errorHandler.MakeAndReportError("No snapshot found", fmt.Sprintf("snapshot %s not found on volume %s.", data.SnapshotName, data.VolumeName))
+		return
+	}
+
+	var request interfaces.StorageVolumeSnapshotRestoreBodyDataModelONTAP
+	request.RestoreTo.Snapshot.Name = snapshot.Name
+	if !data.FilePath.IsNull() {
+		request.RestoreTo.File = &interfaces.SnapshotRestoreFile{
+			Path: data.FilePath.ValueString(),
+		}
+		if !data.RestorePath.IsNull() {
+			request.RestoreTo.File.RestorePath = data.RestorePath.ValueString()
+		}
+	}
+
+	err = interfaces.RestoreStorageVolumeSnapshot(errorHandler, *client, request, volume.UUID)
+	if err != nil {
+		return
+	}
+
+	data.ID = types.StringValue(snapshot.UUID)
+	data.RestoreTime = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	tflog.Trace(ctx, "restored a snapshot")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read keeps the Terraform state as is. A restore is a one time action, there is nothing to refresh from ONTAP.
+func (r *StorageVolumeSnapshotRestoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *StorageVolumeSnapshotRestoreResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("read a snapshot restore resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is not expected to be called as every attribute requires replacement.
+func (r *StorageVolumeSnapshotRestoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *StorageVolumeSnapshotRestoreResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Error(ctx, "Update not supported for snapshot restore, the resource needs to be recreated")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the resource from the Terraform state. The restored data is left untouched.
+func (r *StorageVolumeSnapshotRestoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *StorageVolumeSnapshotRestoreResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("removing snapshot restore %s from state, no change on ONTAP", data.ID.ValueString()))
+}
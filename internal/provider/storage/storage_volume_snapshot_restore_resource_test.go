@@ -0,0 +1,64 @@
+package storage_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	ntest "github.com/netapp/terraform-provider-netapp-ontap/internal/provider"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccStorageVolumeSnapshotRestoreResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { ntest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ntest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// non-existant snapshot
+			{
+				Config:      testAccStorageVolumeSnapshotRestoreResourceConfig("non-existant"),
+				ExpectError: regexp.MustCompile("snapshot non-existant not found"),
+			},
+			// Create and read testing
+			{
+				Config: testAccStorageVolumeSnapshotRestoreResourceConfig("snaptest"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_volume_snapshot_restore.example", "volume_name", "carchi_test_root"),
+					resource.TestCheckResourceAttr("netapp-ontap_volume_snapshot_restore.example", "snapshot_name", "snaptest"),
+					resource.TestCheckResourceAttrSet("netapp-ontap_volume_snapshot_restore.example", "restore_time"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStorageVolumeSnapshotRestoreResourceConfig(snapshotName string) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_volume_snapshot_restore" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "carchi-test"
+  volume_name = "carchi_test_root"
+  snapshot_name = "%s"
+}`, host, admin, password, snapshotName)
+}
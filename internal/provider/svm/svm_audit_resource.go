@@ -0,0 +1,512 @@
+package svm
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/interfaces"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/provider/connection"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/restclient"
+	"github.com/netapp/terraform-provider-netapp-ontap/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &SvmAuditResource{}
+var _ resource.ResourceWithImportState = &SvmAuditResource{}
+
+// NewSvmAuditResource is a helper function to simplify the provider implementation.
+func NewSvmAuditResource() resource.Resource {
+	return &SvmAuditResource{
+		config: connection.ResourceOrDataSourceConfig{
+			Name: "svm_audit",
+		},
+	}
+}
+
+// SvmAuditResource defines the resource implementation.
+type SvmAuditResource struct {
+	config connection.ResourceOrDataSourceConfig
+}
+
+// SvmAuditResourceModel describes the resource data model.
+type SvmAuditResourceModel struct {
+	CxProfileName    types.String                           `tfsdk:"cx_profile_name"`
+	SVMName          types.String                           `tfsdk:"svm_name"`
+	Enabled          types.Bool                             `tfsdk:"enabled"`
+	LogPath          types.String                           `tfsdk:"log_path"`
+	Format           types.String                           `tfsdk:"format"`
+	RotationSize     types.Int64                            `tfsdk:"rotation_size"`
+	RotationSchedule *SvmAuditRotationScheduleResourceModel `tfsdk:"rotation_schedule"`
+	RetentionCount   types.Int64                            `tfsdk:"retention_count"`
+	Events           *SvmAuditEventsResourceModel           `tfsdk:"events"`
+	ID               types.String                           `tfsdk:"id"`
+}
+
+// SvmAuditRotationScheduleResourceModel describes a time based log rotation schedule.
+type SvmAuditRotationScheduleResourceModel struct {
+	Minutes  []types.Int64 `tfsdk:"minutes"`
+	Hours    []types.Int64 `tfsdk:"hours"`
+	Weekdays []types.Int64 `tfsdk:"weekdays"`
+	Days     []types.Int64 `tfsdk:"days"`
+	Months   []types.Int64 `tfsdk:"months"`
+}
+
+// SvmAuditEventsResourceModel describes the audited events.
+type SvmAuditEventsResourceModel struct {
+	FileOperations      types.Bool `tfsdk:"file_operations"`
+	CifsLogonLogoff     types.Bool `tfsdk:"cifs_logon_logoff"`
+	AuditPolicyChange   types.Bool `tfsdk:"audit_policy_change"`
+	FileShare           types.Bool `tfsdk:"file_share"`
+	UserAccount         types.Bool `tfsdk:"user_account"`
+	SecurityGroup       types.Bool `tfsdk:"security_group"`
+	AuthorizationPolicy types.Bool `tfsdk:"authorization_policy"`
+	CapStaging          types.Bool `tfsdk:"cap_staging"`
+}
+
+// Metadata returns the resource type name.
+func (r *SvmAuditResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.Name
+}
+
+// Schema defines the schema for the resource.
+func (r *SvmAuditResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	scheduleSet := func(description string, min int64, max int64) schema.SetAttribute {
+		return schema.SetAttribute{
+			ElementType:         types.Int64Type,
+			MarkdownDescription: description,
+			Optional:            true,
+			Validators: []validator.Set{
+				setvalidator.ValueInt64sAre(int64validator.Between(min, max)),
+			},
+		}
+	}
+	eventBool := func(description string, defaultValue bool) schema.BoolAttribute {
+		return schema.BoolAttribute{
+			MarkdownDescription: description,
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(defaultValue),
+		}
+	}
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "SvmAudit resource",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"svm_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the SVM to audit",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether auditing is enabled on the SVM",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"log_path": schema.StringAttribute{
+				MarkdownDescription: "Directory in the SVM namespace the audit logs are written to. The directory must exist before auditing is configured",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^/`), "must be an absolute path in the SVM namespace"),
+				},
+			},
+			"format": schema.StringAttribute{
+				MarkdownDescription: "Format of the audit logs. One of evtx, xml",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("evtx"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("evtx", "xml"),
+				},
+			},
+			"rotation_size": schema.Int64Attribute{
+				MarkdownDescription: "Size of the audit log, in bytes, that triggers a rotation",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotation_schedule": schema.SingleNestedAttribute{
+				MarkdownDescription: "Time based rotation of the audit logs. The schedule is not managed when omitted",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"minutes":  scheduleSet("Minutes of the hour the logs are rotated at", 0, 59),
+					"hours":    scheduleSet("Hours of the day the logs are rotated at", 0, 23),
+					"weekdays": scheduleSet("Days of the week the logs are rotated on, 0 is Sunday", 0, 6),
+					"days":     scheduleSet("Days of the month the logs are rotated on", 1, 31),
+					"months":   scheduleSet("Months the logs are rotated in, 0 is January", 0, 11),
+				},
+			},
+			"retention_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of rotated audit logs that are kept",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 999),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"events": schema.SingleNestedAttribute{
+				MarkdownDescription: "Events that are audited. The events are not managed when omitted",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"file_operations":      eventBool("Audit file operations", true),
+					"cifs_logon_logoff":    eventBool("Audit CIFS logon and logoff", true),
+					"audit_policy_change":  eventBool("Audit policy changes", false),
+					"file_share":           eventBool("Audit file share changes", false),
+					"user_account":         eventBool("Audit local user account changes", false),
+					"security_group":       eventBool("Audit local security group changes", false),
+					"authorization_policy": eventBool("Audit authorization policy changes", false),
+					"cap_staging":          eventBool("Audit central access policy staging", false),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "SVM UUID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *SvmAuditResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(connection.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.ProviderConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *SvmAuditResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SvmAuditResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	restInfo, err := interfaces.GetSvmAuditBySVMName(errorHandler, *client, data.SVMName.ValueString())
+	if err != nil {
+		// error reporting done inside GetSvmAuditBySVMName
+		return
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No audit configuration found on svm %s", data.SVMName.ValueString()))
+		return
+	}
+
+	flattenSvmAudit(&data, restInfo)
+
+	tflog.Debug(ctx, fmt.Sprintf("read a resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Create a resource and retrieve UUID
+func (r *SvmAuditResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SvmAuditResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var body interfaces.SvmAuditResourceBodyDataModelONTAP
+	body.SVM.Name = data.SVMName.ValueString()
+	body.Enabled = data.Enabled.ValueBool()
+	body.LogPath = data.LogPath.ValueString()
+	body.Log = expandSvmAuditLog(data)
+	body.Events = expandSvmAuditEvents(data.Events)
+
+	err = interfaces.CreateSvmAudit(errorHandler, *client, body)
+	if err != nil {
+		return
+	}
+
+	// pick up the UUID and the ONTAP defaults for rotation and retention
+	if !readBackSvmAudit(errorHandler, *client, data) {
+		return
+	}
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *SvmAuditResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *SvmAuditResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, plan.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	enabled := plan.Enabled.ValueBool()
+	// auditing is disabled before the configuration changes, and enabled once it is in place
+	if !enabled && state.Enabled.ValueBool() {
+		err = interfaces.UpdateSvmAudit(errorHandler, *client, state.ID.ValueString(), interfaces.SvmAuditUpdateBodyDataModelONTAP{Enabled: &enabled})
+		if err != nil {
+			return
+		}
+	}
+
+	var body interfaces.SvmAuditUpdateBodyDataModelONTAP
+	if !plan.LogPath.Equal(state.LogPath) {
+		body.LogPath = plan.LogPath.ValueString()
+	}
+	if !plan.Format.Equal(state.Format) || !plan.RotationSize.Equal(state.RotationSize) || !plan.RetentionCount.Equal(state.RetentionCount) ||
+		(plan.RotationSchedule != nil && !reflect.DeepEqual(plan.RotationSchedule, state.RotationSchedule)) {
+		body.Log = expandSvmAuditLog(plan)
+	}
+	if plan.Events != nil && !reflect.DeepEqual(plan.Events, state.Events) {
+		body.Events = expandSvmAuditEvents(plan.Events)
+	}
+	if !reflect.DeepEqual(body, interfaces.SvmAuditUpdateBodyDataModelONTAP{}) {
+		err = interfaces.UpdateSvmAudit(errorHandler, *client, state.ID.ValueString(), body)
+		if err != nil {
+			return
+		}
+	}
+
+	if enabled && !state.Enabled.ValueBool() {
+		err = interfaces.UpdateSvmAudit(errorHandler, *client, state.ID.ValueString(), interfaces.SvmAuditUpdateBodyDataModelONTAP{Enabled: &enabled})
+		if err != nil {
+			return
+		}
+	}
+
+	if !readBackSvmAudit(errorHandler, *client, plan) {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *SvmAuditResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SvmAuditResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := connection.GetRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if data.ID.IsNull() {
+		errorHandler.MakeAndReportError("UUID is null", "svm_audit UUID is null")
+		return
+	}
+
+	// auditing needs to be disabled before the configuration can be deleted
+	if data.Enabled.ValueBool() {
+		enabled := false
+		err = interfaces.UpdateSvmAudit(errorHandler, *client, data.ID.ValueString(), interfaces.SvmAuditUpdateBodyDataModelONTAP{Enabled: &enabled})
+		if err != nil {
+			return
+		}
+	}
+
+	err = interfaces.DeleteSvmAudit(errorHandler, *client, data.ID.ValueString())
+	if err != nil {
+		return
+	}
+}
+
+// ImportState imports a resource using ID from terraform import command by calling the Read method.
+func (r *SvmAuditResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: svm_name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("svm_name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[1])...)
+}
+
+func readBackSvmAudit(errorHandler *utils.ErrorHandler, r restclient.RestClient, data *SvmAuditResourceModel) bool {
+	restInfo, err := interfaces.GetSvmAuditBySVMName(errorHandler, r, data.SVMName.ValueString())
+	if err != nil {
+		return false
+	}
+	if restInfo == nil {
+		errorHandler.MakeAndReportError("error reading info", fmt.Sprintf("No audit configuration found on svm %s", data.SVMName.ValueString()))
+		return false
+	}
+	flattenSvmAudit(data, restInfo)
+	return true
+}
+
+// flattenSvmAudit only refreshes the rotation schedule and the events when they are managed
+func flattenSvmAudit(data *SvmAuditResourceModel, restInfo *interfaces.SvmAuditGetDataModelONTAP) {
+	data.SVMName = types.StringValue(restInfo.SVM.Name)
+	data.Enabled = types.BoolValue(restInfo.Enabled)
+	data.LogPath = types.StringValue(restInfo.LogPath)
+	data.Format = types.StringValue(restInfo.Log.Format)
+	data.RotationSize = types.Int64Value(restInfo.Log.Rotation.Size)
+	data.RetentionCount = types.Int64Value(restInfo.Log.Retention.Count)
+	if data.RotationSchedule != nil {
+		schedule := interfaces.SvmAuditRotationScheduleDataModel{}
+		if restInfo.Log.Rotation.Schedule != nil {
+			schedule = *restInfo.Log.Rotation.Schedule
+		}
+		data.RotationSchedule = &SvmAuditRotationScheduleResourceModel{
+			Minutes:  refreshManagedInt64s(data.RotationSchedule.Minutes, schedule.Minutes),
+			Hours:    refreshManagedInt64s(data.RotationSchedule.Hours, schedule.Hours),
+			Weekdays: refreshManagedInt64s(data.RotationSchedule.Weekdays, schedule.Weekdays),
+			Days:     refreshManagedInt64s(data.RotationSchedule.Days, schedule.Days),
+			Months:   refreshManagedInt64s(data.RotationSchedule.Months, schedule.Months),
+		}
+	}
+	if data.Events != nil {
+		data.Events = &SvmAuditEventsResourceModel{
+			FileOperations:      types.BoolValue(restInfo.Events.FileOperations),
+			CifsLogonLogoff:     types.BoolValue(restInfo.Events.CifsLogonLogoff),
+			AuditPolicyChange:   types.BoolValue(restInfo.Events.AuditPolicyChange),
+			FileShare:           types.BoolValue(restInfo.Events.FileShare),
+			UserAccount:         types.BoolValue(restInfo.Events.UserAccount),
+			SecurityGroup:       types.BoolValue(restInfo.Events.SecurityGroup),
+			AuthorizationPolicy: types.BoolValue(restInfo.Events.AuthorizationPolicy),
+			CapStaging:          types.BoolValue(restInfo.Events.CapStaging),
+		}
+	}
+	data.ID = types.StringValue(restInfo.SVM.UUID)
+}
+
+func expandSvmAuditLog(data *SvmAuditResourceModel) *interfaces.SvmAuditLogDataModel {
+	log := interfaces.SvmAuditLogDataModel{Format: data.Format.ValueString()}
+	if !data.RotationSize.IsUnknown() {
+		log.Rotation.Size = data.RotationSize.ValueInt64()
+	}
+	if !data.RetentionCount.IsUnknown() {
+		log.Retention.Count = data.RetentionCount.ValueInt64()
+	}
+	if data.RotationSchedule != nil {
+		log.Rotation.Schedule = &interfaces.SvmAuditRotationScheduleDataModel{
+			Minutes:  int64sFromTypes(data.RotationSchedule.Minutes),
+			Hours:    int64sFromTypes(data.RotationSchedule.Hours),
+			Weekdays: int64sFromTypes(data.RotationSchedule.Weekdays),
+			Days:     int64sFromTypes(data.RotationSchedule.Days),
+			Months:   int64sFromTypes(data.RotationSchedule.Months),
+		}
+	}
+	return &log
+}
+
+func expandSvmAuditEvents(events *SvmAuditEventsResourceModel) *interfaces.SvmAuditEventsDataModel {
+	if events == nil {
+		return nil
+	}
+	return &interfaces.SvmAuditEventsDataModel{
+		FileOperations:      events.FileOperations.ValueBool(),
+		CifsLogonLogoff:     events.CifsLogonLogoff.ValueBool(),
+		AuditPolicyChange:   events.AuditPolicyChange.ValueBool(),
+		FileShare:           events.FileShare.ValueBool(),
+		UserAccount:         events.UserAccount.ValueBool(),
+		SecurityGroup:       events.SecurityGroup.ValueBool(),
+		AuthorizationPolicy: events.AuthorizationPolicy.ValueBool(),
+		CapStaging:          events.CapStaging.ValueBool(),
+	}
+}
+
+func int64sFromTypes(values []types.Int64) []int64 {
+	var result []int64
+	for _, v := range values {
+		result = append(result, v.ValueInt64())
+	}
+	return result
+}
+
+func refreshManagedInt64s(prior []types.Int64, values []int64) []types.Int64 {
+	if prior == nil {
+		return nil
+	}
+	result := make([]types.Int64, 0, len(values))
+	for _, v := range values {
+		result = append(result, types.Int64Value(v))
+	}
+	return result
+}
@@ -0,0 +1,87 @@
+package svm_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	ntest "github.com/netapp/terraform-provider-netapp-ontap/internal/provider"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSvmAuditResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { ntest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ntest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test log path that does not exist
+			{
+				Config:      testAccSvmAuditResourceConfig("svm0", "/no_such_dir", "evtx", true),
+				ExpectError: regexp.MustCompile("must be an existing directory"),
+			},
+			// Create svm_audit and read
+			{
+				Config: testAccSvmAuditResourceConfig("svm0", "/", "evtx", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_svm_audit.example", "svm_name", "svm0"),
+					resource.TestCheckResourceAttr("netapp-ontap_svm_audit.example", "enabled", "true"),
+					resource.TestCheckResourceAttr("netapp-ontap_svm_audit.example", "format", "evtx"),
+					resource.TestCheckResourceAttr("netapp-ontap_svm_audit.example", "events.audit_policy_change", "true"),
+				),
+			},
+			// Update format and disable
+			{
+				Config: testAccSvmAuditResourceConfig("svm0", "/", "xml", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_svm_audit.example", "enabled", "false"),
+					resource.TestCheckResourceAttr("netapp-ontap_svm_audit.example", "format", "xml"),
+				),
+			},
+			// Import and read
+			{
+				ResourceName:  "netapp-ontap_svm_audit.example",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,%s", "svm0", "cluster4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp-ontap_svm_audit.example", "log_path", "/"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSvmAuditResourceConfig(svm string, logPath string, format string, enabled bool) string {
+	host := os.Getenv("TF_ACC_NETAPP_HOST")
+	admin := os.Getenv("TF_ACC_NETAPP_USER")
+	password := os.Getenv("TF_ACC_NETAPP_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_NETAPP_HOST, TF_ACC_NETAPP_USER, and TF_ACC_NETAPP_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "netapp-ontap" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "netapp-ontap_svm_audit" "example" {
+  cx_profile_name = "cluster4"
+  svm_name = "%s"
+  log_path = "%s"
+  format = "%s"
+  enabled = %t
+  retention_count = 5
+  events = {
+    audit_policy_change = true
+  }
+}`, host, admin, password, svm, logPath, format, enabled)
+}
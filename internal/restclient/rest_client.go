@@ -44,6 +44,7 @@ type RestClient struct {
 	requestSlots          chan int
 	mode                  string
 	responses             []MockResponse
+	recorder              *mockRecorder
 	jobCompletionTimeOut  int
 	tag                   string
 }
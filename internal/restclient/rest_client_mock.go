@@ -32,6 +32,9 @@ func NewMockedRestClient(responses []MockResponse) (*RestClient, error) {
 }
 
 func (c *RestClient) mockCallAPIMethod(method string, baseURL string, query *RestQuery, body map[string]interface{}) (int, RestResponse, error) {
+	if c.recorder != nil {
+		return c.recordingMockCallAPIMethod(method, baseURL, body)
+	}
 	if len(c.responses) == 0 {
 		panic(fmt.Sprintf("Unexpected request: %s %s", method, baseURL))
 	}
@@ -45,3 +48,48 @@ func (c *RestClient) mockCallAPIMethod(method string, baseURL string, query *Res
 	c.responses = c.responses[1:]
 	return expectedResponse.StatusCode, expectedResponse.Response, expectedResponse.Err
 }
+
+// MockRequest describes a request received by a recording mocked client.
+type MockRequest struct {
+	Method string
+	URL    string
+	Body   map[string]interface{}
+}
+
+// mockRecorder is shared by all the copies of a recording mocked client, so responses are consumed in order across calls.
+type mockRecorder struct {
+	responses []MockResponse
+	requests  []MockRequest
+}
+
+// NewRecordingMockedRestClient is used in Unit Testing to mock expected REST responses and record the requests.
+// Unlike NewMockedRestClient, each request must match ExpectedMethod and ExpectedURL.
+func NewRecordingMockedRestClient(responses []MockResponse) (*RestClient, error) {
+	restclient, err := NewMockedRestClient(nil)
+	if err != nil {
+		return nil, err
+	}
+	restclient.recorder = &mockRecorder{responses: responses}
+	return restclient, nil
+}
+
+// MockRequests returns the requests received by a recording mocked client, in order.
+func (c *RestClient) MockRequests() []MockRequest {
+	if c.recorder == nil {
+		return nil
+	}
+	return c.recorder.requests
+}
+
+func (c *RestClient) recordingMockCallAPIMethod(method string, baseURL string, body map[string]interface{}) (int, RestResponse, error) {
+	if len(c.recorder.responses) == 0 {
+		panic(fmt.Sprintf("Unexpected request: %s %s", method, baseURL))
+	}
+	expectedResponse := c.recorder.responses[0]
+	if expectedResponse.ExpectedMethod != method || expectedResponse.ExpectedURL != baseURL {
+		panic(fmt.Sprintf("Unexpected request: %s %s, expecting %s %s", method, baseURL, expectedResponse.ExpectedMethod, expectedResponse.ExpectedURL))
+	}
+	c.recorder.responses = c.recorder.responses[1:]
+	c.recorder.requests = append(c.recorder.requests, MockRequest{Method: method, URL: baseURL, Body: body})
+	return expectedResponse.StatusCode, expectedResponse.Response, expectedResponse.Err
+}